@@ -2,17 +2,32 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net"
 	stdhttp "net/http"
 	"os"
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/board"
+	"project-manager-dashboard-go/internal/app/usecase/idempotency"
+	"project-manager-dashboard-go/internal/app/usecase/mail"
+	"project-manager-dashboard-go/internal/app/usecase/milestone"
+	"project-manager-dashboard-go/internal/app/usecase/notification"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
 	"project-manager-dashboard-go/internal/app/usecase/project"
 	"project-manager-dashboard-go/internal/app/usecase/task"
+	"project-manager-dashboard-go/internal/app/usecase/webhook"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
 
 	"project-manager-dashboard-go/internal/app"
 	"project-manager-dashboard-go/internal/app/usecase/user"
+	"project-manager-dashboard-go/internal/app/worker"
+	graphqlapi "project-manager-dashboard-go/internal/transport/graphql"
+	grpcapi "project-manager-dashboard-go/internal/transport/grpc"
 	httpapi "project-manager-dashboard-go/internal/transport/http"
 )
 
@@ -20,6 +35,7 @@ func main() {
 	_ = godotenv.Load()
 
 	addr := getenv("HTTP_ADDR", ":8081")
+	grpcAddr := getenv("GRPC_ADDR", ":9090")
 	dbURL := getenv("DATABASE_URL", "")
 	if dbURL == "" {
 		log.Fatal("DATABASE_URL is empty")
@@ -37,28 +53,266 @@ func main() {
 	if err := a.Ent.Schema.Create(ctx); err != nil {
 		log.Fatalf("schema create: %v", err)
 	}
+	if err := app.MigrateData(ctx, a.DB); err != nil {
+		log.Fatalf("data migration: %v", err)
+	}
 
 	// Users
 	userRepo := user.NewUserRepo(a.Ent)
 	userUseCase := user.NewUserUsecase(userRepo)
 	userHandlers := httpapi.NewUserHandler(userUseCase)
 
+	locker := worker.NewPGLocker(a.DB)
+
+	// События: репозитории пишут их в outbox, релей раздаёт получателям.
+	outboxRepo := outbox.NewEntRepo(a.Ent)
+	bus := outbox.NewBus()
+
+	// Mail
+	mailUC, err := newMailUsecase(a)
+	if err != nil {
+		log.Fatalf("mail: %v", err)
+	}
+	var mailer notification.Mailer
+	if mailUC != nil {
+		mailer = mailUC
+	}
+
+	// Notifications
+	notificationRepo := notification.NewEntRepo(a.Ent)
+	notificationUC := notification.NewNotificationUsecase(notificationRepo, mailer)
+	notificationHandlers := httpapi.NewNotificationHandler(notificationUC)
+
+	// Webhooks
+	webhookTimeout, err := time.ParseDuration(getenv("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil {
+		log.Fatalf("WEBHOOK_TIMEOUT: %v", err)
+	}
+	webhookMaxAttempts, err := strconv.Atoi(getenv("WEBHOOK_MAX_ATTEMPTS", "8"))
+	if err != nil {
+		log.Fatalf("WEBHOOK_MAX_ATTEMPTS: %v", err)
+	}
+	webhookDisableAfter, err := strconv.Atoi(getenv("WEBHOOK_DISABLE_AFTER", "20"))
+	if err != nil {
+		log.Fatalf("WEBHOOK_DISABLE_AFTER: %v", err)
+	}
+	webhookRepo := webhook.NewEntRepo(a.Ent)
+	webhookUC := webhook.NewWebhookUsecase(
+		webhookRepo,
+		webhook.NewHTTPSender(webhookTimeout),
+		webhookMaxAttempts,
+		webhookDisableAfter,
+	)
+	webhookHandlers := httpapi.NewWebhookHandler(webhookUC)
+
 	// Projects
 	projectRepo := project.NewEntRepo(a.Ent)
-	projectUseCase := project.NewProjectUsecase(projectRepo)
+	projectUseCase := project.NewProjectUsecase(projectRepo, notificationUC)
 	projectHandlers := httpapi.NewProjectHandler(projectUseCase)
 
 	// Tasks
 	taskRepo := task.NewEntRepo(a.Ent)
-	taskUC := task.NewTasksUseCase(taskRepo)
+	taskUC := task.NewTasksUseCase(taskRepo, notificationUC)
 	taskHandlers := httpapi.NewTaskHandler(taskUC)
 
-	r := httpapi.NewRouter(userHandlers, projectHandlers, taskHandlers)
+	// Milestones
+	milestoneRepo := milestone.NewEntRepo(a.Ent)
+	milestoneUC := milestone.NewMilestoneUsecase(milestoneRepo)
+	milestoneHandlers := httpapi.NewMilestoneHandler(milestoneUC)
+
+	// Audit
+	auditRepo := audit.NewEntRepo(a.Ent)
+	auditUC := audit.NewAuditUsecase(auditRepo)
+	auditHandlers := httpapi.NewAuditHandler(auditUC)
+
+	// Board events
+	boardBuffer, err := strconv.Atoi(getenv("BOARD_STREAM_BUFFER", "256"))
+	if err != nil {
+		log.Fatalf("BOARD_STREAM_BUFFER: %v", err)
+	}
+	boardUC := board.NewBoardUsecase(board.NewEntRepo(a.Ent), bus, boardBuffer)
+	boardHandlers := httpapi.NewBoardHandler(boardUC)
+
+	// Idempotency keys
+	idemRetention, err := time.ParseDuration(getenv("IDEMPOTENCY_RETENTION", "24h"))
+	if err != nil {
+		log.Fatalf("IDEMPOTENCY_RETENTION: %v", err)
+	}
+	idemRepo := idempotency.NewEntRepo(a.Ent)
+	idemUC := idempotency.NewIdempotencyUsecase(idemRepo, idemRetention)
+	idem := httpapi.NewIdempotency(idemUC)
+
+	// Trash
+	retention, err := time.ParseDuration(getenv("TRASH_RETENTION", "720h"))
+	if err != nil {
+		log.Fatalf("TRASH_RETENTION: %v", err)
+	}
+	purgeInterval, err := time.ParseDuration(getenv("TRASH_PURGE_INTERVAL", "1h"))
+	if err != nil {
+		log.Fatalf("TRASH_PURGE_INTERVAL: %v", err)
+	}
+	purger := worker.NewTrashPurger(retention, purgeInterval, locker)
+	purger.Register("tasks", taskRepo)
+	purger.Register("projects", projectRepo)
+	go purger.Run(context.Background())
+
+	idemPurger := worker.NewTrashPurger(idemRetention, purgeInterval, locker)
+	idemPurger.Register("idempotency keys", idemRepo)
+	go idemPurger.Run(context.Background())
+
+	webhookLogRetention, err := time.ParseDuration(getenv("WEBHOOK_LOG_RETENTION", "720h"))
+	if err != nil {
+		log.Fatalf("WEBHOOK_LOG_RETENTION: %v", err)
+	}
+	webhookPurger := worker.NewTrashPurger(webhookLogRetention, purgeInterval, locker)
+	webhookPurger.Register("webhook deliveries", webhookRepo)
+	go webhookPurger.Run(context.Background())
+
+	webhookInterval, err := time.ParseDuration(getenv("WEBHOOK_QUEUE_INTERVAL", "10s"))
+	if err != nil {
+		log.Fatalf("WEBHOOK_QUEUE_INTERVAL: %v", err)
+	}
+	go worker.NewWebhookQueue(webhookInterval, locker, webhookUC).Run(context.Background())
+
+	// Outbox
+	outboxGapTimeout, err := time.ParseDuration(getenv("OUTBOX_GAP_TIMEOUT", "10s"))
+	if err != nil {
+		log.Fatalf("OUTBOX_GAP_TIMEOUT: %v", err)
+	}
+	outboxUC := outbox.NewOutboxUsecase(outboxRepo, outboxGapTimeout)
+	for _, name := range strings.Split(getenv("OUTBOX_SINKS", "notify,webhooks"), ",") {
+		switch strings.TrimSpace(name) {
+		case "bus":
+			outboxUC.Register("bus", bus)
+		case "notify":
+			// Шину каждой реплики наполняет её слушатель NOTIFY.
+			outboxUC.Register("notify", worker.NewPGNotifier(a.DB, worker.EventsChannel))
+			go worker.NewPGListener(a.DB, worker.EventsChannel, outboxRepo, bus).Run(context.Background())
+		case "webhooks":
+			outboxUC.Register("webhooks", webhookUC)
+		case "log":
+			outboxUC.Register("log", outbox.LogSink{})
+		case "":
+		default:
+			log.Fatalf("OUTBOX_SINKS: unknown sink %q", name)
+		}
+	}
+	relayInterval, err := time.ParseDuration(getenv("OUTBOX_RELAY_INTERVAL", "1s"))
+	if err != nil {
+		log.Fatalf("OUTBOX_RELAY_INTERVAL: %v", err)
+	}
+	go worker.NewOutboxRelay(relayInterval, locker, outboxUC).Run(context.Background())
+
+	outboxRetention, err := time.ParseDuration(getenv("OUTBOX_RETENTION", "168h"))
+	if err != nil {
+		log.Fatalf("OUTBOX_RETENTION: %v", err)
+	}
+	outboxPurger := worker.NewTrashPurger(outboxRetention, purgeInterval, locker)
+	outboxPurger.Register("outbox events", outboxRepo)
+	go outboxPurger.Run(context.Background())
+
+	// Due-date reminders
+	reminderInterval, err := time.ParseDuration(getenv("REMINDER_INTERVAL", "5m"))
+	if err != nil {
+		log.Fatalf("REMINDER_INTERVAL: %v", err)
+	}
+	dueSoon, err := time.ParseDuration(getenv("REMINDER_DUE_SOON", "24h"))
+	if err != nil {
+		log.Fatalf("REMINDER_DUE_SOON: %v", err)
+	}
+	reminder := worker.NewDueReminder(reminderInterval, dueSoon, locker, taskUC)
+	go reminder.Run(context.Background())
+
+	if mailUC != nil {
+		queueInterval, err := time.ParseDuration(getenv("MAIL_QUEUE_INTERVAL", "30s"))
+		if err != nil {
+			log.Fatalf("MAIL_QUEUE_INTERVAL: %v", err)
+		}
+		digestHour, err := strconv.Atoi(getenv("MAIL_DIGEST_HOUR", "8"))
+		if err != nil || digestHour < 0 || digestHour > 23 {
+			log.Fatalf("MAIL_DIGEST_HOUR: must be 0..23")
+		}
+		go worker.NewMailQueue(queueInterval, locker, mailUC).Run(context.Background())
+		go worker.NewMailDigest(digestHour, locker, mailUC).Run(context.Background())
+	}
+
+	// Пути без /v1 остаются псевдонимами до API_LEGACY_SUNSET.
+	legacySince, err := time.Parse(time.DateOnly, getenv("API_LEGACY_DEPRECATED_AT", "2026-10-19"))
+	if err != nil {
+		log.Fatalf("API_LEGACY_DEPRECATED_AT: %v", err)
+	}
+	legacySunset, err := time.Parse(time.DateOnly, getenv("API_LEGACY_SUNSET", "2027-04-19"))
+	if err != nil {
+		log.Fatalf("API_LEGACY_SUNSET: %v", err)
+	}
+
+	graphQLComplexity, err := strconv.Atoi(getenv("GRAPHQL_COMPLEXITY_LIMIT", "1000"))
+	if err != nil || graphQLComplexity <= 0 {
+		log.Fatalf("GRAPHQL_COMPLEXITY_LIMIT: must be a positive integer")
+	}
+	graphQLHandler := graphqlapi.NewHandler(a.Ent, projectUseCase, taskUC, graphQLComplexity)
+
+	r, err := httpapi.NewRouter(
+		userHandlers,
+		projectHandlers,
+		taskHandlers,
+		milestoneHandlers,
+		auditHandlers,
+		notificationHandlers,
+		webhookHandlers,
+		boardHandlers,
+		graphQLHandler,
+		idem,
+		httpapi.Deprecation{Since: legacySince, Sunset: legacySunset},
+	)
+	if err != nil {
+		log.Fatalf("router: %v", err)
+	}
+
+	grpcServer := grpcapi.NewServer(userUseCase, projectUseCase, taskUC, boardUC)
+	lis, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		log.Fatalf("grpc listen: %v", err)
+	}
+	go func() {
+		log.Printf("gRPC listening on %s", grpcAddr)
+		log.Fatal(grpcServer.Serve(lis))
+	}()
 
 	log.Printf("HTTP listening on %s", addr)
 	log.Fatal(stdhttp.ListenAndServe(addr, r))
 }
 
+// newMailUsecase настраивает отправку почты. Без SMTP_HOST почта выключена
+// и возвращается nil.
+func newMailUsecase(a *app.App) (*mail.UseCase, error) {
+	port, err := strconv.Atoi(getenv("SMTP_PORT", "587"))
+	if err != nil {
+		return nil, fmt.Errorf("SMTP_PORT: %w", err)
+	}
+	cfg := mail.SMTPConfig{
+		Host:     getenv("SMTP_HOST", ""),
+		Port:     port,
+		Username: getenv("SMTP_USERNAME", ""),
+		Password: getenv("SMTP_PASSWORD", ""),
+		TLS:      getenv("SMTP_TLS", mail.TLSStartTLS),
+		From:     getenv("MAIL_FROM", "Project Manager <noreply@localhost>"),
+	}
+	if cfg.Host == "" {
+		return nil, nil
+	}
+
+	maxAttempts, err := strconv.Atoi(getenv("MAIL_MAX_ATTEMPTS", "8"))
+	if err != nil {
+		return nil, fmt.Errorf("MAIL_MAX_ATTEMPTS: %w", err)
+	}
+	sender, err := mail.NewSMTPSender(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return mail.NewMailUsecase(mail.NewEntRepo(a.Ent), sender, maxAttempts), nil
+}
+
 func getenv(key, def string) string {
 	if v := os.Getenv(key); v != "" {
 		return v
@@ -0,0 +1,38 @@
+// Команда fakesmtp — SMTP-сервер для локальной разработки: принимает
+// письма, ничего не доставляет и печатает их в лог. API направляют на
+// него через SMTP_HOST, SMTP_PORT и SMTP_TLS=none.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"project-manager-dashboard-go/internal/app/usecase/mail/mailtest"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:1025", "listen address")
+	body := flag.Bool("body", false, "print message source")
+	flag.Parse()
+
+	srv, err := mailtest.NewServer(*addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv.OnMessage = func(m mailtest.Received) {
+		log.Printf("fake smtp: mail from %s to %s, %d bytes", m.From, strings.Join(m.To, ", "), len(m.Data))
+		if *body {
+			log.Printf("%s", m.Data)
+		}
+	}
+	log.Printf("fake smtp listening on %s", srv.Addr())
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+	_ = srv.Close()
+}
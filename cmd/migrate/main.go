@@ -8,6 +8,7 @@ import (
 	"log"
 	"project-manager-dashboard-go/ent"
 	"project-manager-dashboard-go/ent/migrate"
+	"project-manager-dashboard-go/internal/app"
 )
 
 func main() {
@@ -34,5 +35,9 @@ func main() {
 		log.Fatalf("migrate: %v", err)
 	}
 
+	if err := app.MigrateData(ctx, drv.DB()); err != nil {
+		log.Fatalf("data migration: %v", err)
+	}
+
 	log.Println("migration complete")
 }
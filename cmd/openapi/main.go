@@ -0,0 +1,47 @@
+// Команда openapi печатает спецификацию HTTP API. С флагом -check она
+// только собирает роутер, чтобы убедиться, что маршруты и спецификация
+// совпадают; база данных не нужна.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+
+	httpapi "project-manager-dashboard-go/internal/transport/http"
+)
+
+func main() {
+	check := flag.Bool("check", false, "only check that routes match the spec")
+	flag.Parse()
+
+	if *check {
+		// Обработчики не вызываются, поэтому хватает нулевых значений.
+		_, err := httpapi.NewRouter(
+			&httpapi.UserHandler{},
+			&httpapi.ProjectHandler{},
+			&httpapi.TaskHandler{},
+			&httpapi.MilestoneHandler{},
+			&httpapi.AuditHandler{},
+			&httpapi.NotificationHandler{},
+			&httpapi.WebhookHandler{},
+			&httpapi.BoardHandler{},
+			http.NotFoundHandler(),
+			&httpapi.Idempotency{},
+			httpapi.Deprecation{},
+		)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Print("openapi: routes match the spec")
+		return
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(httpapi.NewV1Spec()); err != nil {
+		log.Fatal(err)
+	}
+}
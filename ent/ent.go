@@ -6,11 +6,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager-dashboard-go/ent/auditevent"
+	"project-manager-dashboard-go/ent/idempotencykey"
+	"project-manager-dashboard-go/ent/mailmessage"
+	"project-manager-dashboard-go/ent/milestone"
+	"project-manager-dashboard-go/ent/notification"
+	"project-manager-dashboard-go/ent/notificationpreference"
+	"project-manager-dashboard-go/ent/outboxevent"
 	"project-manager-dashboard-go/ent/project"
 	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/projecttemplate"
 	"project-manager-dashboard-go/ent/projectuser"
 	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/templatemember"
+	"project-manager-dashboard-go/ent/templatetask"
 	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/ent/webhook"
+	"project-manager-dashboard-go/ent/webhookdelivery"
 	"reflect"
 	"sync"
 
@@ -77,11 +94,28 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			project.Table:     project.ValidColumn,
-			projecttask.Table: projecttask.ValidColumn,
-			projectuser.Table: projectuser.ValidColumn,
-			task.Table:        task.ValidColumn,
-			user.Table:        user.ValidColumn,
+			auditevent.Table:             auditevent.ValidColumn,
+			idempotencykey.Table:         idempotencykey.ValidColumn,
+			mailmessage.Table:            mailmessage.ValidColumn,
+			milestone.Table:              milestone.ValidColumn,
+			notification.Table:           notification.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			outboxevent.Table:            outboxevent.ValidColumn,
+			project.Table:                project.ValidColumn,
+			projecttask.Table:            projecttask.ValidColumn,
+			projecttemplate.Table:        projecttemplate.ValidColumn,
+			projectuser.Table:            projectuser.ValidColumn,
+			task.Table:                   task.ValidColumn,
+			taskactivity.Table:           taskactivity.ValidColumn,
+			taskassignee.Table:           taskassignee.ValidColumn,
+			taskassignmentevent.Table:    taskassignmentevent.ValidColumn,
+			taskreminder.Table:           taskreminder.ValidColumn,
+			taskwatcher.Table:            taskwatcher.ValidColumn,
+			templatemember.Table:         templatemember.ValidColumn,
+			templatetask.Table:           templatetask.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			webhook.Table:                webhook.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
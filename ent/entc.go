@@ -0,0 +1,32 @@
+//go:build ignore
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+)
+
+// Генерация ent вместе с GraphQL-схемой для internal/transport/graphql.
+// Запускается из каталога ent: go generate ./ent, затем go run
+// github.com/99designs/gqlgen из корня модуля.
+func main() {
+	ex, err := entgql.NewExtension(
+		entgql.WithSchemaGenerator(),
+		entgql.WithSchemaPath("../internal/transport/graphql/ent.graphql"),
+		entgql.WithConfigPath("../gqlgen.yml"),
+		entgql.WithWhereInputs(true),
+	)
+	if err != nil {
+		log.Fatalf("entgql: %v", err)
+	}
+	err = entc.Generate("./schema", &gen.Config{
+		Features: []gen.Feature{gen.FeatureIntercept},
+	}, entc.Extensions(ex))
+	if err != nil {
+		log.Fatalf("ent: %v", err)
+	}
+}
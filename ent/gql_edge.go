@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (_m *Milestone) Project(ctx context.Context) (*Project, error) {
+	result, err := _m.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryProject().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Milestone) Tasks(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TaskOrder, where *TaskWhereInput,
+) (*TaskConnection, error) {
+	opts := []TaskPaginateOption{
+		WithTaskOrder(orderBy),
+		WithTaskFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[1][alias]
+	if nodes, err := _m.NamedTasks(alias); err == nil || hasTotalCount {
+		pager, err := newTaskPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TaskConnection{Edges: []*TaskEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryTasks().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Project) Memberships(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *ProjectUserOrder, where *ProjectUserWhereInput,
+) (*ProjectUserConnection, error) {
+	opts := []ProjectUserPaginateOption{
+		WithProjectUserOrder(orderBy),
+		WithProjectUserFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[0][alias]
+	if nodes, err := _m.NamedMemberships(alias); err == nil || hasTotalCount {
+		pager, err := newProjectUserPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &ProjectUserConnection{Edges: []*ProjectUserEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryMemberships().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Project) ProjectTasks(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *ProjectTaskOrder, where *ProjectTaskWhereInput,
+) (*ProjectTaskConnection, error) {
+	opts := []ProjectTaskPaginateOption{
+		WithProjectTaskOrder(orderBy),
+		WithProjectTaskFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[1][alias]
+	if nodes, err := _m.NamedProjectTasks(alias); err == nil || hasTotalCount {
+		pager, err := newProjectTaskPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &ProjectTaskConnection{Edges: []*ProjectTaskEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryProjectTasks().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Project) Milestones(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *MilestoneOrder, where *MilestoneWhereInput,
+) (*MilestoneConnection, error) {
+	opts := []MilestonePaginateOption{
+		WithMilestoneOrder(orderBy),
+		WithMilestoneFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[2][alias]
+	if nodes, err := _m.NamedMilestones(alias); err == nil || hasTotalCount {
+		pager, err := newMilestonePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &MilestoneConnection{Edges: []*MilestoneEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryMilestones().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *ProjectTask) Project(ctx context.Context) (*Project, error) {
+	result, err := _m.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryProject().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *ProjectTask) Task(ctx context.Context) (*Task, error) {
+	result, err := _m.Edges.TaskOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryTask().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *ProjectUser) Project(ctx context.Context) (*Project, error) {
+	result, err := _m.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryProject().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *ProjectUser) User(ctx context.Context) (*User, error) {
+	result, err := _m.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Task) ProjectTasks(ctx context.Context) (result []*ProjectTask, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedProjectTasks(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.ProjectTasksOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryProjectTasks().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Task) Assignees(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TaskAssigneeOrder, where *TaskAssigneeWhereInput,
+) (*TaskAssigneeConnection, error) {
+	opts := []TaskAssigneePaginateOption{
+		WithTaskAssigneeOrder(orderBy),
+		WithTaskAssigneeFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[1][alias]
+	if nodes, err := _m.NamedAssignees(alias); err == nil || hasTotalCount {
+		pager, err := newTaskAssigneePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TaskAssigneeConnection{Edges: []*TaskAssigneeEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryAssignees().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Task) Milestone(ctx context.Context) (*Milestone, error) {
+	result, err := _m.Edges.MilestoneOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryMilestone().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (_m *TaskAssignee) Task(ctx context.Context) (*Task, error) {
+	result, err := _m.Edges.TaskOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryTask().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *TaskAssignee) User(ctx context.Context) (*User, error) {
+	result, err := _m.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *User) TaskAssignments(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TaskAssigneeOrder, where *TaskAssigneeWhereInput,
+) (*TaskAssigneeConnection, error) {
+	opts := []TaskAssigneePaginateOption{
+		WithTaskAssigneeOrder(orderBy),
+		WithTaskAssigneeFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[0][alias]
+	if nodes, err := _m.NamedTaskAssignments(alias); err == nil || hasTotalCount {
+		pager, err := newTaskAssigneePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &TaskAssigneeConnection{Edges: []*TaskAssigneeEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryTaskAssignments().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *User) Memberships(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *ProjectUserOrder, where *ProjectUserWhereInput,
+) (*ProjectUserConnection, error) {
+	opts := []ProjectUserPaginateOption{
+		WithProjectUserOrder(orderBy),
+		WithProjectUserFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[1][alias]
+	if nodes, err := _m.NamedMemberships(alias); err == nil || hasTotalCount {
+		pager, err := newProjectUserPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &ProjectUserConnection{Edges: []*ProjectUserEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryMemberships().Paginate(ctx, after, first, before, last, opts...)
+}
@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"project-manager-dashboard-go/ent/milestone"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/projectuser"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/user"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Noder wraps the basic Node method.
+type Noder interface {
+	IsNode()
+}
+
+var milestoneImplementors = []string{"Milestone", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Milestone) IsNode() {}
+
+var projectImplementors = []string{"Project", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Project) IsNode() {}
+
+var projecttaskImplementors = []string{"ProjectTask", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ProjectTask) IsNode() {}
+
+var projectuserImplementors = []string{"ProjectUser", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*ProjectUser) IsNode() {}
+
+var taskImplementors = []string{"Task", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Task) IsNode() {}
+
+var taskassigneeImplementors = []string{"TaskAssignee", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*TaskAssignee) IsNode() {}
+
+var userImplementors = []string{"User", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*User) IsNode() {}
+
+var errNodeInvalidID = &NotFoundError{"node"}
+
+// NodeOption allows configuring the Noder execution using functional options.
+type NodeOption func(*nodeOptions)
+
+// WithNodeType sets the node Type resolver function (i.e. the table to query).
+// If was not provided, the table will be derived from the universal-id
+// configuration as described in: https://entgo.io/docs/migrate/#universal-ids.
+func WithNodeType(f func(context.Context, uuid.UUID) (string, error)) NodeOption {
+	return func(o *nodeOptions) {
+		o.nodeType = f
+	}
+}
+
+// WithFixedNodeType sets the Type of the node to a fixed value.
+func WithFixedNodeType(t string) NodeOption {
+	return WithNodeType(func(context.Context, uuid.UUID) (string, error) {
+		return t, nil
+	})
+}
+
+type nodeOptions struct {
+	nodeType func(context.Context, uuid.UUID) (string, error)
+}
+
+func (c *Client) newNodeOpts(opts []NodeOption) *nodeOptions {
+	nopts := &nodeOptions{}
+	for _, opt := range opts {
+		opt(nopts)
+	}
+	if nopts.nodeType == nil {
+		nopts.nodeType = func(ctx context.Context, id uuid.UUID) (string, error) {
+			return "", fmt.Errorf("cannot resolve noder (%v) without its type", id)
+		}
+	}
+	return nopts
+}
+
+// Noder returns a Node by its id. If the NodeType was not provided, it will
+// be derived from the id value according to the universal-id configuration.
+//
+//	c.Noder(ctx, id)
+//	c.Noder(ctx, id, ent.WithNodeType(typeResolver))
+func (c *Client) Noder(ctx context.Context, id uuid.UUID, opts ...NodeOption) (_ Noder, err error) {
+	defer func() {
+		if IsNotFound(err) {
+			err = multierror.Append(err, entgql.ErrNodeNotFound(id))
+		}
+	}()
+	table, err := c.newNodeOpts(opts).nodeType(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return c.noder(ctx, table, id)
+}
+
+func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder, error) {
+	switch table {
+	case milestone.Table:
+		query := c.Milestone.Query().
+			Where(milestone.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, milestoneImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case project.Table:
+		query := c.Project.Query().
+			Where(project.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, projectImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case projecttask.Table:
+		query := c.ProjectTask.Query().
+			Where(projecttask.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, projecttaskImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case projectuser.Table:
+		query := c.ProjectUser.Query().
+			Where(projectuser.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, projectuserImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case task.Table:
+		query := c.Task.Query().
+			Where(task.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, taskImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case taskassignee.Table:
+		query := c.TaskAssignee.Query().
+			Where(taskassignee.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, taskassigneeImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case user.Table:
+		query := c.User.Query().
+			Where(user.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, userImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	default:
+		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
+	}
+}
+
+func (c *Client) Noders(ctx context.Context, ids []uuid.UUID, opts ...NodeOption) ([]Noder, error) {
+	switch len(ids) {
+	case 1:
+		noder, err := c.Noder(ctx, ids[0], opts...)
+		if err != nil {
+			return nil, err
+		}
+		return []Noder{noder}, nil
+	case 0:
+		return []Noder{}, nil
+	}
+
+	noders := make([]Noder, len(ids))
+	errors := make([]error, len(ids))
+	tables := make(map[string][]uuid.UUID)
+	id2idx := make(map[uuid.UUID][]int, len(ids))
+	nopts := c.newNodeOpts(opts)
+	for i, id := range ids {
+		table, err := nopts.nodeType(ctx, id)
+		if err != nil {
+			errors[i] = err
+			continue
+		}
+		tables[table] = append(tables[table], id)
+		id2idx[id] = append(id2idx[id], i)
+	}
+
+	for table, ids := range tables {
+		nodes, err := c.noders(ctx, table, ids)
+		if err != nil {
+			for _, id := range ids {
+				for _, idx := range id2idx[id] {
+					errors[idx] = err
+				}
+			}
+		} else {
+			for i, id := range ids {
+				for _, idx := range id2idx[id] {
+					noders[idx] = nodes[i]
+				}
+			}
+		}
+	}
+
+	for i, id := range ids {
+		if errors[i] == nil {
+			if noders[i] != nil {
+				continue
+			}
+			errors[i] = entgql.ErrNodeNotFound(id)
+		} else if IsNotFound(errors[i]) {
+			errors[i] = multierror.Append(errors[i], entgql.ErrNodeNotFound(id))
+		}
+		ctx := graphql.WithPathContext(ctx,
+			graphql.NewPathWithIndex(i),
+		)
+		graphql.AddError(ctx, errors[i])
+	}
+	return noders, nil
+}
+
+func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]Noder, error) {
+	noders := make([]Noder, len(ids))
+	idmap := make(map[uuid.UUID][]*Noder, len(ids))
+	for i, id := range ids {
+		idmap[id] = append(idmap[id], &noders[i])
+	}
+	switch table {
+	case milestone.Table:
+		query := c.Milestone.Query().
+			Where(milestone.IDIn(ids...))
+		query, err := query.CollectFields(ctx, milestoneImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case project.Table:
+		query := c.Project.Query().
+			Where(project.IDIn(ids...))
+		query, err := query.CollectFields(ctx, projectImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case projecttask.Table:
+		query := c.ProjectTask.Query().
+			Where(projecttask.IDIn(ids...))
+		query, err := query.CollectFields(ctx, projecttaskImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case projectuser.Table:
+		query := c.ProjectUser.Query().
+			Where(projectuser.IDIn(ids...))
+		query, err := query.CollectFields(ctx, projectuserImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case task.Table:
+		query := c.Task.Query().
+			Where(task.IDIn(ids...))
+		query, err := query.CollectFields(ctx, taskImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case taskassignee.Table:
+		query := c.TaskAssignee.Query().
+			Where(taskassignee.IDIn(ids...))
+		query, err := query.CollectFields(ctx, taskassigneeImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case user.Table:
+		query := c.User.Query().
+			Where(user.IDIn(ids...))
+		query, err := query.CollectFields(ctx, userImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	default:
+		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
+	}
+	return noders, nil
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/idempotencykey"
+	"project-manager-dashboard-go/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdempotencyKeyUpdate is the builder for updating IdempotencyKey entities.
+type IdempotencyKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (_u *IdempotencyKeyUpdate) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *IdempotencyKeyUpdate) SetStatusCode(v int) *IdempotencyKeyUpdate {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *IdempotencyKeyUpdate) SetNillableStatusCode(v *int) *IdempotencyKeyUpdate {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *IdempotencyKeyUpdate) AddStatusCode(v int) *IdempotencyKeyUpdate {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (_u *IdempotencyKeyUpdate) ClearStatusCode() *IdempotencyKeyUpdate {
+	_u.mutation.ClearStatusCode()
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *IdempotencyKeyUpdate) SetContentType(v string) *IdempotencyKeyUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *IdempotencyKeyUpdate) SetNillableContentType(v *string) *IdempotencyKeyUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (_u *IdempotencyKeyUpdate) ClearContentType() *IdempotencyKeyUpdate {
+	_u.mutation.ClearContentType()
+	return _u
+}
+
+// SetResponseBody sets the "response_body" field.
+func (_u *IdempotencyKeyUpdate) SetResponseBody(v []byte) *IdempotencyKeyUpdate {
+	_u.mutation.SetResponseBody(v)
+	return _u
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (_u *IdempotencyKeyUpdate) ClearResponseBody() *IdempotencyKeyUpdate {
+	_u.mutation.ClearResponseBody()
+	return _u
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (_u *IdempotencyKeyUpdate) Mutation() *IdempotencyKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *IdempotencyKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IdempotencyKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *IdempotencyKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IdempotencyKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *IdempotencyKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(idempotencykey.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(idempotencykey.FieldContentType, field.TypeString, value)
+	}
+	if _u.mutation.ContentTypeCleared() {
+		_spec.ClearField(idempotencykey.FieldContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+	}
+	if _u.mutation.ResponseBodyCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseBody, field.TypeBytes)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// IdempotencyKeyUpdateOne is the builder for updating a single IdempotencyKey entity.
+type IdempotencyKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_u *IdempotencyKeyUpdateOne) SetStatusCode(v int) *IdempotencyKeyUpdateOne {
+	_u.mutation.ResetStatusCode()
+	_u.mutation.SetStatusCode(v)
+	return _u
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_u *IdempotencyKeyUpdateOne) SetNillableStatusCode(v *int) *IdempotencyKeyUpdateOne {
+	if v != nil {
+		_u.SetStatusCode(*v)
+	}
+	return _u
+}
+
+// AddStatusCode adds value to the "status_code" field.
+func (_u *IdempotencyKeyUpdateOne) AddStatusCode(v int) *IdempotencyKeyUpdateOne {
+	_u.mutation.AddStatusCode(v)
+	return _u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (_u *IdempotencyKeyUpdateOne) ClearStatusCode() *IdempotencyKeyUpdateOne {
+	_u.mutation.ClearStatusCode()
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *IdempotencyKeyUpdateOne) SetContentType(v string) *IdempotencyKeyUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *IdempotencyKeyUpdateOne) SetNillableContentType(v *string) *IdempotencyKeyUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (_u *IdempotencyKeyUpdateOne) ClearContentType() *IdempotencyKeyUpdateOne {
+	_u.mutation.ClearContentType()
+	return _u
+}
+
+// SetResponseBody sets the "response_body" field.
+func (_u *IdempotencyKeyUpdateOne) SetResponseBody(v []byte) *IdempotencyKeyUpdateOne {
+	_u.mutation.SetResponseBody(v)
+	return _u
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (_u *IdempotencyKeyUpdateOne) ClearResponseBody() *IdempotencyKeyUpdateOne {
+	_u.mutation.ClearResponseBody()
+	return _u
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (_u *IdempotencyKeyUpdateOne) Mutation() *IdempotencyKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (_u *IdempotencyKeyUpdateOne) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *IdempotencyKeyUpdateOne) Select(field string, fields ...string) *IdempotencyKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated IdempotencyKey entity.
+func (_u *IdempotencyKeyUpdateOne) Save(ctx context.Context) (*IdempotencyKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IdempotencyKeyUpdateOne) SaveX(ctx context.Context) *IdempotencyKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *IdempotencyKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IdempotencyKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *IdempotencyKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdempotencyKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdempotencyKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotencykey.FieldID)
+		for _, f := range fields {
+			if !idempotencykey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != idempotencykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StatusCode(); ok {
+		_spec.SetField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatusCode(); ok {
+		_spec.AddField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(idempotencykey.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(idempotencykey.FieldContentType, field.TypeString, value)
+	}
+	if _u.mutation.ContentTypeCleared() {
+		_spec.ClearField(idempotencykey.FieldContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+	}
+	if _u.mutation.ResponseBodyCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseBody, field.TypeBytes)
+	}
+	_node = &IdempotencyKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
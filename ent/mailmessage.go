@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/mailmessage"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// MailMessage is the model entity for the MailMessage schema.
+type MailMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ToEmail holds the value of the "to_email" field.
+	ToEmail string `json:"to_email,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// TextBody holds the value of the "text_body" field.
+	TextBody string `json:"text_body,omitempty"`
+	// HTMLBody holds the value of the "html_body" field.
+	HTMLBody string `json:"html_body,omitempty"`
+	// Status holds the value of the "status" field.
+	Status mailmessage.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError *string `json:"last_error,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt *time.Time `json:"sent_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MailMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mailmessage.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case mailmessage.FieldToEmail, mailmessage.FieldSubject, mailmessage.FieldTextBody, mailmessage.FieldHTMLBody, mailmessage.FieldStatus, mailmessage.FieldLastError:
+			values[i] = new(sql.NullString)
+		case mailmessage.FieldNextAttemptAt, mailmessage.FieldSentAt, mailmessage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case mailmessage.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MailMessage fields.
+func (_m *MailMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mailmessage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case mailmessage.FieldToEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_email", values[i])
+			} else if value.Valid {
+				_m.ToEmail = value.String
+			}
+		case mailmessage.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case mailmessage.FieldTextBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text_body", values[i])
+			} else if value.Valid {
+				_m.TextBody = value.String
+			}
+		case mailmessage.FieldHTMLBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field html_body", values[i])
+			} else if value.Valid {
+				_m.HTMLBody = value.String
+			}
+		case mailmessage.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = mailmessage.Status(value.String)
+			}
+		case mailmessage.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case mailmessage.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = value.Time
+			}
+		case mailmessage.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = new(string)
+				*_m.LastError = value.String
+			}
+		case mailmessage.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = new(time.Time)
+				*_m.SentAt = value.Time
+			}
+		case mailmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MailMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *MailMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MailMessage.
+// Note that you need to call MailMessage.Unwrap() before calling this method if this MailMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MailMessage) Update() *MailMessageUpdateOne {
+	return NewMailMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MailMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MailMessage) Unwrap() *MailMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MailMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MailMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("MailMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("to_email=")
+	builder.WriteString(_m.ToEmail)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("text_body=")
+	builder.WriteString(_m.TextBody)
+	builder.WriteString(", ")
+	builder.WriteString("html_body=")
+	builder.WriteString(_m.HTMLBody)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(_m.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MailMessages is a parsable slice of MailMessage.
+type MailMessages []*MailMessage
@@ -0,0 +1,651 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mailmessage
+
+import (
+	"project-manager-dashboard-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldID, id))
+}
+
+// ToEmail applies equality check predicate on the "to_email" field. It's identical to ToEmailEQ.
+func ToEmail(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldToEmail, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldSubject, v))
+}
+
+// TextBody applies equality check predicate on the "text_body" field. It's identical to TextBodyEQ.
+func TextBody(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldTextBody, v))
+}
+
+// HTMLBody applies equality check predicate on the "html_body" field. It's identical to HTMLBodyEQ.
+func HTMLBody(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldHTMLBody, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldLastError, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldSentAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ToEmailEQ applies the EQ predicate on the "to_email" field.
+func ToEmailEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldToEmail, v))
+}
+
+// ToEmailNEQ applies the NEQ predicate on the "to_email" field.
+func ToEmailNEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldToEmail, v))
+}
+
+// ToEmailIn applies the In predicate on the "to_email" field.
+func ToEmailIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldToEmail, vs...))
+}
+
+// ToEmailNotIn applies the NotIn predicate on the "to_email" field.
+func ToEmailNotIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldToEmail, vs...))
+}
+
+// ToEmailGT applies the GT predicate on the "to_email" field.
+func ToEmailGT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldToEmail, v))
+}
+
+// ToEmailGTE applies the GTE predicate on the "to_email" field.
+func ToEmailGTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldToEmail, v))
+}
+
+// ToEmailLT applies the LT predicate on the "to_email" field.
+func ToEmailLT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldToEmail, v))
+}
+
+// ToEmailLTE applies the LTE predicate on the "to_email" field.
+func ToEmailLTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldToEmail, v))
+}
+
+// ToEmailContains applies the Contains predicate on the "to_email" field.
+func ToEmailContains(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContains(FieldToEmail, v))
+}
+
+// ToEmailHasPrefix applies the HasPrefix predicate on the "to_email" field.
+func ToEmailHasPrefix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasPrefix(FieldToEmail, v))
+}
+
+// ToEmailHasSuffix applies the HasSuffix predicate on the "to_email" field.
+func ToEmailHasSuffix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasSuffix(FieldToEmail, v))
+}
+
+// ToEmailEqualFold applies the EqualFold predicate on the "to_email" field.
+func ToEmailEqualFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEqualFold(FieldToEmail, v))
+}
+
+// ToEmailContainsFold applies the ContainsFold predicate on the "to_email" field.
+func ToEmailContainsFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContainsFold(FieldToEmail, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// TextBodyEQ applies the EQ predicate on the "text_body" field.
+func TextBodyEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldTextBody, v))
+}
+
+// TextBodyNEQ applies the NEQ predicate on the "text_body" field.
+func TextBodyNEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldTextBody, v))
+}
+
+// TextBodyIn applies the In predicate on the "text_body" field.
+func TextBodyIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldTextBody, vs...))
+}
+
+// TextBodyNotIn applies the NotIn predicate on the "text_body" field.
+func TextBodyNotIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldTextBody, vs...))
+}
+
+// TextBodyGT applies the GT predicate on the "text_body" field.
+func TextBodyGT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldTextBody, v))
+}
+
+// TextBodyGTE applies the GTE predicate on the "text_body" field.
+func TextBodyGTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldTextBody, v))
+}
+
+// TextBodyLT applies the LT predicate on the "text_body" field.
+func TextBodyLT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldTextBody, v))
+}
+
+// TextBodyLTE applies the LTE predicate on the "text_body" field.
+func TextBodyLTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldTextBody, v))
+}
+
+// TextBodyContains applies the Contains predicate on the "text_body" field.
+func TextBodyContains(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContains(FieldTextBody, v))
+}
+
+// TextBodyHasPrefix applies the HasPrefix predicate on the "text_body" field.
+func TextBodyHasPrefix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasPrefix(FieldTextBody, v))
+}
+
+// TextBodyHasSuffix applies the HasSuffix predicate on the "text_body" field.
+func TextBodyHasSuffix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasSuffix(FieldTextBody, v))
+}
+
+// TextBodyEqualFold applies the EqualFold predicate on the "text_body" field.
+func TextBodyEqualFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEqualFold(FieldTextBody, v))
+}
+
+// TextBodyContainsFold applies the ContainsFold predicate on the "text_body" field.
+func TextBodyContainsFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContainsFold(FieldTextBody, v))
+}
+
+// HTMLBodyEQ applies the EQ predicate on the "html_body" field.
+func HTMLBodyEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldHTMLBody, v))
+}
+
+// HTMLBodyNEQ applies the NEQ predicate on the "html_body" field.
+func HTMLBodyNEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldHTMLBody, v))
+}
+
+// HTMLBodyIn applies the In predicate on the "html_body" field.
+func HTMLBodyIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldHTMLBody, vs...))
+}
+
+// HTMLBodyNotIn applies the NotIn predicate on the "html_body" field.
+func HTMLBodyNotIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldHTMLBody, vs...))
+}
+
+// HTMLBodyGT applies the GT predicate on the "html_body" field.
+func HTMLBodyGT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldHTMLBody, v))
+}
+
+// HTMLBodyGTE applies the GTE predicate on the "html_body" field.
+func HTMLBodyGTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldHTMLBody, v))
+}
+
+// HTMLBodyLT applies the LT predicate on the "html_body" field.
+func HTMLBodyLT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldHTMLBody, v))
+}
+
+// HTMLBodyLTE applies the LTE predicate on the "html_body" field.
+func HTMLBodyLTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldHTMLBody, v))
+}
+
+// HTMLBodyContains applies the Contains predicate on the "html_body" field.
+func HTMLBodyContains(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContains(FieldHTMLBody, v))
+}
+
+// HTMLBodyHasPrefix applies the HasPrefix predicate on the "html_body" field.
+func HTMLBodyHasPrefix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasPrefix(FieldHTMLBody, v))
+}
+
+// HTMLBodyHasSuffix applies the HasSuffix predicate on the "html_body" field.
+func HTMLBodyHasSuffix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasSuffix(FieldHTMLBody, v))
+}
+
+// HTMLBodyIsNil applies the IsNil predicate on the "html_body" field.
+func HTMLBodyIsNil() predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIsNull(FieldHTMLBody))
+}
+
+// HTMLBodyNotNil applies the NotNil predicate on the "html_body" field.
+func HTMLBodyNotNil() predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotNull(FieldHTMLBody))
+}
+
+// HTMLBodyEqualFold applies the EqualFold predicate on the "html_body" field.
+func HTMLBodyEqualFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEqualFold(FieldHTMLBody, v))
+}
+
+// HTMLBodyContainsFold applies the ContainsFold predicate on the "html_body" field.
+func HTMLBodyContainsFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContainsFold(FieldHTMLBody, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotNull(FieldSentAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MailMessage {
+	return predicate.MailMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MailMessage) predicate.MailMessage {
+	return predicate.MailMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MailMessage) predicate.MailMessage {
+	return predicate.MailMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MailMessage) predicate.MailMessage {
+	return predicate.MailMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/mailmessage"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MailMessageCreate is the builder for creating a MailMessage entity.
+type MailMessageCreate struct {
+	config
+	mutation *MailMessageMutation
+	hooks    []Hook
+}
+
+// SetToEmail sets the "to_email" field.
+func (_c *MailMessageCreate) SetToEmail(v string) *MailMessageCreate {
+	_c.mutation.SetToEmail(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *MailMessageCreate) SetSubject(v string) *MailMessageCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetTextBody sets the "text_body" field.
+func (_c *MailMessageCreate) SetTextBody(v string) *MailMessageCreate {
+	_c.mutation.SetTextBody(v)
+	return _c
+}
+
+// SetHTMLBody sets the "html_body" field.
+func (_c *MailMessageCreate) SetHTMLBody(v string) *MailMessageCreate {
+	_c.mutation.SetHTMLBody(v)
+	return _c
+}
+
+// SetNillableHTMLBody sets the "html_body" field if the given value is not nil.
+func (_c *MailMessageCreate) SetNillableHTMLBody(v *string) *MailMessageCreate {
+	if v != nil {
+		_c.SetHTMLBody(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *MailMessageCreate) SetStatus(v mailmessage.Status) *MailMessageCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *MailMessageCreate) SetNillableStatus(v *mailmessage.Status) *MailMessageCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *MailMessageCreate) SetAttempts(v int) *MailMessageCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *MailMessageCreate) SetNillableAttempts(v *int) *MailMessageCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *MailMessageCreate) SetNextAttemptAt(v time.Time) *MailMessageCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *MailMessageCreate) SetNillableNextAttemptAt(v *time.Time) *MailMessageCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *MailMessageCreate) SetLastError(v string) *MailMessageCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *MailMessageCreate) SetNillableLastError(v *string) *MailMessageCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *MailMessageCreate) SetSentAt(v time.Time) *MailMessageCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *MailMessageCreate) SetNillableSentAt(v *time.Time) *MailMessageCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MailMessageCreate) SetCreatedAt(v time.Time) *MailMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MailMessageCreate) SetNillableCreatedAt(v *time.Time) *MailMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MailMessageCreate) SetID(v uuid.UUID) *MailMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MailMessageCreate) SetNillableID(v *uuid.UUID) *MailMessageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the MailMessageMutation object of the builder.
+func (_c *MailMessageCreate) Mutation() *MailMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the MailMessage in the database.
+func (_c *MailMessageCreate) Save(ctx context.Context) (*MailMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MailMessageCreate) SaveX(ctx context.Context) *MailMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MailMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MailMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MailMessageCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := mailmessage.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := mailmessage.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := mailmessage.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := mailmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := mailmessage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MailMessageCreate) check() error {
+	if _, ok := _c.mutation.ToEmail(); !ok {
+		return &ValidationError{Name: "to_email", err: errors.New(`ent: missing required field "MailMessage.to_email"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "MailMessage.subject"`)}
+	}
+	if _, ok := _c.mutation.TextBody(); !ok {
+		return &ValidationError{Name: "text_body", err: errors.New(`ent: missing required field "MailMessage.text_body"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MailMessage.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := mailmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MailMessage.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "MailMessage.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "MailMessage.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MailMessage.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *MailMessageCreate) sqlSave(ctx context.Context) (*MailMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MailMessageCreate) createSpec() (*MailMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MailMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mailmessage.Table, sqlgraph.NewFieldSpec(mailmessage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ToEmail(); ok {
+		_spec.SetField(mailmessage.FieldToEmail, field.TypeString, value)
+		_node.ToEmail = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(mailmessage.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.TextBody(); ok {
+		_spec.SetField(mailmessage.FieldTextBody, field.TypeString, value)
+		_node.TextBody = value
+	}
+	if value, ok := _c.mutation.HTMLBody(); ok {
+		_spec.SetField(mailmessage.FieldHTMLBody, field.TypeString, value)
+		_node.HTMLBody = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(mailmessage.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(mailmessage.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(mailmessage.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(mailmessage.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(mailmessage.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(mailmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// MailMessageCreateBulk is the builder for creating many MailMessage entities in bulk.
+type MailMessageCreateBulk struct {
+	config
+	err      error
+	builders []*MailMessageCreate
+}
+
+// Save creates the MailMessage entities in the database.
+func (_c *MailMessageCreateBulk) Save(ctx context.Context) ([]*MailMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MailMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MailMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MailMessageCreateBulk) SaveX(ctx context.Context) []*MailMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MailMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MailMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
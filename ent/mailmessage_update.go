@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/mailmessage"
+	"project-manager-dashboard-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MailMessageUpdate is the builder for updating MailMessage entities.
+type MailMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MailMessageMutation
+}
+
+// Where appends a list predicates to the MailMessageUpdate builder.
+func (_u *MailMessageUpdate) Where(ps ...predicate.MailMessage) *MailMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MailMessageUpdate) SetStatus(v mailmessage.Status) *MailMessageUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MailMessageUpdate) SetNillableStatus(v *mailmessage.Status) *MailMessageUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *MailMessageUpdate) SetAttempts(v int) *MailMessageUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *MailMessageUpdate) SetNillableAttempts(v *int) *MailMessageUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *MailMessageUpdate) AddAttempts(v int) *MailMessageUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *MailMessageUpdate) SetNextAttemptAt(v time.Time) *MailMessageUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *MailMessageUpdate) SetNillableNextAttemptAt(v *time.Time) *MailMessageUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *MailMessageUpdate) SetLastError(v string) *MailMessageUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *MailMessageUpdate) SetNillableLastError(v *string) *MailMessageUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *MailMessageUpdate) ClearLastError() *MailMessageUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *MailMessageUpdate) SetSentAt(v time.Time) *MailMessageUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *MailMessageUpdate) SetNillableSentAt(v *time.Time) *MailMessageUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *MailMessageUpdate) ClearSentAt() *MailMessageUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the MailMessageMutation object of the builder.
+func (_u *MailMessageUpdate) Mutation() *MailMessageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MailMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MailMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MailMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MailMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MailMessageUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := mailmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MailMessage.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MailMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mailmessage.Table, mailmessage.Columns, sqlgraph.NewFieldSpec(mailmessage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.HTMLBodyCleared() {
+		_spec.ClearField(mailmessage.FieldHTMLBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(mailmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(mailmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(mailmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(mailmessage.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(mailmessage.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(mailmessage.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(mailmessage.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(mailmessage.FieldSentAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mailmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MailMessageUpdateOne is the builder for updating a single MailMessage entity.
+type MailMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MailMessageMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *MailMessageUpdateOne) SetStatus(v mailmessage.Status) *MailMessageUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MailMessageUpdateOne) SetNillableStatus(v *mailmessage.Status) *MailMessageUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *MailMessageUpdateOne) SetAttempts(v int) *MailMessageUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *MailMessageUpdateOne) SetNillableAttempts(v *int) *MailMessageUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *MailMessageUpdateOne) AddAttempts(v int) *MailMessageUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *MailMessageUpdateOne) SetNextAttemptAt(v time.Time) *MailMessageUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *MailMessageUpdateOne) SetNillableNextAttemptAt(v *time.Time) *MailMessageUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *MailMessageUpdateOne) SetLastError(v string) *MailMessageUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *MailMessageUpdateOne) SetNillableLastError(v *string) *MailMessageUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *MailMessageUpdateOne) ClearLastError() *MailMessageUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *MailMessageUpdateOne) SetSentAt(v time.Time) *MailMessageUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *MailMessageUpdateOne) SetNillableSentAt(v *time.Time) *MailMessageUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *MailMessageUpdateOne) ClearSentAt() *MailMessageUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the MailMessageMutation object of the builder.
+func (_u *MailMessageUpdateOne) Mutation() *MailMessageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MailMessageUpdate builder.
+func (_u *MailMessageUpdateOne) Where(ps ...predicate.MailMessage) *MailMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MailMessageUpdateOne) Select(field string, fields ...string) *MailMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MailMessage entity.
+func (_u *MailMessageUpdateOne) Save(ctx context.Context) (*MailMessage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MailMessageUpdateOne) SaveX(ctx context.Context) *MailMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MailMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MailMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MailMessageUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := mailmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MailMessage.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MailMessageUpdateOne) sqlSave(ctx context.Context) (_node *MailMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mailmessage.Table, mailmessage.Columns, sqlgraph.NewFieldSpec(mailmessage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MailMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mailmessage.FieldID)
+		for _, f := range fields {
+			if !mailmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mailmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.HTMLBodyCleared() {
+		_spec.ClearField(mailmessage.FieldHTMLBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(mailmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(mailmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(mailmessage.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(mailmessage.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(mailmessage.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(mailmessage.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(mailmessage.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(mailmessage.FieldSentAt, field.TypeTime)
+	}
+	_node = &MailMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mailmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
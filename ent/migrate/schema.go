@@ -8,18 +8,239 @@ import (
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"create", "update", "delete"}},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_project_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[7], AuditEventsColumns[8]},
+			},
+			{
+				Name:    "auditevent_entity_type_entity_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[2], AuditEventsColumns[3], AuditEventsColumns[8]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "scope", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "content_type", Type: field.TypeString, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_scope_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2]},
+			},
+			{
+				Name:    "idempotencykey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[7]},
+			},
+		},
+	}
+	// MailMessagesColumns holds the columns for the "mail_messages" table.
+	MailMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "to_email", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "text_body", Type: field.TypeString, Size: 2147483647},
+		{Name: "html_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MailMessagesTable holds the schema information for the "mail_messages" table.
+	MailMessagesTable = &schema.Table{
+		Name:       "mail_messages",
+		Columns:    MailMessagesColumns,
+		PrimaryKey: []*schema.Column{MailMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mailmessage_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{MailMessagesColumns[5], MailMessagesColumns[7]},
+			},
+		},
+	}
+	// MilestonesColumns holds the columns for the "milestones" table.
+	MilestonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "closed"}, Default: "open"},
+		{Name: "target_date", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_milestones", Type: field.TypeUUID},
+	}
+	// MilestonesTable holds the schema information for the "milestones" table.
+	MilestonesTable = &schema.Table{
+		Name:       "milestones",
+		Columns:    MilestonesColumns,
+		PrimaryKey: []*schema.Column{MilestonesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "milestones_projects_milestones",
+				Columns:    []*schema.Column{MilestonesColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "milestone_target_date_project_milestones",
+				Unique:  false,
+				Columns: []*schema.Column{MilestonesColumns[4], MilestonesColumns[7]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"task_assigned", "project_invited", "task_changed", "task_due_soon", "task_overdue", "task_mentioned"}},
+		{Name: "message", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "emailed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_read_at_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[9], NotificationsColumns[6], NotificationsColumns[8]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"task_assigned", "project_invited", "task_changed", "task_due_soon", "task_overdue", "task_mentioned"}},
+		{Name: "in_app", Type: field.TypeBool, Default: true},
+		{Name: "email", Type: field.TypeBool, Default: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_preferences_users_notification_preferences",
+				Columns:    []*schema.Column{NotificationPreferencesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_user_id_type",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[4], NotificationPreferencesColumns[1]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "event_id", Type: field.TypeUUID, Unique: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "aggregate_type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeUUID},
+		{Name: "project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "published_seq", Type: field.TypeInt64, Unique: true, Nullable: true},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_published_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[10], OutboxEventsColumns[0]},
+			},
+		},
+	}
 	// ProjectsColumns holds the columns for the "projects" table.
 	ProjectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
 	ProjectsTable = &schema.Table{
 		Name:       "projects",
 		Columns:    ProjectsColumns,
 		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "project_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[5], ProjectsColumns[0]},
+			},
+		},
 	}
 	// ProjectTasksColumns holds the columns for the "project_tasks" table.
 	ProjectTasksColumns = []*schema.Column{
@@ -61,6 +282,20 @@ var (
 			},
 		},
 	}
+	// ProjectTemplatesColumns holds the columns for the "project_templates" table.
+	ProjectTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ProjectTemplatesTable holds the schema information for the "project_templates" table.
+	ProjectTemplatesTable = &schema.Table{
+		Name:       "project_templates",
+		Columns:    ProjectTemplatesColumns,
+		PrimaryKey: []*schema.Column{ProjectTemplatesColumns[0]},
+	}
 	// ProjectUsersColumns holds the columns for the "project_users" table.
 	ProjectUsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -99,6 +334,8 @@ var (
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"todo", "in_progress", "done"}, Default: "todo"},
@@ -107,7 +344,9 @@ var (
 		{Name: "due_date", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "assignee_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "trashed_project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "trashed_position", Type: field.TypeInt, Nullable: true},
+		{Name: "milestone_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
 	TasksTable = &schema.Table{
@@ -116,19 +355,261 @@ var (
 		PrimaryKey: []*schema.Column{TasksColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "tasks_users_assigned_tasks",
-				Columns:    []*schema.Column{TasksColumns[9]},
-				RefColumns: []*schema.Column{UsersColumns[0]},
+				Symbol:     "tasks_milestones_tasks",
+				Columns:    []*schema.Column{TasksColumns[13]},
+				RefColumns: []*schema.Column{MilestonesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// TaskActivitiesColumns holds the columns for the "task_activities" table.
+	TaskActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"created", "title_changed", "description_changed", "status_changed", "moved", "assigned", "unassigned", "milestone_changed", "deleted", "restored"}},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "from_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "to_value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_activities", Type: field.TypeUUID},
+	}
+	// TaskActivitiesTable holds the schema information for the "task_activities" table.
+	TaskActivitiesTable = &schema.Table{
+		Name:       "task_activities",
+		Columns:    TaskActivitiesColumns,
+		PrimaryKey: []*schema.Column{TaskActivitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_activities_tasks_activities",
+				Columns:    []*schema.Column{TaskActivitiesColumns[6]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskactivity_created_at_task_activities",
+				Unique:  false,
+				Columns: []*schema.Column{TaskActivitiesColumns[5], TaskActivitiesColumns[6]},
+			},
+		},
+	}
+	// TaskAssigneesColumns holds the columns for the "task_assignees" table.
+	TaskAssigneesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "role", Type: field.TypeEnum, Nullable: true, Enums: []string{"owner", "reviewer", "contributor"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_assignees", Type: field.TypeUUID},
+		{Name: "user_task_assignments", Type: field.TypeUUID},
+	}
+	// TaskAssigneesTable holds the schema information for the "task_assignees" table.
+	TaskAssigneesTable = &schema.Table{
+		Name:       "task_assignees",
+		Columns:    TaskAssigneesColumns,
+		PrimaryKey: []*schema.Column{TaskAssigneesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_assignees_tasks_assignees",
+				Columns:    []*schema.Column{TaskAssigneesColumns[3]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "task_assignees_users_task_assignments",
+				Columns:    []*schema.Column{TaskAssigneesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskassignee_task_assignees_user_task_assignments",
+				Unique:  true,
+				Columns: []*schema.Column{TaskAssigneesColumns[3], TaskAssigneesColumns[4]},
+			},
+			{
+				Name:    "taskassignee_user_task_assignments",
+				Unique:  false,
+				Columns: []*schema.Column{TaskAssigneesColumns[4]},
+			},
+		},
+	}
+	// TaskAssignmentEventsColumns holds the columns for the "task_assignment_events" table.
+	TaskAssignmentEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"assigned", "unassigned"}},
+		{Name: "actor_id", Type: field.TypeUUID},
+		{Name: "from_user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "to_user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "role", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_assignment_events", Type: field.TypeUUID},
+	}
+	// TaskAssignmentEventsTable holds the schema information for the "task_assignment_events" table.
+	TaskAssignmentEventsTable = &schema.Table{
+		Name:       "task_assignment_events",
+		Columns:    TaskAssignmentEventsColumns,
+		PrimaryKey: []*schema.Column{TaskAssignmentEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_assignment_events_tasks_assignment_events",
+				Columns:    []*schema.Column{TaskAssignmentEventsColumns[7]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskassignmentevent_created_at_task_assignment_events",
+				Unique:  false,
+				Columns: []*schema.Column{TaskAssignmentEventsColumns[6], TaskAssignmentEventsColumns[7]},
+			},
+		},
+	}
+	// TaskRemindersColumns holds the columns for the "task_reminders" table.
+	TaskRemindersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"due_soon", "overdue"}},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_reminders", Type: field.TypeUUID},
+	}
+	// TaskRemindersTable holds the schema information for the "task_reminders" table.
+	TaskRemindersTable = &schema.Table{
+		Name:       "task_reminders",
+		Columns:    TaskRemindersColumns,
+		PrimaryKey: []*schema.Column{TaskRemindersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_reminders_tasks_reminders",
+				Columns:    []*schema.Column{TaskRemindersColumns[4]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskreminder_kind_task_reminders",
+				Unique:  true,
+				Columns: []*schema.Column{TaskRemindersColumns[1], TaskRemindersColumns[4]},
+			},
+			{
+				Name:    "taskreminder_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TaskRemindersColumns[3]},
+			},
+		},
+	}
+	// TaskWatchersColumns holds the columns for the "task_watchers" table.
+	TaskWatchersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "task_watchers", Type: field.TypeUUID},
+		{Name: "user_watched_tasks", Type: field.TypeUUID},
+	}
+	// TaskWatchersTable holds the schema information for the "task_watchers" table.
+	TaskWatchersTable = &schema.Table{
+		Name:       "task_watchers",
+		Columns:    TaskWatchersColumns,
+		PrimaryKey: []*schema.Column{TaskWatchersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "task_watchers_tasks_watchers",
+				Columns:    []*schema.Column{TaskWatchersColumns[2]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "task_watchers_users_watched_tasks",
+				Columns:    []*schema.Column{TaskWatchersColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskwatcher_task_watchers_user_watched_tasks",
+				Unique:  true,
+				Columns: []*schema.Column{TaskWatchersColumns[2], TaskWatchersColumns[3]},
+			},
+			{
+				Name:    "taskwatcher_user_watched_tasks",
+				Unique:  false,
+				Columns: []*schema.Column{TaskWatchersColumns[3]},
+			},
+		},
+	}
+	// TemplateMembersColumns holds the columns for the "template_members" table.
+	TemplateMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "member", "viewer"}, Default: "member"},
+		{Name: "project_template_members", Type: field.TypeUUID},
+		{Name: "user_template_memberships", Type: field.TypeUUID},
+	}
+	// TemplateMembersTable holds the schema information for the "template_members" table.
+	TemplateMembersTable = &schema.Table{
+		Name:       "template_members",
+		Columns:    TemplateMembersColumns,
+		PrimaryKey: []*schema.Column{TemplateMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "template_members_project_templates_members",
+				Columns:    []*schema.Column{TemplateMembersColumns[2]},
+				RefColumns: []*schema.Column{ProjectTemplatesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "template_members_users_template_memberships",
+				Columns:    []*schema.Column{TemplateMembersColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "templatemember_project_template_members_user_template_memberships",
+				Unique:  true,
+				Columns: []*schema.Column{TemplateMembersColumns[2], TemplateMembersColumns[3]},
+			},
+		},
+	}
+	// TemplateTasksColumns holds the columns for the "template_tasks" table.
+	TemplateTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"low", "medium", "high"}, Default: "medium"},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "due_offset_days", Type: field.TypeInt, Nullable: true},
+		{Name: "project_template_tasks", Type: field.TypeUUID},
+	}
+	// TemplateTasksTable holds the schema information for the "template_tasks" table.
+	TemplateTasksTable = &schema.Table{
+		Name:       "template_tasks",
+		Columns:    TemplateTasksColumns,
+		PrimaryKey: []*schema.Column{TemplateTasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "template_tasks_project_templates_tasks",
+				Columns:    []*schema.Column{TemplateTasksColumns[6]},
+				RefColumns: []*schema.Column{ProjectTemplatesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "templatetask_position_project_template_tasks",
+				Unique:  false,
+				Columns: []*schema.Column{TemplateTasksColumns[4], TemplateTasksColumns[6]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "country", Type: field.TypeString, Nullable: true},
+		{Name: "email_mode", Type: field.TypeEnum, Enums: []string{"instant", "daily", "off"}, Default: "instant"},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -136,21 +617,132 @@ var (
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[5], UsersColumns[0]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeUUID},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_projects_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[10]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "redelivery_of", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "response_code", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[14]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5], WebhookDeliveriesColumns[7]},
+			},
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[14], WebhookDeliveriesColumns[13]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
+		IdempotencyKeysTable,
+		MailMessagesTable,
+		MilestonesTable,
+		NotificationsTable,
+		NotificationPreferencesTable,
+		OutboxEventsTable,
 		ProjectsTable,
 		ProjectTasksTable,
+		ProjectTemplatesTable,
 		ProjectUsersTable,
 		TasksTable,
+		TaskActivitiesTable,
+		TaskAssigneesTable,
+		TaskAssignmentEventsTable,
+		TaskRemindersTable,
+		TaskWatchersTable,
+		TemplateMembersTable,
+		TemplateTasksTable,
 		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
+	MilestonesTable.ForeignKeys[0].RefTable = ProjectsTable
+	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationPreferencesTable.ForeignKeys[0].RefTable = UsersTable
 	ProjectTasksTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProjectTasksTable.ForeignKeys[1].RefTable = TasksTable
 	ProjectUsersTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProjectUsersTable.ForeignKeys[1].RefTable = UsersTable
-	TasksTable.ForeignKeys[0].RefTable = UsersTable
+	TasksTable.ForeignKeys[0].RefTable = MilestonesTable
+	TaskActivitiesTable.ForeignKeys[0].RefTable = TasksTable
+	TaskAssigneesTable.ForeignKeys[0].RefTable = TasksTable
+	TaskAssigneesTable.ForeignKeys[1].RefTable = UsersTable
+	TaskAssignmentEventsTable.ForeignKeys[0].RefTable = TasksTable
+	TaskRemindersTable.ForeignKeys[0].RefTable = TasksTable
+	TaskWatchersTable.ForeignKeys[0].RefTable = TasksTable
+	TaskWatchersTable.ForeignKeys[1].RefTable = UsersTable
+	TemplateMembersTable.ForeignKeys[0].RefTable = ProjectTemplatesTable
+	TemplateMembersTable.ForeignKeys[1].RefTable = UsersTable
+	TemplateTasksTable.ForeignKeys[0].RefTable = ProjectTemplatesTable
+	WebhooksTable.ForeignKeys[0].RefTable = ProjectsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
@@ -0,0 +1,707 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/milestone"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/task"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MilestoneUpdate is the builder for updating Milestone entities.
+type MilestoneUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MilestoneMutation
+}
+
+// Where appends a list predicates to the MilestoneUpdate builder.
+func (_u *MilestoneUpdate) Where(ps ...predicate.Milestone) *MilestoneUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MilestoneUpdate) SetName(v string) *MilestoneUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MilestoneUpdate) SetNillableName(v *string) *MilestoneUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MilestoneUpdate) SetDescription(v string) *MilestoneUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MilestoneUpdate) SetNillableDescription(v *string) *MilestoneUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MilestoneUpdate) ClearDescription() *MilestoneUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MilestoneUpdate) SetStatus(v milestone.Status) *MilestoneUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MilestoneUpdate) SetNillableStatus(v *milestone.Status) *MilestoneUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTargetDate sets the "target_date" field.
+func (_u *MilestoneUpdate) SetTargetDate(v time.Time) *MilestoneUpdate {
+	_u.mutation.SetTargetDate(v)
+	return _u
+}
+
+// SetNillableTargetDate sets the "target_date" field if the given value is not nil.
+func (_u *MilestoneUpdate) SetNillableTargetDate(v *time.Time) *MilestoneUpdate {
+	if v != nil {
+		_u.SetTargetDate(*v)
+	}
+	return _u
+}
+
+// ClearTargetDate clears the value of the "target_date" field.
+func (_u *MilestoneUpdate) ClearTargetDate() *MilestoneUpdate {
+	_u.mutation.ClearTargetDate()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MilestoneUpdate) SetCreatedAt(v time.Time) *MilestoneUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MilestoneUpdate) SetNillableCreatedAt(v *time.Time) *MilestoneUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MilestoneUpdate) SetUpdatedAt(v time.Time) *MilestoneUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (_u *MilestoneUpdate) SetProjectID(id uuid.UUID) *MilestoneUpdate {
+	_u.mutation.SetProjectID(id)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *MilestoneUpdate) SetProject(v *Project) *MilestoneUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (_u *MilestoneUpdate) AddTaskIDs(ids ...uuid.UUID) *MilestoneUpdate {
+	_u.mutation.AddTaskIDs(ids...)
+	return _u
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (_u *MilestoneUpdate) AddTasks(v ...*Task) *MilestoneUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaskIDs(ids...)
+}
+
+// Mutation returns the MilestoneMutation object of the builder.
+func (_u *MilestoneUpdate) Mutation() *MilestoneMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *MilestoneUpdate) ClearProject() *MilestoneUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (_u *MilestoneUpdate) ClearTasks() *MilestoneUpdate {
+	_u.mutation.ClearTasks()
+	return _u
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (_u *MilestoneUpdate) RemoveTaskIDs(ids ...uuid.UUID) *MilestoneUpdate {
+	_u.mutation.RemoveTaskIDs(ids...)
+	return _u
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (_u *MilestoneUpdate) RemoveTasks(v ...*Task) *MilestoneUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MilestoneUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MilestoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MilestoneUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MilestoneUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MilestoneUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := milestone.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MilestoneUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := milestone.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Milestone.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Milestone.project"`)
+	}
+	return nil
+}
+
+func (_u *MilestoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(milestone.Table, milestone.Columns, sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(milestone.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(milestone.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(milestone.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(milestone.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetDate(); ok {
+		_spec.SetField(milestone.FieldTargetDate, field.TypeTime, value)
+	}
+	if _u.mutation.TargetDateCleared() {
+		_spec.ClearField(milestone.FieldTargetDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(milestone.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(milestone.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTasksIDs(); len(nodes) > 0 && !_u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{milestone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MilestoneUpdateOne is the builder for updating a single Milestone entity.
+type MilestoneUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MilestoneMutation
+}
+
+// SetName sets the "name" field.
+func (_u *MilestoneUpdateOne) SetName(v string) *MilestoneUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MilestoneUpdateOne) SetNillableName(v *string) *MilestoneUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *MilestoneUpdateOne) SetDescription(v string) *MilestoneUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *MilestoneUpdateOne) SetNillableDescription(v *string) *MilestoneUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *MilestoneUpdateOne) ClearDescription() *MilestoneUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MilestoneUpdateOne) SetStatus(v milestone.Status) *MilestoneUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MilestoneUpdateOne) SetNillableStatus(v *milestone.Status) *MilestoneUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTargetDate sets the "target_date" field.
+func (_u *MilestoneUpdateOne) SetTargetDate(v time.Time) *MilestoneUpdateOne {
+	_u.mutation.SetTargetDate(v)
+	return _u
+}
+
+// SetNillableTargetDate sets the "target_date" field if the given value is not nil.
+func (_u *MilestoneUpdateOne) SetNillableTargetDate(v *time.Time) *MilestoneUpdateOne {
+	if v != nil {
+		_u.SetTargetDate(*v)
+	}
+	return _u
+}
+
+// ClearTargetDate clears the value of the "target_date" field.
+func (_u *MilestoneUpdateOne) ClearTargetDate() *MilestoneUpdateOne {
+	_u.mutation.ClearTargetDate()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MilestoneUpdateOne) SetCreatedAt(v time.Time) *MilestoneUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MilestoneUpdateOne) SetNillableCreatedAt(v *time.Time) *MilestoneUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MilestoneUpdateOne) SetUpdatedAt(v time.Time) *MilestoneUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProjectID sets the "project" edge to the Project entity by ID.
+func (_u *MilestoneUpdateOne) SetProjectID(id uuid.UUID) *MilestoneUpdateOne {
+	_u.mutation.SetProjectID(id)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *MilestoneUpdateOne) SetProject(v *Project) *MilestoneUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (_u *MilestoneUpdateOne) AddTaskIDs(ids ...uuid.UUID) *MilestoneUpdateOne {
+	_u.mutation.AddTaskIDs(ids...)
+	return _u
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (_u *MilestoneUpdateOne) AddTasks(v ...*Task) *MilestoneUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaskIDs(ids...)
+}
+
+// Mutation returns the MilestoneMutation object of the builder.
+func (_u *MilestoneUpdateOne) Mutation() *MilestoneMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *MilestoneUpdateOne) ClearProject() *MilestoneUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (_u *MilestoneUpdateOne) ClearTasks() *MilestoneUpdateOne {
+	_u.mutation.ClearTasks()
+	return _u
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (_u *MilestoneUpdateOne) RemoveTaskIDs(ids ...uuid.UUID) *MilestoneUpdateOne {
+	_u.mutation.RemoveTaskIDs(ids...)
+	return _u
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (_u *MilestoneUpdateOne) RemoveTasks(v ...*Task) *MilestoneUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the MilestoneUpdate builder.
+func (_u *MilestoneUpdateOne) Where(ps ...predicate.Milestone) *MilestoneUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MilestoneUpdateOne) Select(field string, fields ...string) *MilestoneUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Milestone entity.
+func (_u *MilestoneUpdateOne) Save(ctx context.Context) (*Milestone, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MilestoneUpdateOne) SaveX(ctx context.Context) *Milestone {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MilestoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MilestoneUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MilestoneUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := milestone.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MilestoneUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := milestone.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Milestone.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Milestone.project"`)
+	}
+	return nil
+}
+
+func (_u *MilestoneUpdateOne) sqlSave(ctx context.Context) (_node *Milestone, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(milestone.Table, milestone.Columns, sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Milestone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, milestone.FieldID)
+		for _, f := range fields {
+			if !milestone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != milestone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(milestone.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(milestone.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(milestone.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(milestone.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetDate(); ok {
+		_spec.SetField(milestone.FieldTargetDate, field.TypeTime, value)
+	}
+	if _u.mutation.TargetDateCleared() {
+		_spec.ClearField(milestone.FieldTargetDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(milestone.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(milestone.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   milestone.ProjectTable,
+			Columns: []string{milestone.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTasksIDs(); len(nodes) > 0 && !_u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   milestone.TasksTable,
+			Columns: []string{milestone.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Milestone{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{milestone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/notification"
+	"project-manager-dashboard-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (_u *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *NotificationUpdate) SetReadAt(v time.Time) *NotificationUpdate {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableReadAt(v *time.Time) *NotificationUpdate {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// SetEmailedAt sets the "emailed_at" field.
+func (_u *NotificationUpdate) SetEmailedAt(v time.Time) *NotificationUpdate {
+	_u.mutation.SetEmailedAt(v)
+	return _u
+}
+
+// SetNillableEmailedAt sets the "emailed_at" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableEmailedAt(v *time.Time) *NotificationUpdate {
+	if v != nil {
+		_u.SetEmailedAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailedAt clears the value of the "emailed_at" field.
+func (_u *NotificationUpdate) ClearEmailedAt() *NotificationUpdate {
+	_u.mutation.ClearEmailedAt()
+	return _u
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (_u *NotificationUpdate) Mutation() *NotificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(notification.FieldActorID, field.TypeUUID)
+	}
+	if _u.mutation.ProjectIDCleared() {
+		_spec.ClearField(notification.FieldProjectID, field.TypeUUID)
+	}
+	if _u.mutation.TaskIDCleared() {
+		_spec.ClearField(notification.FieldTaskID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmailedAt(); ok {
+		_spec.SetField(notification.FieldEmailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailedAtCleared() {
+		_spec.ClearField(notification.FieldEmailedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *NotificationUpdateOne) SetReadAt(v time.Time) *NotificationUpdateOne {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableReadAt(v *time.Time) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// SetEmailedAt sets the "emailed_at" field.
+func (_u *NotificationUpdateOne) SetEmailedAt(v time.Time) *NotificationUpdateOne {
+	_u.mutation.SetEmailedAt(v)
+	return _u
+}
+
+// SetNillableEmailedAt sets the "emailed_at" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableEmailedAt(v *time.Time) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetEmailedAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailedAt clears the value of the "emailed_at" field.
+func (_u *NotificationUpdateOne) ClearEmailedAt() *NotificationUpdateOne {
+	_u.mutation.ClearEmailedAt()
+	return _u
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (_u *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (_u *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (_u *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notification.user"`)
+	}
+	return nil
+}
+
+func (_u *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(notification.FieldActorID, field.TypeUUID)
+	}
+	if _u.mutation.ProjectIDCleared() {
+		_spec.ClearField(notification.FieldProjectID, field.TypeUUID)
+	}
+	if _u.mutation.TaskIDCleared() {
+		_spec.ClearField(notification.FieldTaskID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EmailedAt(); ok {
+		_spec.SetField(notification.FieldEmailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailedAtCleared() {
+		_spec.ClearField(notification.FieldEmailedAt, field.TypeTime)
+	}
+	_node = &Notification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
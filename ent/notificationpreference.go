@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/notificationpreference"
+	"project-manager-dashboard-go/ent/user"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// NotificationPreference is the model entity for the NotificationPreference schema.
+type NotificationPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type notificationpreference.Type `json:"type,omitempty"`
+	// InApp holds the value of the "in_app" field.
+	InApp bool `json:"in_app,omitempty"`
+	// Email holds the value of the "email" field.
+	Email bool `json:"email,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationPreferenceQuery when eager-loading is set.
+	Edges        NotificationPreferenceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NotificationPreferenceEdges holds the relations/edges for other nodes in the graph.
+type NotificationPreferenceEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationPreferenceEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationPreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldInApp, notificationpreference.FieldEmail:
+			values[i] = new(sql.NullBool)
+		case notificationpreference.FieldType:
+			values[i] = new(sql.NullString)
+		case notificationpreference.FieldID, notificationpreference.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationPreference fields.
+func (_m *NotificationPreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationpreference.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case notificationpreference.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case notificationpreference.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = notificationpreference.Type(value.String)
+			}
+		case notificationpreference.FieldInApp:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field in_app", values[i])
+			} else if value.Valid {
+				_m.InApp = value.Bool
+			}
+		case notificationpreference.FieldEmail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationPreference.
+// This includes values selected through modifiers, order, etc.
+func (_m *NotificationPreference) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the NotificationPreference entity.
+func (_m *NotificationPreference) QueryUser() *UserQuery {
+	return NewNotificationPreferenceClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this NotificationPreference.
+// Note that you need to call NotificationPreference.Unwrap() before calling this method if this NotificationPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *NotificationPreference) Update() *NotificationPreferenceUpdateOne {
+	return NewNotificationPreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the NotificationPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *NotificationPreference) Unwrap() *NotificationPreference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationPreference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *NotificationPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("in_app=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InApp))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Email))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationPreferences is a parsable slice of NotificationPreference.
+type NotificationPreferences []*NotificationPreference
@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationpreference
+
+import (
+	"project-manager-dashboard-go/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldUserID, v))
+}
+
+// InApp applies equality check predicate on the "in_app" field. It's identical to InAppEQ.
+func InApp(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldInApp, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldEmail, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNotIn(FieldType, vs...))
+}
+
+// InAppEQ applies the EQ predicate on the "in_app" field.
+func InAppEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldInApp, v))
+}
+
+// InAppNEQ applies the NEQ predicate on the "in_app" field.
+func InAppNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldInApp, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v bool) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.FieldNEQ(FieldEmail, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.NotificationPreference {
+	return predicate.NotificationPreference(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationPreference) predicate.NotificationPreference {
+	return predicate.NotificationPreference(sql.NotPredicates(p))
+}
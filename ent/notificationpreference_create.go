@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/notificationpreference"
+	"project-manager-dashboard-go/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationPreferenceCreate is the builder for creating a NotificationPreference entity.
+type NotificationPreferenceCreate struct {
+	config
+	mutation *NotificationPreferenceMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *NotificationPreferenceCreate) SetUserID(v uuid.UUID) *NotificationPreferenceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *NotificationPreferenceCreate) SetType(v notificationpreference.Type) *NotificationPreferenceCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetInApp sets the "in_app" field.
+func (_c *NotificationPreferenceCreate) SetInApp(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetInApp(v)
+	return _c
+}
+
+// SetNillableInApp sets the "in_app" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableInApp(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetInApp(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *NotificationPreferenceCreate) SetEmail(v bool) *NotificationPreferenceCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableEmail(v *bool) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationPreferenceCreate) SetID(v uuid.UUID) *NotificationPreferenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotificationPreferenceCreate) SetNillableID(v *uuid.UUID) *NotificationPreferenceCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *NotificationPreferenceCreate) SetUser(v *User) *NotificationPreferenceCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the NotificationPreferenceMutation object of the builder.
+func (_c *NotificationPreferenceCreate) Mutation() *NotificationPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the NotificationPreference in the database.
+func (_c *NotificationPreferenceCreate) Save(ctx context.Context) (*NotificationPreference, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationPreferenceCreate) SaveX(ctx context.Context) *NotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationPreferenceCreate) defaults() {
+	if _, ok := _c.mutation.InApp(); !ok {
+		v := notificationpreference.DefaultInApp
+		_c.mutation.SetInApp(v)
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		v := notificationpreference.DefaultEmail
+		_c.mutation.SetEmail(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := notificationpreference.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationPreferenceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "NotificationPreference.user_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "NotificationPreference.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := notificationpreference.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotificationPreference.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InApp(); !ok {
+		return &ValidationError{Name: "in_app", err: errors.New(`ent: missing required field "NotificationPreference.in_app"`)}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "NotificationPreference.email"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "NotificationPreference.user"`)}
+	}
+	return nil
+}
+
+func (_c *NotificationPreferenceCreate) sqlSave(ctx context.Context) (*NotificationPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationPreferenceCreate) createSpec() (*NotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notificationpreference.Table, sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(notificationpreference.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.InApp(); ok {
+		_spec.SetField(notificationpreference.FieldInApp, field.TypeBool, value)
+		_node.InApp = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(notificationpreference.FieldEmail, field.TypeBool, value)
+		_node.Email = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationpreference.UserTable,
+			Columns: []string{notificationpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationPreferenceCreateBulk is the builder for creating many NotificationPreference entities in bulk.
+type NotificationPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationPreferenceCreate
+}
+
+// Save creates the NotificationPreference entities in the database.
+func (_c *NotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*NotificationPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*NotificationPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*NotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
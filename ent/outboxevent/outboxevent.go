@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package outboxevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the outboxevent type in the database.
+	Label = "outbox_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldAggregateType holds the string denoting the aggregate_type field in the database.
+	FieldAggregateType = "aggregate_type"
+	// FieldAggregateID holds the string denoting the aggregate_id field in the database.
+	FieldAggregateID = "aggregate_id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldPublishedSeq holds the string denoting the published_seq field in the database.
+	FieldPublishedSeq = "published_seq"
+	// FieldPublishedAt holds the string denoting the published_at field in the database.
+	FieldPublishedAt = "published_at"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// Table holds the table name of the outboxevent in the database.
+	Table = "outbox_events"
+)
+
+// Columns holds all SQL columns for outboxevent fields.
+var Columns = []string{
+	FieldID,
+	FieldEventID,
+	FieldType,
+	FieldAggregateType,
+	FieldAggregateID,
+	FieldProjectID,
+	FieldActorID,
+	FieldPayload,
+	FieldCreatedAt,
+	FieldPublishedSeq,
+	FieldPublishedAt,
+	FieldAttempts,
+	FieldLastError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEventID holds the default value on creation for the "event_id" field.
+	DefaultEventID func() uuid.UUID
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+)
+
+// OrderOption defines the ordering options for the OutboxEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByAggregateType orders the results by the aggregate_type field.
+func ByAggregateType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAggregateType, opts...).ToFunc()
+}
+
+// ByAggregateID orders the results by the aggregate_id field.
+func ByAggregateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAggregateID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
+// ByPayload orders the results by the payload field.
+func ByPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayload, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPublishedSeq orders the results by the published_seq field.
+func ByPublishedSeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedSeq, opts...).ToFunc()
+}
+
+// ByPublishedAt orders the results by the published_at field.
+func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
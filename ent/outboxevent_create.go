@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/outboxevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OutboxEventCreate is the builder for creating a OutboxEvent entity.
+type OutboxEventCreate struct {
+	config
+	mutation *OutboxEventMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *OutboxEventCreate) SetEventID(v uuid.UUID) *OutboxEventCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_c *OutboxEventCreate) SetNillableEventID(v *uuid.UUID) *OutboxEventCreate {
+	if v != nil {
+		_c.SetEventID(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *OutboxEventCreate) SetType(v string) *OutboxEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetAggregateType sets the "aggregate_type" field.
+func (_c *OutboxEventCreate) SetAggregateType(v string) *OutboxEventCreate {
+	_c.mutation.SetAggregateType(v)
+	return _c
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (_c *OutboxEventCreate) SetAggregateID(v uuid.UUID) *OutboxEventCreate {
+	_c.mutation.SetAggregateID(v)
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *OutboxEventCreate) SetProjectID(v uuid.UUID) *OutboxEventCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_c *OutboxEventCreate) SetNillableProjectID(v *uuid.UUID) *OutboxEventCreate {
+	if v != nil {
+		_c.SetProjectID(*v)
+	}
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *OutboxEventCreate) SetActorID(v uuid.UUID) *OutboxEventCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *OutboxEventCreate) SetNillableActorID(v *uuid.UUID) *OutboxEventCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *OutboxEventCreate) SetPayload(v string) *OutboxEventCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OutboxEventCreate) SetCreatedAt(v time.Time) *OutboxEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OutboxEventCreate) SetNillableCreatedAt(v *time.Time) *OutboxEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPublishedSeq sets the "published_seq" field.
+func (_c *OutboxEventCreate) SetPublishedSeq(v int64) *OutboxEventCreate {
+	_c.mutation.SetPublishedSeq(v)
+	return _c
+}
+
+// SetNillablePublishedSeq sets the "published_seq" field if the given value is not nil.
+func (_c *OutboxEventCreate) SetNillablePublishedSeq(v *int64) *OutboxEventCreate {
+	if v != nil {
+		_c.SetPublishedSeq(*v)
+	}
+	return _c
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_c *OutboxEventCreate) SetPublishedAt(v time.Time) *OutboxEventCreate {
+	_c.mutation.SetPublishedAt(v)
+	return _c
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_c *OutboxEventCreate) SetNillablePublishedAt(v *time.Time) *OutboxEventCreate {
+	if v != nil {
+		_c.SetPublishedAt(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *OutboxEventCreate) SetAttempts(v int) *OutboxEventCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *OutboxEventCreate) SetNillableAttempts(v *int) *OutboxEventCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *OutboxEventCreate) SetLastError(v string) *OutboxEventCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *OutboxEventCreate) SetNillableLastError(v *string) *OutboxEventCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OutboxEventCreate) SetID(v int64) *OutboxEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (_c *OutboxEventCreate) Mutation() *OutboxEventMutation {
+	return _c.mutation
+}
+
+// Save creates the OutboxEvent in the database.
+func (_c *OutboxEventCreate) Save(ctx context.Context) (*OutboxEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OutboxEventCreate) SaveX(ctx context.Context) *OutboxEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OutboxEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OutboxEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OutboxEventCreate) defaults() {
+	if _, ok := _c.mutation.EventID(); !ok {
+		v := outboxevent.DefaultEventID()
+		_c.mutation.SetEventID(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := outboxevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := outboxevent.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OutboxEventCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "OutboxEvent.event_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "OutboxEvent.type"`)}
+	}
+	if _, ok := _c.mutation.AggregateType(); !ok {
+		return &ValidationError{Name: "aggregate_type", err: errors.New(`ent: missing required field "OutboxEvent.aggregate_type"`)}
+	}
+	if _, ok := _c.mutation.AggregateID(); !ok {
+		return &ValidationError{Name: "aggregate_id", err: errors.New(`ent: missing required field "OutboxEvent.aggregate_id"`)}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "OutboxEvent.payload"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboxEvent.created_at"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OutboxEvent.attempts"`)}
+	}
+	return nil
+}
+
+func (_c *OutboxEventCreate) sqlSave(ctx context.Context) (*OutboxEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OutboxEventCreate) createSpec() (*OutboxEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(outboxevent.Table, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt64))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(outboxevent.FieldEventID, field.TypeUUID, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(outboxevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.AggregateType(); ok {
+		_spec.SetField(outboxevent.FieldAggregateType, field.TypeString, value)
+		_node.AggregateType = value
+	}
+	if value, ok := _c.mutation.AggregateID(); ok {
+		_spec.SetField(outboxevent.FieldAggregateID, field.TypeUUID, value)
+		_node.AggregateID = value
+	}
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(outboxevent.FieldProjectID, field.TypeUUID, value)
+		_node.ProjectID = &value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(outboxevent.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.PublishedSeq(); ok {
+		_spec.SetField(outboxevent.FieldPublishedSeq, field.TypeInt64, value)
+		_node.PublishedSeq = &value
+	}
+	if value, ok := _c.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	return _node, _spec
+}
+
+// OutboxEventCreateBulk is the builder for creating many OutboxEvent entities in bulk.
+type OutboxEventCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxEventCreate
+}
+
+// Save creates the OutboxEvent entities in the database.
+func (_c *OutboxEventCreateBulk) Save(ctx context.Context) ([]*OutboxEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OutboxEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OutboxEventCreateBulk) SaveX(ctx context.Context) []*OutboxEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OutboxEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OutboxEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/outboxevent"
+	"project-manager-dashboard-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxEventUpdate is the builder for updating OutboxEvent entities.
+type OutboxEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (_u *OutboxEventUpdate) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPublishedSeq sets the "published_seq" field.
+func (_u *OutboxEventUpdate) SetPublishedSeq(v int64) *OutboxEventUpdate {
+	_u.mutation.ResetPublishedSeq()
+	_u.mutation.SetPublishedSeq(v)
+	return _u
+}
+
+// SetNillablePublishedSeq sets the "published_seq" field if the given value is not nil.
+func (_u *OutboxEventUpdate) SetNillablePublishedSeq(v *int64) *OutboxEventUpdate {
+	if v != nil {
+		_u.SetPublishedSeq(*v)
+	}
+	return _u
+}
+
+// AddPublishedSeq adds value to the "published_seq" field.
+func (_u *OutboxEventUpdate) AddPublishedSeq(v int64) *OutboxEventUpdate {
+	_u.mutation.AddPublishedSeq(v)
+	return _u
+}
+
+// ClearPublishedSeq clears the value of the "published_seq" field.
+func (_u *OutboxEventUpdate) ClearPublishedSeq() *OutboxEventUpdate {
+	_u.mutation.ClearPublishedSeq()
+	return _u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_u *OutboxEventUpdate) SetPublishedAt(v time.Time) *OutboxEventUpdate {
+	_u.mutation.SetPublishedAt(v)
+	return _u
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_u *OutboxEventUpdate) SetNillablePublishedAt(v *time.Time) *OutboxEventUpdate {
+	if v != nil {
+		_u.SetPublishedAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (_u *OutboxEventUpdate) ClearPublishedAt() *OutboxEventUpdate {
+	_u.mutation.ClearPublishedAt()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *OutboxEventUpdate) SetAttempts(v int) *OutboxEventUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OutboxEventUpdate) SetNillableAttempts(v *int) *OutboxEventUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OutboxEventUpdate) AddAttempts(v int) *OutboxEventUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *OutboxEventUpdate) SetLastError(v string) *OutboxEventUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *OutboxEventUpdate) SetNillableLastError(v *string) *OutboxEventUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *OutboxEventUpdate) ClearLastError() *OutboxEventUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (_u *OutboxEventUpdate) Mutation() *OutboxEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OutboxEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutboxEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OutboxEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutboxEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *OutboxEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ProjectIDCleared() {
+		_spec.ClearField(outboxevent.FieldProjectID, field.TypeUUID)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(outboxevent.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.PublishedSeq(); ok {
+		_spec.SetField(outboxevent.FieldPublishedSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPublishedSeq(); ok {
+		_spec.AddField(outboxevent.FieldPublishedSeq, field.TypeInt64, value)
+	}
+	if _u.mutation.PublishedSeqCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedSeq, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedAtCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxevent.FieldLastError, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OutboxEventUpdateOne is the builder for updating a single OutboxEvent entity.
+type OutboxEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// SetPublishedSeq sets the "published_seq" field.
+func (_u *OutboxEventUpdateOne) SetPublishedSeq(v int64) *OutboxEventUpdateOne {
+	_u.mutation.ResetPublishedSeq()
+	_u.mutation.SetPublishedSeq(v)
+	return _u
+}
+
+// SetNillablePublishedSeq sets the "published_seq" field if the given value is not nil.
+func (_u *OutboxEventUpdateOne) SetNillablePublishedSeq(v *int64) *OutboxEventUpdateOne {
+	if v != nil {
+		_u.SetPublishedSeq(*v)
+	}
+	return _u
+}
+
+// AddPublishedSeq adds value to the "published_seq" field.
+func (_u *OutboxEventUpdateOne) AddPublishedSeq(v int64) *OutboxEventUpdateOne {
+	_u.mutation.AddPublishedSeq(v)
+	return _u
+}
+
+// ClearPublishedSeq clears the value of the "published_seq" field.
+func (_u *OutboxEventUpdateOne) ClearPublishedSeq() *OutboxEventUpdateOne {
+	_u.mutation.ClearPublishedSeq()
+	return _u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (_u *OutboxEventUpdateOne) SetPublishedAt(v time.Time) *OutboxEventUpdateOne {
+	_u.mutation.SetPublishedAt(v)
+	return _u
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (_u *OutboxEventUpdateOne) SetNillablePublishedAt(v *time.Time) *OutboxEventUpdateOne {
+	if v != nil {
+		_u.SetPublishedAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (_u *OutboxEventUpdateOne) ClearPublishedAt() *OutboxEventUpdateOne {
+	_u.mutation.ClearPublishedAt()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *OutboxEventUpdateOne) SetAttempts(v int) *OutboxEventUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OutboxEventUpdateOne) SetNillableAttempts(v *int) *OutboxEventUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OutboxEventUpdateOne) AddAttempts(v int) *OutboxEventUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *OutboxEventUpdateOne) SetLastError(v string) *OutboxEventUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *OutboxEventUpdateOne) SetNillableLastError(v *string) *OutboxEventUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *OutboxEventUpdateOne) ClearLastError() *OutboxEventUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (_u *OutboxEventUpdateOne) Mutation() *OutboxEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (_u *OutboxEventUpdateOne) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OutboxEventUpdateOne) Select(field string, fields ...string) *OutboxEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OutboxEvent entity.
+func (_u *OutboxEventUpdateOne) Save(ctx context.Context) (*OutboxEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutboxEventUpdateOne) SaveX(ctx context.Context) *OutboxEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OutboxEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutboxEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *OutboxEventUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for _, f := range fields {
+			if !outboxevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ProjectIDCleared() {
+		_spec.ClearField(outboxevent.FieldProjectID, field.TypeUUID)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(outboxevent.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.PublishedSeq(); ok {
+		_spec.SetField(outboxevent.FieldPublishedSeq, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPublishedSeq(); ok {
+		_spec.AddField(outboxevent.FieldPublishedSeq, field.TypeInt64, value)
+	}
+	if _u.mutation.PublishedSeqCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedSeq, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishedAtCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxevent.FieldLastError, field.TypeString)
+	}
+	_node = &OutboxEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,17 +6,68 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// MailMessage is the predicate function for mailmessage builders.
+type MailMessage func(*sql.Selector)
+
+// Milestone is the predicate function for milestone builders.
+type Milestone func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
 // ProjectTask is the predicate function for projecttask builders.
 type ProjectTask func(*sql.Selector)
 
+// ProjectTemplate is the predicate function for projecttemplate builders.
+type ProjectTemplate func(*sql.Selector)
+
 // ProjectUser is the predicate function for projectuser builders.
 type ProjectUser func(*sql.Selector)
 
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// TaskActivity is the predicate function for taskactivity builders.
+type TaskActivity func(*sql.Selector)
+
+// TaskAssignee is the predicate function for taskassignee builders.
+type TaskAssignee func(*sql.Selector)
+
+// TaskAssignmentEvent is the predicate function for taskassignmentevent builders.
+type TaskAssignmentEvent func(*sql.Selector)
+
+// TaskReminder is the predicate function for taskreminder builders.
+type TaskReminder func(*sql.Selector)
+
+// TaskWatcher is the predicate function for taskwatcher builders.
+type TaskWatcher func(*sql.Selector)
+
+// TemplateMember is the predicate function for templatemember builders.
+type TemplateMember func(*sql.Selector)
+
+// TemplateTask is the predicate function for templatetask builders.
+type TemplateTask func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
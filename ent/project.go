@@ -18,12 +18,20 @@ type Project struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description *string `json:"description,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProjectQuery when eager-loading is set.
 	Edges        ProjectEdges `json:"edges"`
@@ -36,9 +44,20 @@ type ProjectEdges struct {
 	Memberships []*ProjectUser `json:"memberships,omitempty"`
 	// ProjectTasks holds the value of the project_tasks edge.
 	ProjectTasks []*ProjectTask `json:"project_tasks,omitempty"`
+	// Milestones holds the value of the milestones edge.
+	Milestones []*Milestone `json:"milestones,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+
+	namedMemberships  map[string][]*ProjectUser
+	namedProjectTasks map[string][]*ProjectTask
+	namedMilestones   map[string][]*Milestone
+	namedWebhooks     map[string][]*Webhook
 }
 
 // MembershipsOrErr returns the Memberships value or an error if the edge
@@ -59,14 +78,34 @@ func (e ProjectEdges) ProjectTasksOrErr() ([]*ProjectTask, error) {
 	return nil, &NotLoadedError{edge: "project_tasks"}
 }
 
+// MilestonesOrErr returns the Milestones value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) MilestonesOrErr() ([]*Milestone, error) {
+	if e.loadedTypes[2] {
+		return e.Milestones, nil
+	}
+	return nil, &NotLoadedError{edge: "milestones"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[3] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case project.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case project.FieldName, project.FieldDescription:
 			values[i] = new(sql.NullString)
-		case project.FieldCreatedAt:
+		case project.FieldDeletedAt, project.FieldCreatedAt, project.FieldUpdatedAt, project.FieldArchivedAt:
 			values[i] = new(sql.NullTime)
 		case project.FieldID:
 			values[i] = new(uuid.UUID)
@@ -91,6 +130,19 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case project.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case project.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case project.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -110,6 +162,19 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case project.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case project.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = new(time.Time)
+				*_m.ArchivedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -133,6 +198,16 @@ func (_m *Project) QueryProjectTasks() *ProjectTaskQuery {
 	return NewProjectClient(_m.config).QueryProjectTasks(_m)
 }
 
+// QueryMilestones queries the "milestones" edge of the Project entity.
+func (_m *Project) QueryMilestones() *MilestoneQuery {
+	return NewProjectClient(_m.config).QueryMilestones(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Project entity.
+func (_m *Project) QueryWebhooks() *WebhookQuery {
+	return NewProjectClient(_m.config).QueryWebhooks(_m)
+}
+
 // Update returns a builder for updating this Project.
 // Note that you need to call Project.Unwrap() before calling this method if this Project
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -156,6 +231,14 @@ func (_m *Project) String() string {
 	var builder strings.Builder
 	builder.WriteString("Project(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -166,9 +249,113 @@ func (_m *Project) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedMemberships returns the Memberships named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Project) NamedMemberships(name string) ([]*ProjectUser, error) {
+	if _m.Edges.namedMemberships == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedMemberships[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Project) appendNamedMemberships(name string, edges ...*ProjectUser) {
+	if _m.Edges.namedMemberships == nil {
+		_m.Edges.namedMemberships = make(map[string][]*ProjectUser)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedMemberships[name] = []*ProjectUser{}
+	} else {
+		_m.Edges.namedMemberships[name] = append(_m.Edges.namedMemberships[name], edges...)
+	}
+}
+
+// NamedProjectTasks returns the ProjectTasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Project) NamedProjectTasks(name string) ([]*ProjectTask, error) {
+	if _m.Edges.namedProjectTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedProjectTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Project) appendNamedProjectTasks(name string, edges ...*ProjectTask) {
+	if _m.Edges.namedProjectTasks == nil {
+		_m.Edges.namedProjectTasks = make(map[string][]*ProjectTask)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedProjectTasks[name] = []*ProjectTask{}
+	} else {
+		_m.Edges.namedProjectTasks[name] = append(_m.Edges.namedProjectTasks[name], edges...)
+	}
+}
+
+// NamedMilestones returns the Milestones named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Project) NamedMilestones(name string) ([]*Milestone, error) {
+	if _m.Edges.namedMilestones == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedMilestones[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Project) appendNamedMilestones(name string, edges ...*Milestone) {
+	if _m.Edges.namedMilestones == nil {
+		_m.Edges.namedMilestones = make(map[string][]*Milestone)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedMilestones[name] = []*Milestone{}
+	} else {
+		_m.Edges.namedMilestones[name] = append(_m.Edges.namedMilestones[name], edges...)
+	}
+}
+
+// NamedWebhooks returns the Webhooks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Project) NamedWebhooks(name string) ([]*Webhook, error) {
+	if _m.Edges.namedWebhooks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedWebhooks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Project) appendNamedWebhooks(name string, edges ...*Webhook) {
+	if _m.Edges.namedWebhooks == nil {
+		_m.Edges.namedWebhooks = make(map[string][]*Webhook)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedWebhooks[name] = []*Webhook{}
+	} else {
+		_m.Edges.namedWebhooks[name] = append(_m.Edges.namedWebhooks[name], edges...)
+	}
+}
+
 // Projects is a parsable slice of Project.
 type Projects []*Project
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ProjectTemplate is the model entity for the ProjectTemplate schema.
+type ProjectTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProjectTemplateQuery when eager-loading is set.
+	Edges        ProjectTemplateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProjectTemplateEdges holds the relations/edges for other nodes in the graph.
+type ProjectTemplateEdges struct {
+	// Tasks holds the value of the tasks edge.
+	Tasks []*TemplateTask `json:"tasks,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*TemplateMember `json:"members,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+
+	namedTasks   map[string][]*TemplateTask
+	namedMembers map[string][]*TemplateMember
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectTemplateEdges) TasksOrErr() ([]*TemplateTask, error) {
+	if e.loadedTypes[0] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectTemplateEdges) MembersOrErr() ([]*TemplateMember, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProjectTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case projecttemplate.FieldName, projecttemplate.FieldDescription:
+			values[i] = new(sql.NullString)
+		case projecttemplate.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case projecttemplate.FieldID, projecttemplate.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProjectTemplate fields.
+func (_m *ProjectTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case projecttemplate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case projecttemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case projecttemplate.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case projecttemplate.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case projecttemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProjectTemplate.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProjectTemplate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTasks queries the "tasks" edge of the ProjectTemplate entity.
+func (_m *ProjectTemplate) QueryTasks() *TemplateTaskQuery {
+	return NewProjectTemplateClient(_m.config).QueryTasks(_m)
+}
+
+// QueryMembers queries the "members" edge of the ProjectTemplate entity.
+func (_m *ProjectTemplate) QueryMembers() *TemplateMemberQuery {
+	return NewProjectTemplateClient(_m.config).QueryMembers(_m)
+}
+
+// Update returns a builder for updating this ProjectTemplate.
+// Note that you need to call ProjectTemplate.Unwrap() before calling this method if this ProjectTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProjectTemplate) Update() *ProjectTemplateUpdateOne {
+	return NewProjectTemplateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProjectTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProjectTemplate) Unwrap() *ProjectTemplate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProjectTemplate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProjectTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProjectTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedTasks returns the Tasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *ProjectTemplate) NamedTasks(name string) ([]*TemplateTask, error) {
+	if _m.Edges.namedTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *ProjectTemplate) appendNamedTasks(name string, edges ...*TemplateTask) {
+	if _m.Edges.namedTasks == nil {
+		_m.Edges.namedTasks = make(map[string][]*TemplateTask)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedTasks[name] = []*TemplateTask{}
+	} else {
+		_m.Edges.namedTasks[name] = append(_m.Edges.namedTasks[name], edges...)
+	}
+}
+
+// NamedMembers returns the Members named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *ProjectTemplate) NamedMembers(name string) ([]*TemplateMember, error) {
+	if _m.Edges.namedMembers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedMembers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *ProjectTemplate) appendNamedMembers(name string, edges ...*TemplateMember) {
+	if _m.Edges.namedMembers == nil {
+		_m.Edges.namedMembers = make(map[string][]*TemplateMember)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedMembers[name] = []*TemplateMember{}
+	} else {
+		_m.Edges.namedMembers[name] = append(_m.Edges.namedMembers[name], edges...)
+	}
+}
+
+// ProjectTemplates is a parsable slice of ProjectTemplate.
+type ProjectTemplates []*ProjectTemplate
@@ -2,7 +2,370 @@
 
 package runtime
 
-// The schema-stitching logic is generated in project-manager-dashboard-go/ent/runtime.go
+import (
+	"project-manager-dashboard-go/ent/auditevent"
+	"project-manager-dashboard-go/ent/idempotencykey"
+	"project-manager-dashboard-go/ent/mailmessage"
+	"project-manager-dashboard-go/ent/milestone"
+	"project-manager-dashboard-go/ent/notification"
+	"project-manager-dashboard-go/ent/notificationpreference"
+	"project-manager-dashboard-go/ent/outboxevent"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"project-manager-dashboard-go/ent/projectuser"
+	"project-manager-dashboard-go/ent/schema"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/templatemember"
+	"project-manager-dashboard-go/ent/templatetask"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/ent/webhook"
+	"project-manager-dashboard-go/ent/webhookdelivery"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[8].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescScope is the schema descriptor for scope field.
+	idempotencykeyDescScope := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.DefaultScope holds the default value on creation for the scope field.
+	idempotencykey.DefaultScope = idempotencykeyDescScope.Default.(string)
+	// idempotencykey.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	idempotencykey.ScopeValidator = idempotencykeyDescScope.Validators[0].(func(string) error)
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[7].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	mailmessageFields := schema.MailMessage{}.Fields()
+	_ = mailmessageFields
+	// mailmessageDescAttempts is the schema descriptor for attempts field.
+	mailmessageDescAttempts := mailmessageFields[6].Descriptor()
+	// mailmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	mailmessage.DefaultAttempts = mailmessageDescAttempts.Default.(int)
+	// mailmessageDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	mailmessageDescNextAttemptAt := mailmessageFields[7].Descriptor()
+	// mailmessage.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	mailmessage.DefaultNextAttemptAt = mailmessageDescNextAttemptAt.Default.(func() time.Time)
+	// mailmessageDescCreatedAt is the schema descriptor for created_at field.
+	mailmessageDescCreatedAt := mailmessageFields[10].Descriptor()
+	// mailmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mailmessage.DefaultCreatedAt = mailmessageDescCreatedAt.Default.(func() time.Time)
+	// mailmessageDescID is the schema descriptor for id field.
+	mailmessageDescID := mailmessageFields[0].Descriptor()
+	// mailmessage.DefaultID holds the default value on creation for the id field.
+	mailmessage.DefaultID = mailmessageDescID.Default.(func() uuid.UUID)
+	milestoneFields := schema.Milestone{}.Fields()
+	_ = milestoneFields
+	// milestoneDescCreatedAt is the schema descriptor for created_at field.
+	milestoneDescCreatedAt := milestoneFields[5].Descriptor()
+	// milestone.DefaultCreatedAt holds the default value on creation for the created_at field.
+	milestone.DefaultCreatedAt = milestoneDescCreatedAt.Default.(func() time.Time)
+	// milestoneDescUpdatedAt is the schema descriptor for updated_at field.
+	milestoneDescUpdatedAt := milestoneFields[6].Descriptor()
+	// milestone.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	milestone.DefaultUpdatedAt = milestoneDescUpdatedAt.Default.(func() time.Time)
+	// milestone.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	milestone.UpdateDefaultUpdatedAt = milestoneDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// milestoneDescID is the schema descriptor for id field.
+	milestoneDescID := milestoneFields[0].Descriptor()
+	// milestone.DefaultID holds the default value on creation for the id field.
+	milestone.DefaultID = milestoneDescID.Default.(func() uuid.UUID)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[9].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescInApp is the schema descriptor for in_app field.
+	notificationpreferenceDescInApp := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultInApp holds the default value on creation for the in_app field.
+	notificationpreference.DefaultInApp = notificationpreferenceDescInApp.Default.(bool)
+	// notificationpreferenceDescEmail is the schema descriptor for email field.
+	notificationpreferenceDescEmail := notificationpreferenceFields[4].Descriptor()
+	// notificationpreference.DefaultEmail holds the default value on creation for the email field.
+	notificationpreference.DefaultEmail = notificationpreferenceDescEmail.Default.(bool)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.DefaultID holds the default value on creation for the id field.
+	notificationpreference.DefaultID = notificationpreferenceDescID.Default.(func() uuid.UUID)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescEventID is the schema descriptor for event_id field.
+	outboxeventDescEventID := outboxeventFields[1].Descriptor()
+	// outboxevent.DefaultEventID holds the default value on creation for the event_id field.
+	outboxevent.DefaultEventID = outboxeventDescEventID.Default.(func() uuid.UUID)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[8].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[11].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	projectMixin := schema.Project{}.Mixin()
+	projectMixinHooks1 := projectMixin[1].Hooks()
+	project.Hooks[0] = projectMixinHooks1[0]
+	projectMixinInters0 := projectMixin[0].Interceptors()
+	project.Interceptors[0] = projectMixinInters0[0]
+	projectMixinFields1 := projectMixin[1].Fields()
+	_ = projectMixinFields1
+	projectFields := schema.Project{}.Fields()
+	_ = projectFields
+	// projectDescVersion is the schema descriptor for version field.
+	projectDescVersion := projectMixinFields1[0].Descriptor()
+	// project.DefaultVersion holds the default value on creation for the version field.
+	project.DefaultVersion = projectDescVersion.Default.(int)
+	// projectDescCreatedAt is the schema descriptor for created_at field.
+	projectDescCreatedAt := projectFields[3].Descriptor()
+	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
+	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
+	// projectDescUpdatedAt is the schema descriptor for updated_at field.
+	projectDescUpdatedAt := projectFields[4].Descriptor()
+	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
+	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// projectDescID is the schema descriptor for id field.
+	projectDescID := projectFields[0].Descriptor()
+	// project.DefaultID holds the default value on creation for the id field.
+	project.DefaultID = projectDescID.Default.(func() uuid.UUID)
+	projecttaskFields := schema.ProjectTask{}.Fields()
+	_ = projecttaskFields
+	// projecttaskDescPosition is the schema descriptor for position field.
+	projecttaskDescPosition := projecttaskFields[1].Descriptor()
+	// projecttask.DefaultPosition holds the default value on creation for the position field.
+	projecttask.DefaultPosition = projecttaskDescPosition.Default.(int)
+	// projecttaskDescCreatedAt is the schema descriptor for created_at field.
+	projecttaskDescCreatedAt := projecttaskFields[2].Descriptor()
+	// projecttask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projecttask.DefaultCreatedAt = projecttaskDescCreatedAt.Default.(func() time.Time)
+	// projecttaskDescID is the schema descriptor for id field.
+	projecttaskDescID := projecttaskFields[0].Descriptor()
+	// projecttask.DefaultID holds the default value on creation for the id field.
+	projecttask.DefaultID = projecttaskDescID.Default.(func() uuid.UUID)
+	projecttemplateFields := schema.ProjectTemplate{}.Fields()
+	_ = projecttemplateFields
+	// projecttemplateDescCreatedAt is the schema descriptor for created_at field.
+	projecttemplateDescCreatedAt := projecttemplateFields[4].Descriptor()
+	// projecttemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projecttemplate.DefaultCreatedAt = projecttemplateDescCreatedAt.Default.(func() time.Time)
+	// projecttemplateDescID is the schema descriptor for id field.
+	projecttemplateDescID := projecttemplateFields[0].Descriptor()
+	// projecttemplate.DefaultID holds the default value on creation for the id field.
+	projecttemplate.DefaultID = projecttemplateDescID.Default.(func() uuid.UUID)
+	projectuserFields := schema.ProjectUser{}.Fields()
+	_ = projectuserFields
+	// projectuserDescCreatedAt is the schema descriptor for created_at field.
+	projectuserDescCreatedAt := projectuserFields[2].Descriptor()
+	// projectuser.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projectuser.DefaultCreatedAt = projectuserDescCreatedAt.Default.(func() time.Time)
+	// projectuserDescID is the schema descriptor for id field.
+	projectuserDescID := projectuserFields[0].Descriptor()
+	// projectuser.DefaultID holds the default value on creation for the id field.
+	projectuser.DefaultID = projectuserDescID.Default.(func() uuid.UUID)
+	taskMixin := schema.Task{}.Mixin()
+	taskMixinHooks1 := taskMixin[1].Hooks()
+	task.Hooks[0] = taskMixinHooks1[0]
+	taskMixinInters0 := taskMixin[0].Interceptors()
+	task.Interceptors[0] = taskMixinInters0[0]
+	taskMixinFields1 := taskMixin[1].Fields()
+	_ = taskMixinFields1
+	taskFields := schema.Task{}.Fields()
+	_ = taskFields
+	// taskDescVersion is the schema descriptor for version field.
+	taskDescVersion := taskMixinFields1[0].Descriptor()
+	// task.DefaultVersion holds the default value on creation for the version field.
+	task.DefaultVersion = taskDescVersion.Default.(int)
+	// taskDescPosition is the schema descriptor for position field.
+	taskDescPosition := taskFields[5].Descriptor()
+	// task.DefaultPosition holds the default value on creation for the position field.
+	task.DefaultPosition = taskDescPosition.Default.(int)
+	// taskDescCreatedAt is the schema descriptor for created_at field.
+	taskDescCreatedAt := taskFields[7].Descriptor()
+	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
+	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
+	// taskDescUpdatedAt is the schema descriptor for updated_at field.
+	taskDescUpdatedAt := taskFields[8].Descriptor()
+	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
+	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	task.UpdateDefaultUpdatedAt = taskDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taskDescID is the schema descriptor for id field.
+	taskDescID := taskFields[0].Descriptor()
+	// task.DefaultID holds the default value on creation for the id field.
+	task.DefaultID = taskDescID.Default.(func() uuid.UUID)
+	taskactivityFields := schema.TaskActivity{}.Fields()
+	_ = taskactivityFields
+	// taskactivityDescCreatedAt is the schema descriptor for created_at field.
+	taskactivityDescCreatedAt := taskactivityFields[5].Descriptor()
+	// taskactivity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskactivity.DefaultCreatedAt = taskactivityDescCreatedAt.Default.(func() time.Time)
+	// taskactivityDescID is the schema descriptor for id field.
+	taskactivityDescID := taskactivityFields[0].Descriptor()
+	// taskactivity.DefaultID holds the default value on creation for the id field.
+	taskactivity.DefaultID = taskactivityDescID.Default.(func() uuid.UUID)
+	taskassigneeFields := schema.TaskAssignee{}.Fields()
+	_ = taskassigneeFields
+	// taskassigneeDescCreatedAt is the schema descriptor for created_at field.
+	taskassigneeDescCreatedAt := taskassigneeFields[2].Descriptor()
+	// taskassignee.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskassignee.DefaultCreatedAt = taskassigneeDescCreatedAt.Default.(func() time.Time)
+	// taskassigneeDescID is the schema descriptor for id field.
+	taskassigneeDescID := taskassigneeFields[0].Descriptor()
+	// taskassignee.DefaultID holds the default value on creation for the id field.
+	taskassignee.DefaultID = taskassigneeDescID.Default.(func() uuid.UUID)
+	taskassignmenteventFields := schema.TaskAssignmentEvent{}.Fields()
+	_ = taskassignmenteventFields
+	// taskassignmenteventDescCreatedAt is the schema descriptor for created_at field.
+	taskassignmenteventDescCreatedAt := taskassignmenteventFields[6].Descriptor()
+	// taskassignmentevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskassignmentevent.DefaultCreatedAt = taskassignmenteventDescCreatedAt.Default.(func() time.Time)
+	// taskassignmenteventDescID is the schema descriptor for id field.
+	taskassignmenteventDescID := taskassignmenteventFields[0].Descriptor()
+	// taskassignmentevent.DefaultID holds the default value on creation for the id field.
+	taskassignmentevent.DefaultID = taskassignmenteventDescID.Default.(func() uuid.UUID)
+	taskreminderFields := schema.TaskReminder{}.Fields()
+	_ = taskreminderFields
+	// taskreminderDescCreatedAt is the schema descriptor for created_at field.
+	taskreminderDescCreatedAt := taskreminderFields[3].Descriptor()
+	// taskreminder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskreminder.DefaultCreatedAt = taskreminderDescCreatedAt.Default.(func() time.Time)
+	// taskreminderDescID is the schema descriptor for id field.
+	taskreminderDescID := taskreminderFields[0].Descriptor()
+	// taskreminder.DefaultID holds the default value on creation for the id field.
+	taskreminder.DefaultID = taskreminderDescID.Default.(func() uuid.UUID)
+	taskwatcherFields := schema.TaskWatcher{}.Fields()
+	_ = taskwatcherFields
+	// taskwatcherDescCreatedAt is the schema descriptor for created_at field.
+	taskwatcherDescCreatedAt := taskwatcherFields[1].Descriptor()
+	// taskwatcher.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskwatcher.DefaultCreatedAt = taskwatcherDescCreatedAt.Default.(func() time.Time)
+	// taskwatcherDescID is the schema descriptor for id field.
+	taskwatcherDescID := taskwatcherFields[0].Descriptor()
+	// taskwatcher.DefaultID holds the default value on creation for the id field.
+	taskwatcher.DefaultID = taskwatcherDescID.Default.(func() uuid.UUID)
+	templatememberFields := schema.TemplateMember{}.Fields()
+	_ = templatememberFields
+	// templatememberDescID is the schema descriptor for id field.
+	templatememberDescID := templatememberFields[0].Descriptor()
+	// templatemember.DefaultID holds the default value on creation for the id field.
+	templatemember.DefaultID = templatememberDescID.Default.(func() uuid.UUID)
+	templatetaskFields := schema.TemplateTask{}.Fields()
+	_ = templatetaskFields
+	// templatetaskDescPosition is the schema descriptor for position field.
+	templatetaskDescPosition := templatetaskFields[4].Descriptor()
+	// templatetask.DefaultPosition holds the default value on creation for the position field.
+	templatetask.DefaultPosition = templatetaskDescPosition.Default.(int)
+	// templatetaskDescID is the schema descriptor for id field.
+	templatetaskDescID := templatetaskFields[0].Descriptor()
+	// templatetask.DefaultID holds the default value on creation for the id field.
+	templatetask.DefaultID = templatetaskDescID.Default.(func() uuid.UUID)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[5].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[2].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[5].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	webhookDescConsecutiveFailures := webhookFields[6].Descriptor()
+	// webhook.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	webhook.DefaultConsecutiveFailures = webhookDescConsecutiveFailures.Default.(int)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[9].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[10].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[14].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
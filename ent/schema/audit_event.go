@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent — запись журнала изменений. Пишется хуками на каждую мутацию
+// Project, Task, ProjectUser и ProjectTask. Связей со сущностями нет
+// намеренно: записи должны переживать удаление объектов.
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.String("entity_type").Immutable(),
+		field.UUID("entity_id", uuid.UUID{}).Immutable(),
+		field.Enum("operation").
+			Values("create", "update", "delete").
+			Immutable(),
+		// changes: имя поля -> {"old": ..., "new": ...}.
+		field.JSON("changes", map[string]any{}).Optional(),
+		field.String("request_id").Optional().Nillable().Immutable(),
+		// project_id заполняется, когда проект сущности известен; для задачи,
+		// созданной до привязки к проекту, проставляется при создании ProjectTask.
+		field.UUID("project_id", uuid.UUID{}).Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "created_at"),
+		index.Fields("entity_type", "entity_id", "created_at"),
+	}
+}
+
+func (AuditEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
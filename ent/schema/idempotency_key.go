@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey — сохранённый результат запроса с заголовком
+// Idempotency-Key. Пока status_code пуст, запрос ещё выполняется.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		// scope — автор запроса: одинаковые ключи разных клиентов не
+		// пересекаются.
+		field.String("scope").MaxLen(64).Default("").Immutable(),
+		field.String("key").MaxLen(255).NotEmpty().Immutable(),
+		// fingerprint — sha256 от метода, пути и тела запроса.
+		field.String("fingerprint").Immutable(),
+
+		field.Int("status_code").Optional().Nillable(),
+		field.String("content_type").Optional(),
+		field.Bytes("response_body").Optional(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope", "key").Unique(),
+		index.Fields("created_at"),
+	}
+}
+
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
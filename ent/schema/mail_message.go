@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MailMessage — письмо в очереди исходящей почты. Неудачная отправка
+// повторяется с растущей паузой до исчерпания попыток.
+type MailMessage struct {
+	ent.Schema
+}
+
+func (MailMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.String("to_email").Immutable(),
+		field.String("subject").Immutable(),
+		field.Text("text_body").Immutable(),
+		field.Text("html_body").Optional().Immutable(),
+
+		field.Enum("status").
+			Values("pending", "sent", "failed").
+			Default("pending"),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Text("last_error").Optional().Nillable(),
+		field.Time("sent_at").Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (MailMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
+
+func (MailMessage) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Milestone struct {
+	ent.Schema
+}
+
+func (Milestone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.String("name"),
+		field.String("description").Optional().Nillable(),
+
+		field.Enum("status").
+			Values("open", "closed").
+			Default("open"),
+
+		field.Time("target_date").
+			Optional().
+			Nillable().
+			Annotations(entgql.OrderField("TARGET_DATE")),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Milestone) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("milestones").
+			Unique().
+			Required(),
+
+		edge.To("tasks", Task.Type).
+			Annotations(entgql.RelayConnection()),
+	}
+}
+
+func (Milestone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("project").Fields("target_date"),
+	}
+}
+
+func (Milestone) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.RelayConnection()}
+}
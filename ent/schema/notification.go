@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NotificationTypes — типы уведомлений; на них же завязаны настройки
+// пользователя (NotificationPreference).
+var NotificationTypes = []string{
+	"task_assigned",
+	"project_invited",
+	"task_changed",
+	"task_due_soon",
+	"task_overdue",
+	"task_mentioned",
+}
+
+// Notification — уведомление во входящих пользователя. project_id и task_id
+// не связаны с сущностями: уведомление остаётся после их удаления.
+type Notification struct {
+	ent.Schema
+}
+
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.Enum("type").
+			Values(NotificationTypes...).
+			Immutable(),
+		field.String("message").Immutable(),
+
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.UUID("project_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.UUID("task_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+
+		field.Time("read_at").Optional().Nillable(),
+		// emailed_at — когда уведомление ушло письмом (сразу или в дайджесте).
+		field.Time("emailed_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notifications").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "read_at", "created_at"),
+	}
+}
+
+func (Notification) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
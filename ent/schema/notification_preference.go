@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NotificationPreference — настройка пользователя для одного типа
+// уведомлений. Отсутствие записи означает «включено».
+type NotificationPreference struct {
+	ent.Schema
+}
+
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.UUID("user_id", uuid.UUID{}).Immutable(),
+		field.Enum("type").
+			Values(NotificationTypes...).
+			Immutable(),
+		field.Bool("in_app").Default(true),
+		field.Bool("email").Default(true),
+	}
+}
+
+func (NotificationPreference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notification_preferences").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (NotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "type").Unique(),
+	}
+}
+
+func (NotificationPreference) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
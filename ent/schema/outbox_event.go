@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxEvent — доменное событие, записанное в той же транзакции, что и
+// изменение. id выдаётся при вставке, а транзакции фиксируются в любом
+// порядке, поэтому порядок публикации задаёт published_seq: релей
+// присваивает его подряд, без пропусков. event_id — ключ дедупликации
+// для получателей.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Immutable(),
+
+		field.UUID("event_id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.String("type").Immutable(),
+		field.String("aggregate_type").Immutable(),
+		field.UUID("aggregate_id", uuid.UUID{}).Immutable(),
+		field.UUID("project_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Text("payload").Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Int64("published_seq").Optional().Nillable().Unique(),
+		field.Time("published_at").Optional().Nillable(),
+		field.Int("attempts").Default(0),
+		field.Text("last_error").Optional().Nillable(),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("published_at", "id"),
+	}
+}
+
+func (OutboxEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
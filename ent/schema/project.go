@@ -5,31 +5,72 @@ import (
 
 	"github.com/google/uuid"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Project struct {
 	ent.Schema
 }
 
+func (Project) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+		VersionMixin{},
+	}
+}
+
 func (Project) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
 
-		field.String("name"),
+		field.String("name").
+			Annotations(entgql.OrderField("NAME")),
 		field.String("description").Optional().Nillable(),
 
-		field.Time("created_at").Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
+		// Default в БД нужен, чтобы колонка добавилась к уже существующим строкам.
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+		// archived_at != nil — проект в архиве и доступен только на чтение.
+		field.Time("archived_at").Optional().Nillable(),
 	}
 }
 
 func (Project) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("memberships", ProjectUser.Type),
-		edge.To("project_tasks", ProjectTask.Type),
+		edge.To("memberships", ProjectUser.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("project_tasks", ProjectTask.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("milestones", Milestone.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("webhooks", Webhook.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+func (Project) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+// Индекс под постраничный вывод списка (created_at, id).
+func (Project) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at", "id"),
 	}
 }
@@ -5,7 +5,9 @@ import (
 
 	"github.com/google/uuid"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -21,9 +23,13 @@ func (ProjectTask) Fields() []ent.Field {
 			Default(uuid.New).
 			Immutable(),
 
-		field.Int("position").Default(0),
+		field.Int("position").
+			Default(0).
+			Annotations(entgql.OrderField("POSITION")),
 
-		field.Time("created_at").Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
 	}
 }
 
@@ -47,3 +53,7 @@ func (ProjectTask) Indexes() []ent.Index {
 		index.Edges("project").Fields("position"),
 	}
 }
+
+func (ProjectTask) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.RelayConnection()}
+}
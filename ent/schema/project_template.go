@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type ProjectTemplate struct {
+	ent.Schema
+}
+
+func (ProjectTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.String("name"),
+		field.String("description").Optional().Nillable(),
+
+		field.UUID("created_by", uuid.UUID{}),
+
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (ProjectTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tasks", TemplateTask.Type),
+		edge.To("members", TemplateMember.Type),
+	}
+}
+
+func (ProjectTemplate) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
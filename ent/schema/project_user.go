@@ -5,7 +5,9 @@ import (
 
 	"github.com/google/uuid"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -25,7 +27,9 @@ func (ProjectUser) Fields() []ent.Field {
 			Values("owner", "member", "viewer").
 			Default("member"),
 
-		field.Time("created_at").Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
 	}
 }
 
@@ -48,3 +52,7 @@ func (ProjectUser) Indexes() []ent.Index {
 		index.Edges("project", "user").Unique(),
 	}
 }
+
+func (ProjectUser) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.RelayConnection()}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"project-manager-dashboard-go/ent/intercept"
+)
+
+// SoftDeleteMixin добавляет поле deleted_at и interceptor, который
+// скрывает удалённые записи из всех обычных запросов.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Annotations(entgql.Skip()),
+	}
+}
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete возвращает контекст, в котором запросы видят и удалённые записи
+// (корзина, восстановление, очистка).
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if skip, _ := ctx.Value(softDeleteKey{}).(bool); skip {
+				return nil
+			}
+			d.P(q)
+			return nil
+		}),
+	}
+}
+
+// P добавляет к запросу условие deleted_at IS NULL.
+func (d SoftDeleteMixin) P(w interface{ WhereP(...func(*sql.Selector)) }) {
+	w.WhereP(
+		sql.FieldIsNull(d.Fields()[0].Descriptor().Name),
+	)
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/google/uuid"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -14,13 +16,21 @@ type Task struct {
 	ent.Schema
 }
 
+func (Task) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+		VersionMixin{},
+	}
+}
+
 func (Task) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
 
-		field.String("title"),
+		field.String("title").
+			Annotations(entgql.OrderField("TITLE")),
 		field.String("description").Optional(),
 
 		field.Enum("status").
@@ -33,20 +43,52 @@ func (Task) Fields() []ent.Field {
 
 		field.Int("position").Default(0),
 
-		field.Time("due_date").Optional(),
+		field.Time("due_date").
+			Optional().
+			Annotations(entgql.OrderField("DUE_DATE")),
 
-		field.Time("created_at").Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
-		field.UUID("assignee_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("milestone_id", uuid.UUID{}).Optional().Nillable(),
+
+		// Заполняются при перемещении задачи в корзину: проект и позиция,
+		// в которые задача вернётся при восстановлении.
+		field.UUID("trashed_project_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Annotations(entgql.Skip()),
+		field.Int("trashed_position").
+			Optional().
+			Nillable().
+			Annotations(entgql.Skip()),
 	}
 }
 
 func (Task) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("project_tasks", ProjectTask.Type),
-		edge.From("assignee", User.Type).
-			Ref("assigned_tasks").
-			Field("assignee_id").
+		edge.To("assignees", TaskAssignee.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("assignment_events", TaskAssignmentEvent.Type).
+			Annotations(entgql.Skip()),
+		edge.To("watchers", TaskWatcher.Type).
+			Annotations(entgql.Skip()),
+		edge.To("activities", TaskActivity.Type).
+			Annotations(entgql.Skip()),
+		edge.To("reminders", TaskReminder.Type).
+			Annotations(entgql.Skip()),
+		edge.From("milestone", Milestone.Type).
+			Ref("tasks").
+			Field("milestone_id").
 			Unique(),
 	}
 }
+
+func (Task) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskActivity — событие ленты активности задачи. from_value / to_value
+// хранят значения до и после изменения в строковом виде (для назначений —
+// ID пользователя, для перемещения — позиции).
+type TaskActivity struct {
+	ent.Schema
+}
+
+func (TaskActivity) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.Enum("kind").
+			Values(
+				"created",
+				"title_changed",
+				"description_changed",
+				"status_changed",
+				"moved",
+				"assigned",
+				"unassigned",
+				"milestone_changed",
+				"deleted",
+				"restored",
+			).
+			Immutable(),
+
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.Text("from_value").Optional().Nillable().Immutable(),
+		field.Text("to_value").Optional().Nillable().Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (TaskActivity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("activities").
+			Unique().
+			Required(),
+	}
+}
+
+func (TaskActivity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("task").Fields("created_at"),
+	}
+}
+
+func (TaskActivity) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
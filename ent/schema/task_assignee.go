@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type TaskAssignee struct {
+	ent.Schema
+}
+
+func (TaskAssignee) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.Enum("role").
+			Values("owner", "reviewer", "contributor").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
+	}
+}
+
+func (TaskAssignee) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("assignees").
+			Unique().
+			Required(),
+
+		edge.From("user", User.Type).
+			Ref("task_assignments").
+			Unique().
+			Required(),
+	}
+}
+
+func (TaskAssignee) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("task", "user").Unique(),
+		index.Edges("user"),
+	}
+}
+
+func (TaskAssignee) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.RelayConnection()}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskAssignmentEvent — запись истории назначений задачи.
+type TaskAssignmentEvent struct {
+	ent.Schema
+}
+
+func (TaskAssignmentEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.Enum("action").
+			Values("assigned", "unassigned").
+			Immutable(),
+
+		field.UUID("actor_id", uuid.UUID{}).Immutable(),
+		field.UUID("from_user_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.UUID("to_user_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.String("role").Optional().Nillable().Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (TaskAssignmentEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("assignment_events").
+			Unique().
+			Required(),
+	}
+}
+
+func (TaskAssignmentEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("task").Fields("created_at"),
+	}
+}
+
+func (TaskAssignmentEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskReminder — отправленное напоминание о сроке задачи. На каждый порог
+// (due_soon, overdue) у задачи не больше одной записи; при смене due_date
+// записи удаляются, и напоминания приходят заново.
+type TaskReminder struct {
+	ent.Schema
+}
+
+func (TaskReminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.Enum("kind").
+			Values("due_soon", "overdue").
+			Immutable(),
+		// due_date — срок задачи на момент напоминания.
+		field.Time("due_date").Immutable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (TaskReminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("reminders").
+			Unique().
+			Required(),
+	}
+}
+
+func (TaskReminder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("task").Fields("kind").Unique(),
+		index.Fields("created_at"),
+	}
+}
+
+func (TaskReminder) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type TaskWatcher struct {
+	ent.Schema
+}
+
+func (TaskWatcher) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (TaskWatcher) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("watchers").
+			Unique().
+			Required(),
+
+		edge.From("user", User.Type).
+			Ref("watched_tasks").
+			Unique().
+			Required(),
+	}
+}
+
+func (TaskWatcher) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("task", "user").Unique(),
+		index.Edges("user"),
+	}
+}
+
+func (TaskWatcher) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type TemplateMember struct {
+	ent.Schema
+}
+
+func (TemplateMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.Enum("role").
+			Values("owner", "member", "viewer").
+			Default("member"),
+	}
+}
+
+func (TemplateMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("template", ProjectTemplate.Type).
+			Ref("members").
+			Unique().
+			Required(),
+
+		edge.From("user", User.Type).
+			Ref("template_memberships").
+			Unique().
+			Required(),
+	}
+}
+
+func (TemplateMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("template", "user").Unique(),
+	}
+}
+
+func (TemplateMember) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
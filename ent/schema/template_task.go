@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type TemplateTask struct {
+	ent.Schema
+}
+
+func (TemplateTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.String("title"),
+		field.String("description").Optional(),
+
+		field.Enum("priority").
+			Values("low", "medium", "high").
+			Default("medium"),
+
+		field.Int("position").Default(0),
+
+		// Срок задачи в днях относительно даты старта проекта.
+		field.Int("due_offset_days").Optional().Nillable(),
+	}
+}
+
+func (TemplateTask) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("template", ProjectTemplate.Type).
+			Ref("tasks").
+			Unique().
+			Required(),
+	}
+}
+
+func (TemplateTask) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("template").Fields("position"),
+	}
+}
+
+func (TemplateTask) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
@@ -5,9 +5,12 @@ import (
 
 	"github.com/google/uuid"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type User struct {
@@ -20,17 +23,51 @@ func (User) Fields() []ent.Field {
 			Default(uuid.New).
 			Immutable(),
 
-		field.String("email").Unique(),
-		field.String("name"),
+		field.String("email").
+			Unique().
+			Annotations(entgql.OrderField("EMAIL")),
+		field.String("name").
+			Annotations(entgql.OrderField("NAME")),
 		field.String("country").Optional(),
+		// email_mode — доставка уведомлений письмом: сразу, раз в день
+		// дайджестом или никогда.
+		field.Enum("email_mode").
+			Values("instant", "daily", "off").
+			Default("instant"),
 
-		field.Time("created_at").Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Annotations(entgql.OrderField("CREATED_AT")),
 	}
 }
 
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("assigned_tasks", Task.Type),
-		edge.To("memberships", ProjectUser.Type),
+		edge.To("task_assignments", TaskAssignee.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("watched_tasks", TaskWatcher.Type).
+			Annotations(entgql.Skip()),
+		edge.To("memberships", ProjectUser.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("template_memberships", TemplateMember.Type).
+			Annotations(entgql.Skip()),
+		edge.To("notifications", Notification.Type).
+			Annotations(entgql.Skip()),
+		edge.To("notification_preferences", NotificationPreference.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+// Индекс под постраничный вывод списка (created_at, id).
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at", "id"),
 	}
 }
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// VersionMixin добавляет счётчик версии для оптимистичных блокировок.
+// Хук увеличивает version при каждом обновлении записи; из него же
+// строится ETag в HTTP-ответах.
+type VersionMixin struct {
+	mixin.Schema
+}
+
+func (VersionMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").Default(1),
+	}
+}
+
+func (VersionMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+					if vm, ok := m.(interface{ AddVersion(int) }); ok {
+						vm.AddVersion(1)
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookEvents — события, на которые можно подписать вебхук.
+var WebhookEvents = []string{
+	"task.created",
+	"task.updated",
+	"task.deleted",
+	"task.assigned",
+	"task.unassigned",
+	"project.member_added",
+}
+
+// Webhook — подписка проекта на события. После серии неудачных доставок
+// подписка выключается (active=false, disabled_at != nil).
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.UUID("project_id", uuid.UUID{}).Immutable(),
+		field.String("url").MaxLen(2048),
+		field.String("secret").Sensitive(),
+		field.Strings("events"),
+
+		field.Bool("active").Default(true),
+		// consecutive_failures сбрасывается первой успешной доставкой.
+		field.Int("consecutive_failures").Default(0),
+		field.Time("disabled_at").Optional().Nillable(),
+
+		field.UUID("created_by", uuid.UUID{}).Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("webhooks").
+			Field("project_id").
+			Unique().
+			Required().
+			Immutable(),
+
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
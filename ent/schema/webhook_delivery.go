@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery — доставка события вебхуку и одновременно запись журнала:
+// хранит тело запроса и результат последней попытки. Повторная доставка
+// вручную создаёт новую запись с тем же event_id.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+
+		field.UUID("webhook_id", uuid.UUID{}).Immutable(),
+		field.UUID("event_id", uuid.UUID{}).Immutable(),
+		field.String("event").Immutable(),
+		field.Text("payload").Immutable(),
+		field.UUID("redelivery_of", uuid.UUID{}).Optional().Nillable().Immutable(),
+
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Default(time.Now),
+
+		field.Int("response_code").Optional().Nillable(),
+		field.Text("response_body").Optional().Nillable(),
+		field.Text("last_error").Optional().Nillable(),
+		field.Int("duration_ms").Optional().Nillable(),
+		field.Time("last_attempt_at").Optional().Nillable(),
+
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "created_at"),
+	}
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
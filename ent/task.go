@@ -4,8 +4,8 @@ package ent
 
 import (
 	"fmt"
+	"project-manager-dashboard-go/ent/milestone"
 	"project-manager-dashboard-go/ent/task"
-	"project-manager-dashboard-go/ent/user"
 	"strings"
 	"time"
 
@@ -19,6 +19,10 @@ type Task struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
 	// Description holds the value of the "description" field.
@@ -35,8 +39,12 @@ type Task struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// AssigneeID holds the value of the "assignee_id" field.
-	AssigneeID *uuid.UUID `json:"assignee_id,omitempty"`
+	// MilestoneID holds the value of the "milestone_id" field.
+	MilestoneID *uuid.UUID `json:"milestone_id,omitempty"`
+	// TrashedProjectID holds the value of the "trashed_project_id" field.
+	TrashedProjectID *uuid.UUID `json:"trashed_project_id,omitempty"`
+	// TrashedPosition holds the value of the "trashed_position" field.
+	TrashedPosition *int `json:"trashed_position,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -47,11 +55,30 @@ type Task struct {
 type TaskEdges struct {
 	// ProjectTasks holds the value of the project_tasks edge.
 	ProjectTasks []*ProjectTask `json:"project_tasks,omitempty"`
-	// Assignee holds the value of the assignee edge.
-	Assignee *User `json:"assignee,omitempty"`
+	// Assignees holds the value of the assignees edge.
+	Assignees []*TaskAssignee `json:"assignees,omitempty"`
+	// AssignmentEvents holds the value of the assignment_events edge.
+	AssignmentEvents []*TaskAssignmentEvent `json:"assignment_events,omitempty"`
+	// Watchers holds the value of the watchers edge.
+	Watchers []*TaskWatcher `json:"watchers,omitempty"`
+	// Activities holds the value of the activities edge.
+	Activities []*TaskActivity `json:"activities,omitempty"`
+	// Reminders holds the value of the reminders edge.
+	Reminders []*TaskReminder `json:"reminders,omitempty"`
+	// Milestone holds the value of the milestone edge.
+	Milestone *Milestone `json:"milestone,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [7]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+
+	namedProjectTasks     map[string][]*ProjectTask
+	namedAssignees        map[string][]*TaskAssignee
+	namedAssignmentEvents map[string][]*TaskAssignmentEvent
+	namedWatchers         map[string][]*TaskWatcher
+	namedActivities       map[string][]*TaskActivity
+	namedReminders        map[string][]*TaskReminder
 }
 
 // ProjectTasksOrErr returns the ProjectTasks value or an error if the edge
@@ -63,15 +90,60 @@ func (e TaskEdges) ProjectTasksOrErr() ([]*ProjectTask, error) {
 	return nil, &NotLoadedError{edge: "project_tasks"}
 }
 
-// AssigneeOrErr returns the Assignee value or an error if the edge
+// AssigneesOrErr returns the Assignees value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) AssigneesOrErr() ([]*TaskAssignee, error) {
+	if e.loadedTypes[1] {
+		return e.Assignees, nil
+	}
+	return nil, &NotLoadedError{edge: "assignees"}
+}
+
+// AssignmentEventsOrErr returns the AssignmentEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) AssignmentEventsOrErr() ([]*TaskAssignmentEvent, error) {
+	if e.loadedTypes[2] {
+		return e.AssignmentEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "assignment_events"}
+}
+
+// WatchersOrErr returns the Watchers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) WatchersOrErr() ([]*TaskWatcher, error) {
+	if e.loadedTypes[3] {
+		return e.Watchers, nil
+	}
+	return nil, &NotLoadedError{edge: "watchers"}
+}
+
+// ActivitiesOrErr returns the Activities value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ActivitiesOrErr() ([]*TaskActivity, error) {
+	if e.loadedTypes[4] {
+		return e.Activities, nil
+	}
+	return nil, &NotLoadedError{edge: "activities"}
+}
+
+// RemindersOrErr returns the Reminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) RemindersOrErr() ([]*TaskReminder, error) {
+	if e.loadedTypes[5] {
+		return e.Reminders, nil
+	}
+	return nil, &NotLoadedError{edge: "reminders"}
+}
+
+// MilestoneOrErr returns the Milestone value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
-func (e TaskEdges) AssigneeOrErr() (*User, error) {
-	if e.Assignee != nil {
-		return e.Assignee, nil
-	} else if e.loadedTypes[1] {
-		return nil, &NotFoundError{label: user.Label}
+func (e TaskEdges) MilestoneOrErr() (*Milestone, error) {
+	if e.Milestone != nil {
+		return e.Milestone, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: milestone.Label}
 	}
-	return nil, &NotLoadedError{edge: "assignee"}
+	return nil, &NotLoadedError{edge: "milestone"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -79,13 +151,13 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldAssigneeID:
+		case task.FieldMilestoneID, task.FieldTrashedProjectID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case task.FieldPosition:
+		case task.FieldVersion, task.FieldPosition, task.FieldTrashedPosition:
 			values[i] = new(sql.NullInt64)
 		case task.FieldTitle, task.FieldDescription, task.FieldStatus, task.FieldPriority:
 			values[i] = new(sql.NullString)
-		case task.FieldDueDate, task.FieldCreatedAt, task.FieldUpdatedAt:
+		case task.FieldDeletedAt, task.FieldDueDate, task.FieldCreatedAt, task.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case task.FieldID:
 			values[i] = new(uuid.UUID)
@@ -110,6 +182,19 @@ func (_m *Task) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case task.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case task.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case task.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -158,12 +243,26 @@ func (_m *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
-		case task.FieldAssigneeID:
+		case task.FieldMilestoneID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone_id", values[i])
+			} else if value.Valid {
+				_m.MilestoneID = new(uuid.UUID)
+				*_m.MilestoneID = *value.S.(*uuid.UUID)
+			}
+		case task.FieldTrashedProjectID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+				return fmt.Errorf("unexpected type %T for field trashed_project_id", values[i])
+			} else if value.Valid {
+				_m.TrashedProjectID = new(uuid.UUID)
+				*_m.TrashedProjectID = *value.S.(*uuid.UUID)
+			}
+		case task.FieldTrashedPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trashed_position", values[i])
 			} else if value.Valid {
-				_m.AssigneeID = new(uuid.UUID)
-				*_m.AssigneeID = *value.S.(*uuid.UUID)
+				_m.TrashedPosition = new(int)
+				*_m.TrashedPosition = int(value.Int64)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -183,9 +282,34 @@ func (_m *Task) QueryProjectTasks() *ProjectTaskQuery {
 	return NewTaskClient(_m.config).QueryProjectTasks(_m)
 }
 
-// QueryAssignee queries the "assignee" edge of the Task entity.
-func (_m *Task) QueryAssignee() *UserQuery {
-	return NewTaskClient(_m.config).QueryAssignee(_m)
+// QueryAssignees queries the "assignees" edge of the Task entity.
+func (_m *Task) QueryAssignees() *TaskAssigneeQuery {
+	return NewTaskClient(_m.config).QueryAssignees(_m)
+}
+
+// QueryAssignmentEvents queries the "assignment_events" edge of the Task entity.
+func (_m *Task) QueryAssignmentEvents() *TaskAssignmentEventQuery {
+	return NewTaskClient(_m.config).QueryAssignmentEvents(_m)
+}
+
+// QueryWatchers queries the "watchers" edge of the Task entity.
+func (_m *Task) QueryWatchers() *TaskWatcherQuery {
+	return NewTaskClient(_m.config).QueryWatchers(_m)
+}
+
+// QueryActivities queries the "activities" edge of the Task entity.
+func (_m *Task) QueryActivities() *TaskActivityQuery {
+	return NewTaskClient(_m.config).QueryActivities(_m)
+}
+
+// QueryReminders queries the "reminders" edge of the Task entity.
+func (_m *Task) QueryReminders() *TaskReminderQuery {
+	return NewTaskClient(_m.config).QueryReminders(_m)
+}
+
+// QueryMilestone queries the "milestone" edge of the Task entity.
+func (_m *Task) QueryMilestone() *MilestoneQuery {
+	return NewTaskClient(_m.config).QueryMilestone(_m)
 }
 
 // Update returns a builder for updating this Task.
@@ -211,6 +335,14 @@ func (_m *Task) String() string {
 	var builder strings.Builder
 	builder.WriteString("Task(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -235,13 +367,167 @@ func (_m *Task) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	if v := _m.AssigneeID; v != nil {
-		builder.WriteString("assignee_id=")
+	if v := _m.MilestoneID; v != nil {
+		builder.WriteString("milestone_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrashedProjectID; v != nil {
+		builder.WriteString("trashed_project_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrashedPosition; v != nil {
+		builder.WriteString("trashed_position=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedProjectTasks returns the ProjectTasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Task) NamedProjectTasks(name string) ([]*ProjectTask, error) {
+	if _m.Edges.namedProjectTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedProjectTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Task) appendNamedProjectTasks(name string, edges ...*ProjectTask) {
+	if _m.Edges.namedProjectTasks == nil {
+		_m.Edges.namedProjectTasks = make(map[string][]*ProjectTask)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedProjectTasks[name] = []*ProjectTask{}
+	} else {
+		_m.Edges.namedProjectTasks[name] = append(_m.Edges.namedProjectTasks[name], edges...)
+	}
+}
+
+// NamedAssignees returns the Assignees named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Task) NamedAssignees(name string) ([]*TaskAssignee, error) {
+	if _m.Edges.namedAssignees == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedAssignees[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Task) appendNamedAssignees(name string, edges ...*TaskAssignee) {
+	if _m.Edges.namedAssignees == nil {
+		_m.Edges.namedAssignees = make(map[string][]*TaskAssignee)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedAssignees[name] = []*TaskAssignee{}
+	} else {
+		_m.Edges.namedAssignees[name] = append(_m.Edges.namedAssignees[name], edges...)
+	}
+}
+
+// NamedAssignmentEvents returns the AssignmentEvents named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Task) NamedAssignmentEvents(name string) ([]*TaskAssignmentEvent, error) {
+	if _m.Edges.namedAssignmentEvents == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedAssignmentEvents[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Task) appendNamedAssignmentEvents(name string, edges ...*TaskAssignmentEvent) {
+	if _m.Edges.namedAssignmentEvents == nil {
+		_m.Edges.namedAssignmentEvents = make(map[string][]*TaskAssignmentEvent)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedAssignmentEvents[name] = []*TaskAssignmentEvent{}
+	} else {
+		_m.Edges.namedAssignmentEvents[name] = append(_m.Edges.namedAssignmentEvents[name], edges...)
+	}
+}
+
+// NamedWatchers returns the Watchers named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Task) NamedWatchers(name string) ([]*TaskWatcher, error) {
+	if _m.Edges.namedWatchers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedWatchers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Task) appendNamedWatchers(name string, edges ...*TaskWatcher) {
+	if _m.Edges.namedWatchers == nil {
+		_m.Edges.namedWatchers = make(map[string][]*TaskWatcher)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedWatchers[name] = []*TaskWatcher{}
+	} else {
+		_m.Edges.namedWatchers[name] = append(_m.Edges.namedWatchers[name], edges...)
+	}
+}
+
+// NamedActivities returns the Activities named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Task) NamedActivities(name string) ([]*TaskActivity, error) {
+	if _m.Edges.namedActivities == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedActivities[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Task) appendNamedActivities(name string, edges ...*TaskActivity) {
+	if _m.Edges.namedActivities == nil {
+		_m.Edges.namedActivities = make(map[string][]*TaskActivity)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedActivities[name] = []*TaskActivity{}
+	} else {
+		_m.Edges.namedActivities[name] = append(_m.Edges.namedActivities[name], edges...)
+	}
+}
+
+// NamedReminders returns the Reminders named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Task) NamedReminders(name string) ([]*TaskReminder, error) {
+	if _m.Edges.namedReminders == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedReminders[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Task) appendNamedReminders(name string, edges ...*TaskReminder) {
+	if _m.Edges.namedReminders == nil {
+		_m.Edges.namedReminders = make(map[string][]*TaskReminder)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedReminders[name] = []*TaskReminder{}
+	} else {
+		_m.Edges.namedReminders[name] = append(_m.Edges.namedReminders[name], edges...)
+	}
+}
+
 // Tasks is a parsable slice of Task.
 type Tasks []*Task
@@ -4,8 +4,11 @@ package task
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -16,6 +19,10 @@ const (
 	Label = "task"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -32,12 +39,26 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldAssigneeID holds the string denoting the assignee_id field in the database.
-	FieldAssigneeID = "assignee_id"
+	// FieldMilestoneID holds the string denoting the milestone_id field in the database.
+	FieldMilestoneID = "milestone_id"
+	// FieldTrashedProjectID holds the string denoting the trashed_project_id field in the database.
+	FieldTrashedProjectID = "trashed_project_id"
+	// FieldTrashedPosition holds the string denoting the trashed_position field in the database.
+	FieldTrashedPosition = "trashed_position"
 	// EdgeProjectTasks holds the string denoting the project_tasks edge name in mutations.
 	EdgeProjectTasks = "project_tasks"
-	// EdgeAssignee holds the string denoting the assignee edge name in mutations.
-	EdgeAssignee = "assignee"
+	// EdgeAssignees holds the string denoting the assignees edge name in mutations.
+	EdgeAssignees = "assignees"
+	// EdgeAssignmentEvents holds the string denoting the assignment_events edge name in mutations.
+	EdgeAssignmentEvents = "assignment_events"
+	// EdgeWatchers holds the string denoting the watchers edge name in mutations.
+	EdgeWatchers = "watchers"
+	// EdgeActivities holds the string denoting the activities edge name in mutations.
+	EdgeActivities = "activities"
+	// EdgeReminders holds the string denoting the reminders edge name in mutations.
+	EdgeReminders = "reminders"
+	// EdgeMilestone holds the string denoting the milestone edge name in mutations.
+	EdgeMilestone = "milestone"
 	// Table holds the table name of the task in the database.
 	Table = "tasks"
 	// ProjectTasksTable is the table that holds the project_tasks relation/edge.
@@ -47,18 +68,55 @@ const (
 	ProjectTasksInverseTable = "project_tasks"
 	// ProjectTasksColumn is the table column denoting the project_tasks relation/edge.
 	ProjectTasksColumn = "task_project_tasks"
-	// AssigneeTable is the table that holds the assignee relation/edge.
-	AssigneeTable = "tasks"
-	// AssigneeInverseTable is the table name for the User entity.
-	// It exists in this package in order to avoid circular dependency with the "user" package.
-	AssigneeInverseTable = "users"
-	// AssigneeColumn is the table column denoting the assignee relation/edge.
-	AssigneeColumn = "assignee_id"
+	// AssigneesTable is the table that holds the assignees relation/edge.
+	AssigneesTable = "task_assignees"
+	// AssigneesInverseTable is the table name for the TaskAssignee entity.
+	// It exists in this package in order to avoid circular dependency with the "taskassignee" package.
+	AssigneesInverseTable = "task_assignees"
+	// AssigneesColumn is the table column denoting the assignees relation/edge.
+	AssigneesColumn = "task_assignees"
+	// AssignmentEventsTable is the table that holds the assignment_events relation/edge.
+	AssignmentEventsTable = "task_assignment_events"
+	// AssignmentEventsInverseTable is the table name for the TaskAssignmentEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "taskassignmentevent" package.
+	AssignmentEventsInverseTable = "task_assignment_events"
+	// AssignmentEventsColumn is the table column denoting the assignment_events relation/edge.
+	AssignmentEventsColumn = "task_assignment_events"
+	// WatchersTable is the table that holds the watchers relation/edge.
+	WatchersTable = "task_watchers"
+	// WatchersInverseTable is the table name for the TaskWatcher entity.
+	// It exists in this package in order to avoid circular dependency with the "taskwatcher" package.
+	WatchersInverseTable = "task_watchers"
+	// WatchersColumn is the table column denoting the watchers relation/edge.
+	WatchersColumn = "task_watchers"
+	// ActivitiesTable is the table that holds the activities relation/edge.
+	ActivitiesTable = "task_activities"
+	// ActivitiesInverseTable is the table name for the TaskActivity entity.
+	// It exists in this package in order to avoid circular dependency with the "taskactivity" package.
+	ActivitiesInverseTable = "task_activities"
+	// ActivitiesColumn is the table column denoting the activities relation/edge.
+	ActivitiesColumn = "task_activities"
+	// RemindersTable is the table that holds the reminders relation/edge.
+	RemindersTable = "task_reminders"
+	// RemindersInverseTable is the table name for the TaskReminder entity.
+	// It exists in this package in order to avoid circular dependency with the "taskreminder" package.
+	RemindersInverseTable = "task_reminders"
+	// RemindersColumn is the table column denoting the reminders relation/edge.
+	RemindersColumn = "task_reminders"
+	// MilestoneTable is the table that holds the milestone relation/edge.
+	MilestoneTable = "tasks"
+	// MilestoneInverseTable is the table name for the Milestone entity.
+	// It exists in this package in order to avoid circular dependency with the "milestone" package.
+	MilestoneInverseTable = "milestones"
+	// MilestoneColumn is the table column denoting the milestone relation/edge.
+	MilestoneColumn = "milestone_id"
 )
 
 // Columns holds all SQL columns for task fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
+	FieldVersion,
 	FieldTitle,
 	FieldDescription,
 	FieldStatus,
@@ -67,7 +125,9 @@ var Columns = []string{
 	FieldDueDate,
 	FieldCreatedAt,
 	FieldUpdatedAt,
-	FieldAssigneeID,
+	FieldMilestoneID,
+	FieldTrashedProjectID,
+	FieldTrashedPosition,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,7 +140,16 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "project-manager-dashboard-go/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultPosition holds the default value on creation for the "position" field.
 	DefaultPosition int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -155,6 +224,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
@@ -195,9 +274,19 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByAssigneeID orders the results by the assignee_id field.
-func ByAssigneeID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldAssigneeID, opts...).ToFunc()
+// ByMilestoneID orders the results by the milestone_id field.
+func ByMilestoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMilestoneID, opts...).ToFunc()
+}
+
+// ByTrashedProjectID orders the results by the trashed_project_id field.
+func ByTrashedProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashedProjectID, opts...).ToFunc()
+}
+
+// ByTrashedPosition orders the results by the trashed_position field.
+func ByTrashedPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashedPosition, opts...).ToFunc()
 }
 
 // ByProjectTasksCount orders the results by project_tasks count.
@@ -214,10 +303,80 @@ func ByProjectTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
-// ByAssigneeField orders the results by assignee field.
-func ByAssigneeField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByAssigneesCount orders the results by assignees count.
+func ByAssigneesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssigneesStep(), opts...)
+	}
+}
+
+// ByAssignees orders the results by assignees terms.
+func ByAssignees(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssigneesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAssignmentEventsCount orders the results by assignment_events count.
+func ByAssignmentEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignmentEventsStep(), opts...)
+	}
+}
+
+// ByAssignmentEvents orders the results by assignment_events terms.
+func ByAssignmentEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignmentEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWatchersCount orders the results by watchers count.
+func ByWatchersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchersStep(), opts...)
+	}
+}
+
+// ByWatchers orders the results by watchers terms.
+func ByWatchers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByActivitiesCount orders the results by activities count.
+func ByActivitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActivitiesStep(), opts...)
+	}
+}
+
+// ByActivities orders the results by activities terms.
+func ByActivities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActivitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRemindersCount orders the results by reminders count.
+func ByRemindersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newAssigneeStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborsCount(s, newRemindersStep(), opts...)
+	}
+}
+
+// ByReminders orders the results by reminders terms.
+func ByReminders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRemindersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMilestoneField orders the results by milestone field.
+func ByMilestoneField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMilestoneStep(), sql.OrderByField(field, opts...))
 	}
 }
 func newProjectTasksStep() *sqlgraph.Step {
@@ -227,10 +386,81 @@ func newProjectTasksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ProjectTasksTable, ProjectTasksColumn),
 	)
 }
-func newAssigneeStep() *sqlgraph.Step {
+func newAssigneesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssigneesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssigneesTable, AssigneesColumn),
+	)
+}
+func newAssignmentEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignmentEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssignmentEventsTable, AssignmentEventsColumn),
+	)
+}
+func newWatchersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WatchersTable, WatchersColumn),
+	)
+}
+func newActivitiesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(AssigneeInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, AssigneeTable, AssigneeColumn),
+		sqlgraph.To(ActivitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
 	)
 }
+func newRemindersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RemindersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RemindersTable, RemindersColumn),
+	)
+}
+func newMilestoneStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MilestoneInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MilestoneTable, MilestoneColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Priority) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Priority) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Priority(str)
+	if err := PriorityValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Priority", str)
+	}
+	return nil
+}
@@ -56,6 +56,16 @@ func IDLTE(id uuid.UUID) predicate.Task {
 	return predicate.Task(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldVersion, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldTitle, v))
@@ -86,9 +96,109 @@ func UpdatedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// AssigneeID applies equality check predicate on the "assignee_id" field. It's identical to AssigneeIDEQ.
-func AssigneeID(v uuid.UUID) predicate.Task {
-	return predicate.Task(sql.FieldEQ(FieldAssigneeID, v))
+// MilestoneID applies equality check predicate on the "milestone_id" field. It's identical to MilestoneIDEQ.
+func MilestoneID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// TrashedProjectID applies equality check predicate on the "trashed_project_id" field. It's identical to TrashedProjectIDEQ.
+func TrashedProjectID(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTrashedProjectID, v))
+}
+
+// TrashedPosition applies equality check predicate on the "trashed_position" field. It's identical to TrashedPositionEQ.
+func TrashedPosition(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTrashedPosition, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldVersion, v))
 }
 
 // TitleEQ applies the EQ predicate on the "title" field.
@@ -441,34 +551,134 @@ func UpdatedAtLTE(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// AssigneeIDEQ applies the EQ predicate on the "assignee_id" field.
-func AssigneeIDEQ(v uuid.UUID) predicate.Task {
-	return predicate.Task(sql.FieldEQ(FieldAssigneeID, v))
+// MilestoneIDEQ applies the EQ predicate on the "milestone_id" field.
+func MilestoneIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDNEQ applies the NEQ predicate on the "milestone_id" field.
+func MilestoneIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDIn applies the In predicate on the "milestone_id" field.
+func MilestoneIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDNotIn applies the NotIn predicate on the "milestone_id" field.
+func MilestoneIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDIsNil applies the IsNil predicate on the "milestone_id" field.
+func MilestoneIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldMilestoneID))
+}
+
+// MilestoneIDNotNil applies the NotNil predicate on the "milestone_id" field.
+func MilestoneIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldMilestoneID))
+}
+
+// TrashedProjectIDEQ applies the EQ predicate on the "trashed_project_id" field.
+func TrashedProjectIDEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTrashedProjectID, v))
+}
+
+// TrashedProjectIDNEQ applies the NEQ predicate on the "trashed_project_id" field.
+func TrashedProjectIDNEQ(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldTrashedProjectID, v))
+}
+
+// TrashedProjectIDIn applies the In predicate on the "trashed_project_id" field.
+func TrashedProjectIDIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldTrashedProjectID, vs...))
+}
+
+// TrashedProjectIDNotIn applies the NotIn predicate on the "trashed_project_id" field.
+func TrashedProjectIDNotIn(vs ...uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldTrashedProjectID, vs...))
+}
+
+// TrashedProjectIDGT applies the GT predicate on the "trashed_project_id" field.
+func TrashedProjectIDGT(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldTrashedProjectID, v))
 }
 
-// AssigneeIDNEQ applies the NEQ predicate on the "assignee_id" field.
-func AssigneeIDNEQ(v uuid.UUID) predicate.Task {
-	return predicate.Task(sql.FieldNEQ(FieldAssigneeID, v))
+// TrashedProjectIDGTE applies the GTE predicate on the "trashed_project_id" field.
+func TrashedProjectIDGTE(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldTrashedProjectID, v))
 }
 
-// AssigneeIDIn applies the In predicate on the "assignee_id" field.
-func AssigneeIDIn(vs ...uuid.UUID) predicate.Task {
-	return predicate.Task(sql.FieldIn(FieldAssigneeID, vs...))
+// TrashedProjectIDLT applies the LT predicate on the "trashed_project_id" field.
+func TrashedProjectIDLT(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldTrashedProjectID, v))
 }
 
-// AssigneeIDNotIn applies the NotIn predicate on the "assignee_id" field.
-func AssigneeIDNotIn(vs ...uuid.UUID) predicate.Task {
-	return predicate.Task(sql.FieldNotIn(FieldAssigneeID, vs...))
+// TrashedProjectIDLTE applies the LTE predicate on the "trashed_project_id" field.
+func TrashedProjectIDLTE(v uuid.UUID) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldTrashedProjectID, v))
 }
 
-// AssigneeIDIsNil applies the IsNil predicate on the "assignee_id" field.
-func AssigneeIDIsNil() predicate.Task {
-	return predicate.Task(sql.FieldIsNull(FieldAssigneeID))
+// TrashedProjectIDIsNil applies the IsNil predicate on the "trashed_project_id" field.
+func TrashedProjectIDIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldTrashedProjectID))
 }
 
-// AssigneeIDNotNil applies the NotNil predicate on the "assignee_id" field.
-func AssigneeIDNotNil() predicate.Task {
-	return predicate.Task(sql.FieldNotNull(FieldAssigneeID))
+// TrashedProjectIDNotNil applies the NotNil predicate on the "trashed_project_id" field.
+func TrashedProjectIDNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldTrashedProjectID))
+}
+
+// TrashedPositionEQ applies the EQ predicate on the "trashed_position" field.
+func TrashedPositionEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTrashedPosition, v))
+}
+
+// TrashedPositionNEQ applies the NEQ predicate on the "trashed_position" field.
+func TrashedPositionNEQ(v int) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldTrashedPosition, v))
+}
+
+// TrashedPositionIn applies the In predicate on the "trashed_position" field.
+func TrashedPositionIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldTrashedPosition, vs...))
+}
+
+// TrashedPositionNotIn applies the NotIn predicate on the "trashed_position" field.
+func TrashedPositionNotIn(vs ...int) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldTrashedPosition, vs...))
+}
+
+// TrashedPositionGT applies the GT predicate on the "trashed_position" field.
+func TrashedPositionGT(v int) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldTrashedPosition, v))
+}
+
+// TrashedPositionGTE applies the GTE predicate on the "trashed_position" field.
+func TrashedPositionGTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldTrashedPosition, v))
+}
+
+// TrashedPositionLT applies the LT predicate on the "trashed_position" field.
+func TrashedPositionLT(v int) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldTrashedPosition, v))
+}
+
+// TrashedPositionLTE applies the LTE predicate on the "trashed_position" field.
+func TrashedPositionLTE(v int) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldTrashedPosition, v))
+}
+
+// TrashedPositionIsNil applies the IsNil predicate on the "trashed_position" field.
+func TrashedPositionIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldTrashedPosition))
+}
+
+// TrashedPositionNotNil applies the NotNil predicate on the "trashed_position" field.
+func TrashedPositionNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldTrashedPosition))
 }
 
 // HasProjectTasks applies the HasEdge predicate on the "project_tasks" edge.
@@ -494,21 +704,136 @@ func HasProjectTasksWith(preds ...predicate.ProjectTask) predicate.Task {
 	})
 }
 
-// HasAssignee applies the HasEdge predicate on the "assignee" edge.
-func HasAssignee() predicate.Task {
+// HasAssignees applies the HasEdge predicate on the "assignees" edge.
+func HasAssignees() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssigneesTable, AssigneesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssigneesWith applies the HasEdge predicate on the "assignees" edge with a given conditions (other predicates).
+func HasAssigneesWith(preds ...predicate.TaskAssignee) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newAssigneesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignmentEvents applies the HasEdge predicate on the "assignment_events" edge.
+func HasAssignmentEvents() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssignmentEventsTable, AssignmentEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignmentEventsWith applies the HasEdge predicate on the "assignment_events" edge with a given conditions (other predicates).
+func HasAssignmentEventsWith(preds ...predicate.TaskAssignmentEvent) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newAssignmentEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWatchers applies the HasEdge predicate on the "watchers" edge.
+func HasWatchers() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WatchersTable, WatchersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWatchersWith applies the HasEdge predicate on the "watchers" edge with a given conditions (other predicates).
+func HasWatchersWith(preds ...predicate.TaskWatcher) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newWatchersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActivities applies the HasEdge predicate on the "activities" edge.
+func HasActivities() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActivitiesWith applies the HasEdge predicate on the "activities" edge with a given conditions (other predicates).
+func HasActivitiesWith(preds ...predicate.TaskActivity) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newActivitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReminders applies the HasEdge predicate on the "reminders" edge.
+func HasReminders() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RemindersTable, RemindersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRemindersWith applies the HasEdge predicate on the "reminders" edge with a given conditions (other predicates).
+func HasRemindersWith(preds ...predicate.TaskReminder) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newRemindersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMilestone applies the HasEdge predicate on the "milestone" edge.
+func HasMilestone() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, AssigneeTable, AssigneeColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, MilestoneTable, MilestoneColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasAssigneeWith applies the HasEdge predicate on the "assignee" edge with a given conditions (other predicates).
-func HasAssigneeWith(preds ...predicate.User) predicate.Task {
+// HasMilestoneWith applies the HasEdge predicate on the "milestone" edge with a given conditions (other predicates).
+func HasMilestoneWith(preds ...predicate.Milestone) predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
-		step := newAssigneeStep()
+		step := newMilestoneStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
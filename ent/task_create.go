@@ -6,9 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager-dashboard-go/ent/milestone"
 	"project-manager-dashboard-go/ent/projecttask"
 	"project-manager-dashboard-go/ent/task"
-	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"project-manager-dashboard-go/ent/taskwatcher"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -23,6 +28,34 @@ type TaskCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TaskCreate) SetDeletedAt(v time.Time) *TaskCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableDeletedAt(v *time.Time) *TaskCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *TaskCreate) SetVersion(v int) *TaskCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableVersion(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *TaskCreate) SetTitle(v string) *TaskCreate {
 	_c.mutation.SetTitle(v)
@@ -127,16 +160,44 @@ func (_c *TaskCreate) SetNillableUpdatedAt(v *time.Time) *TaskCreate {
 	return _c
 }
 
-// SetAssigneeID sets the "assignee_id" field.
-func (_c *TaskCreate) SetAssigneeID(v uuid.UUID) *TaskCreate {
-	_c.mutation.SetAssigneeID(v)
+// SetMilestoneID sets the "milestone_id" field.
+func (_c *TaskCreate) SetMilestoneID(v uuid.UUID) *TaskCreate {
+	_c.mutation.SetMilestoneID(v)
+	return _c
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableMilestoneID(v *uuid.UUID) *TaskCreate {
+	if v != nil {
+		_c.SetMilestoneID(*v)
+	}
+	return _c
+}
+
+// SetTrashedProjectID sets the "trashed_project_id" field.
+func (_c *TaskCreate) SetTrashedProjectID(v uuid.UUID) *TaskCreate {
+	_c.mutation.SetTrashedProjectID(v)
 	return _c
 }
 
-// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
-func (_c *TaskCreate) SetNillableAssigneeID(v *uuid.UUID) *TaskCreate {
+// SetNillableTrashedProjectID sets the "trashed_project_id" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableTrashedProjectID(v *uuid.UUID) *TaskCreate {
 	if v != nil {
-		_c.SetAssigneeID(*v)
+		_c.SetTrashedProjectID(*v)
+	}
+	return _c
+}
+
+// SetTrashedPosition sets the "trashed_position" field.
+func (_c *TaskCreate) SetTrashedPosition(v int) *TaskCreate {
+	_c.mutation.SetTrashedPosition(v)
+	return _c
+}
+
+// SetNillableTrashedPosition sets the "trashed_position" field if the given value is not nil.
+func (_c *TaskCreate) SetNillableTrashedPosition(v *int) *TaskCreate {
+	if v != nil {
+		_c.SetTrashedPosition(*v)
 	}
 	return _c
 }
@@ -170,9 +231,84 @@ func (_c *TaskCreate) AddProjectTasks(v ...*ProjectTask) *TaskCreate {
 	return _c.AddProjectTaskIDs(ids...)
 }
 
-// SetAssignee sets the "assignee" edge to the User entity.
-func (_c *TaskCreate) SetAssignee(v *User) *TaskCreate {
-	return _c.SetAssigneeID(v.ID)
+// AddAssigneeIDs adds the "assignees" edge to the TaskAssignee entity by IDs.
+func (_c *TaskCreate) AddAssigneeIDs(ids ...uuid.UUID) *TaskCreate {
+	_c.mutation.AddAssigneeIDs(ids...)
+	return _c
+}
+
+// AddAssignees adds the "assignees" edges to the TaskAssignee entity.
+func (_c *TaskCreate) AddAssignees(v ...*TaskAssignee) *TaskCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssigneeIDs(ids...)
+}
+
+// AddAssignmentEventIDs adds the "assignment_events" edge to the TaskAssignmentEvent entity by IDs.
+func (_c *TaskCreate) AddAssignmentEventIDs(ids ...uuid.UUID) *TaskCreate {
+	_c.mutation.AddAssignmentEventIDs(ids...)
+	return _c
+}
+
+// AddAssignmentEvents adds the "assignment_events" edges to the TaskAssignmentEvent entity.
+func (_c *TaskCreate) AddAssignmentEvents(v ...*TaskAssignmentEvent) *TaskCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssignmentEventIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the TaskWatcher entity by IDs.
+func (_c *TaskCreate) AddWatcherIDs(ids ...uuid.UUID) *TaskCreate {
+	_c.mutation.AddWatcherIDs(ids...)
+	return _c
+}
+
+// AddWatchers adds the "watchers" edges to the TaskWatcher entity.
+func (_c *TaskCreate) AddWatchers(v ...*TaskWatcher) *TaskCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWatcherIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the TaskActivity entity by IDs.
+func (_c *TaskCreate) AddActivityIDs(ids ...uuid.UUID) *TaskCreate {
+	_c.mutation.AddActivityIDs(ids...)
+	return _c
+}
+
+// AddActivities adds the "activities" edges to the TaskActivity entity.
+func (_c *TaskCreate) AddActivities(v ...*TaskActivity) *TaskCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddActivityIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the TaskReminder entity by IDs.
+func (_c *TaskCreate) AddReminderIDs(ids ...uuid.UUID) *TaskCreate {
+	_c.mutation.AddReminderIDs(ids...)
+	return _c
+}
+
+// AddReminders adds the "reminders" edges to the TaskReminder entity.
+func (_c *TaskCreate) AddReminders(v ...*TaskReminder) *TaskCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReminderIDs(ids...)
+}
+
+// SetMilestone sets the "milestone" edge to the Milestone entity.
+func (_c *TaskCreate) SetMilestone(v *Milestone) *TaskCreate {
+	return _c.SetMilestoneID(v.ID)
 }
 
 // Mutation returns the TaskMutation object of the builder.
@@ -182,7 +318,9 @@ func (_c *TaskCreate) Mutation() *TaskMutation {
 
 // Save creates the Task in the database.
 func (_c *TaskCreate) Save(ctx context.Context) (*Task, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -209,7 +347,11 @@ func (_c *TaskCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TaskCreate) defaults() {
+func (_c *TaskCreate) defaults() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		v := task.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := task.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -223,21 +365,34 @@ func (_c *TaskCreate) defaults() {
 		_c.mutation.SetPosition(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if task.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized task.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := task.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if task.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized task.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := task.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if task.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized task.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := task.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *TaskCreate) check() error {
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Task.version"`)}
+	}
 	if _, ok := _c.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Task.title"`)}
 	}
@@ -301,6 +456,14 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(task.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -333,6 +496,14 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.TrashedProjectID(); ok {
+		_spec.SetField(task.FieldTrashedProjectID, field.TypeUUID, value)
+		_node.TrashedProjectID = &value
+	}
+	if value, ok := _c.mutation.TrashedPosition(); ok {
+		_spec.SetField(task.FieldTrashedPosition, field.TypeInt, value)
+		_node.TrashedPosition = &value
+	}
 	if nodes := _c.mutation.ProjectTasksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -349,21 +520,101 @@ func (_c *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.AssigneeIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: []string{task.AssigneesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssignmentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssignmentEventsTable,
+			Columns: []string{task.AssignmentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MilestoneIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   task.AssigneeTable,
-			Columns: []string{task.AssigneeColumn},
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.AssigneeID = &nodes[0]
+		_node.MilestoneID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
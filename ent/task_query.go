@@ -7,10 +7,15 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"project-manager-dashboard-go/ent/milestone"
 	"project-manager-dashboard-go/ent/predicate"
 	"project-manager-dashboard-go/ent/projecttask"
 	"project-manager-dashboard-go/ent/task"
-	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"project-manager-dashboard-go/ent/taskwatcher"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -22,12 +27,25 @@ import (
 // TaskQuery is the builder for querying Task entities.
 type TaskQuery struct {
 	config
-	ctx              *QueryContext
-	order            []task.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Task
-	withProjectTasks *ProjectTaskQuery
-	withAssignee     *UserQuery
+	ctx                       *QueryContext
+	order                     []task.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Task
+	withProjectTasks          *ProjectTaskQuery
+	withAssignees             *TaskAssigneeQuery
+	withAssignmentEvents      *TaskAssignmentEventQuery
+	withWatchers              *TaskWatcherQuery
+	withActivities            *TaskActivityQuery
+	withReminders             *TaskReminderQuery
+	withMilestone             *MilestoneQuery
+	modifiers                 []func(*sql.Selector)
+	loadTotal                 []func(context.Context, []*Task) error
+	withNamedProjectTasks     map[string]*ProjectTaskQuery
+	withNamedAssignees        map[string]*TaskAssigneeQuery
+	withNamedAssignmentEvents map[string]*TaskAssignmentEventQuery
+	withNamedWatchers         map[string]*TaskWatcherQuery
+	withNamedActivities       map[string]*TaskActivityQuery
+	withNamedReminders        map[string]*TaskReminderQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -86,9 +104,9 @@ func (_q *TaskQuery) QueryProjectTasks() *ProjectTaskQuery {
 	return query
 }
 
-// QueryAssignee chains the current query on the "assignee" edge.
-func (_q *TaskQuery) QueryAssignee() *UserQuery {
-	query := (&UserClient{config: _q.config}).Query()
+// QueryAssignees chains the current query on the "assignees" edge.
+func (_q *TaskQuery) QueryAssignees() *TaskAssigneeQuery {
+	query := (&TaskAssigneeClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -99,8 +117,118 @@ func (_q *TaskQuery) QueryAssignee() *UserQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(task.Table, task.FieldID, selector),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, task.AssigneeTable, task.AssigneeColumn),
+			sqlgraph.To(taskassignee.Table, taskassignee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.AssigneesTable, task.AssigneesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignmentEvents chains the current query on the "assignment_events" edge.
+func (_q *TaskQuery) QueryAssignmentEvents() *TaskAssignmentEventQuery {
+	query := (&TaskAssignmentEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskassignmentevent.Table, taskassignmentevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.AssignmentEventsTable, task.AssignmentEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWatchers chains the current query on the "watchers" edge.
+func (_q *TaskQuery) QueryWatchers() *TaskWatcherQuery {
+	query := (&TaskWatcherClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskwatcher.Table, taskwatcher.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.WatchersTable, task.WatchersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActivities chains the current query on the "activities" edge.
+func (_q *TaskQuery) QueryActivities() *TaskActivityQuery {
+	query := (&TaskActivityClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskactivity.Table, taskactivity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ActivitiesTable, task.ActivitiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReminders chains the current query on the "reminders" edge.
+func (_q *TaskQuery) QueryReminders() *TaskReminderQuery {
+	query := (&TaskReminderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(taskreminder.Table, taskreminder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.RemindersTable, task.RemindersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMilestone chains the current query on the "milestone" edge.
+func (_q *TaskQuery) QueryMilestone() *MilestoneQuery {
+	query := (&MilestoneClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(milestone.Table, milestone.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.MilestoneTable, task.MilestoneColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -295,13 +423,18 @@ func (_q *TaskQuery) Clone() *TaskQuery {
 		return nil
 	}
 	return &TaskQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]task.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Task{}, _q.predicates...),
-		withProjectTasks: _q.withProjectTasks.Clone(),
-		withAssignee:     _q.withAssignee.Clone(),
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]task.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.Task{}, _q.predicates...),
+		withProjectTasks:     _q.withProjectTasks.Clone(),
+		withAssignees:        _q.withAssignees.Clone(),
+		withAssignmentEvents: _q.withAssignmentEvents.Clone(),
+		withWatchers:         _q.withWatchers.Clone(),
+		withActivities:       _q.withActivities.Clone(),
+		withReminders:        _q.withReminders.Clone(),
+		withMilestone:        _q.withMilestone.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -319,14 +452,69 @@ func (_q *TaskQuery) WithProjectTasks(opts ...func(*ProjectTaskQuery)) *TaskQuer
 	return _q
 }
 
-// WithAssignee tells the query-builder to eager-load the nodes that are connected to
-// the "assignee" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *TaskQuery) WithAssignee(opts ...func(*UserQuery)) *TaskQuery {
-	query := (&UserClient{config: _q.config}).Query()
+// WithAssignees tells the query-builder to eager-load the nodes that are connected to
+// the "assignees" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithAssignees(opts ...func(*TaskAssigneeQuery)) *TaskQuery {
+	query := (&TaskAssigneeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssignees = query
+	return _q
+}
+
+// WithAssignmentEvents tells the query-builder to eager-load the nodes that are connected to
+// the "assignment_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithAssignmentEvents(opts ...func(*TaskAssignmentEventQuery)) *TaskQuery {
+	query := (&TaskAssignmentEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssignmentEvents = query
+	return _q
+}
+
+// WithWatchers tells the query-builder to eager-load the nodes that are connected to
+// the "watchers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithWatchers(opts ...func(*TaskWatcherQuery)) *TaskQuery {
+	query := (&TaskWatcherClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWatchers = query
+	return _q
+}
+
+// WithActivities tells the query-builder to eager-load the nodes that are connected to
+// the "activities" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithActivities(opts ...func(*TaskActivityQuery)) *TaskQuery {
+	query := (&TaskActivityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withActivities = query
+	return _q
+}
+
+// WithReminders tells the query-builder to eager-load the nodes that are connected to
+// the "reminders" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithReminders(opts ...func(*TaskReminderQuery)) *TaskQuery {
+	query := (&TaskReminderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReminders = query
+	return _q
+}
+
+// WithMilestone tells the query-builder to eager-load the nodes that are connected to
+// the "milestone" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithMilestone(opts ...func(*MilestoneQuery)) *TaskQuery {
+	query := (&MilestoneClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withAssignee = query
+	_q.withMilestone = query
 	return _q
 }
 
@@ -336,12 +524,12 @@ func (_q *TaskQuery) WithAssignee(opts ...func(*UserQuery)) *TaskQuery {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Task.Query().
-//		GroupBy(task.FieldTitle).
+//		GroupBy(task.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
@@ -359,11 +547,11 @@ func (_q *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Title string `json:"title,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Task.Query().
-//		Select(task.FieldTitle).
+//		Select(task.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *TaskQuery) Select(fields ...string) *TaskSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -408,9 +596,14 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 	var (
 		nodes       = []*Task{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [7]bool{
 			_q.withProjectTasks != nil,
-			_q.withAssignee != nil,
+			_q.withAssignees != nil,
+			_q.withAssignmentEvents != nil,
+			_q.withWatchers != nil,
+			_q.withActivities != nil,
+			_q.withReminders != nil,
+			_q.withMilestone != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -422,6 +615,9 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -438,9 +634,91 @@ func (_q *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 			return nil, err
 		}
 	}
-	if query := _q.withAssignee; query != nil {
-		if err := _q.loadAssignee(ctx, query, nodes, nil,
-			func(n *Task, e *User) { n.Edges.Assignee = e }); err != nil {
+	if query := _q.withAssignees; query != nil {
+		if err := _q.loadAssignees(ctx, query, nodes,
+			func(n *Task) { n.Edges.Assignees = []*TaskAssignee{} },
+			func(n *Task, e *TaskAssignee) { n.Edges.Assignees = append(n.Edges.Assignees, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAssignmentEvents; query != nil {
+		if err := _q.loadAssignmentEvents(ctx, query, nodes,
+			func(n *Task) { n.Edges.AssignmentEvents = []*TaskAssignmentEvent{} },
+			func(n *Task, e *TaskAssignmentEvent) { n.Edges.AssignmentEvents = append(n.Edges.AssignmentEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWatchers; query != nil {
+		if err := _q.loadWatchers(ctx, query, nodes,
+			func(n *Task) { n.Edges.Watchers = []*TaskWatcher{} },
+			func(n *Task, e *TaskWatcher) { n.Edges.Watchers = append(n.Edges.Watchers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withActivities; query != nil {
+		if err := _q.loadActivities(ctx, query, nodes,
+			func(n *Task) { n.Edges.Activities = []*TaskActivity{} },
+			func(n *Task, e *TaskActivity) { n.Edges.Activities = append(n.Edges.Activities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReminders; query != nil {
+		if err := _q.loadReminders(ctx, query, nodes,
+			func(n *Task) { n.Edges.Reminders = []*TaskReminder{} },
+			func(n *Task, e *TaskReminder) { n.Edges.Reminders = append(n.Edges.Reminders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMilestone; query != nil {
+		if err := _q.loadMilestone(ctx, query, nodes, nil,
+			func(n *Task, e *Milestone) { n.Edges.Milestone = e }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedProjectTasks {
+		if err := _q.loadProjectTasks(ctx, query, nodes,
+			func(n *Task) { n.appendNamedProjectTasks(name) },
+			func(n *Task, e *ProjectTask) { n.appendNamedProjectTasks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedAssignees {
+		if err := _q.loadAssignees(ctx, query, nodes,
+			func(n *Task) { n.appendNamedAssignees(name) },
+			func(n *Task, e *TaskAssignee) { n.appendNamedAssignees(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedAssignmentEvents {
+		if err := _q.loadAssignmentEvents(ctx, query, nodes,
+			func(n *Task) { n.appendNamedAssignmentEvents(name) },
+			func(n *Task, e *TaskAssignmentEvent) { n.appendNamedAssignmentEvents(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedWatchers {
+		if err := _q.loadWatchers(ctx, query, nodes,
+			func(n *Task) { n.appendNamedWatchers(name) },
+			func(n *Task, e *TaskWatcher) { n.appendNamedWatchers(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedActivities {
+		if err := _q.loadActivities(ctx, query, nodes,
+			func(n *Task) { n.appendNamedActivities(name) },
+			func(n *Task, e *TaskActivity) { n.appendNamedActivities(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedReminders {
+		if err := _q.loadReminders(ctx, query, nodes,
+			func(n *Task) { n.appendNamedReminders(name) },
+			func(n *Task, e *TaskReminder) { n.appendNamedReminders(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
 		}
 	}
@@ -478,14 +756,169 @@ func (_q *TaskQuery) loadProjectTasks(ctx context.Context, query *ProjectTaskQue
 	}
 	return nil
 }
-func (_q *TaskQuery) loadAssignee(ctx context.Context, query *UserQuery, nodes []*Task, init func(*Task), assign func(*Task, *User)) error {
+func (_q *TaskQuery) loadAssignees(ctx context.Context, query *TaskAssigneeQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskAssignee)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TaskAssignee(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.AssigneesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.task_assignees
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_assignees" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_assignees" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TaskQuery) loadAssignmentEvents(ctx context.Context, query *TaskAssignmentEventQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskAssignmentEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TaskAssignmentEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.AssignmentEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.task_assignment_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_assignment_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_assignment_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TaskQuery) loadWatchers(ctx context.Context, query *TaskWatcherQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskWatcher)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TaskWatcher(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.WatchersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.task_watchers
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_watchers" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_watchers" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TaskQuery) loadActivities(ctx context.Context, query *TaskActivityQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskActivity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TaskActivity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.ActivitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.task_activities
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_activities" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_activities" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TaskQuery) loadReminders(ctx context.Context, query *TaskReminderQuery, nodes []*Task, init func(*Task), assign func(*Task, *TaskReminder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TaskReminder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.RemindersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.task_reminders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "task_reminders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_reminders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TaskQuery) loadMilestone(ctx context.Context, query *MilestoneQuery, nodes []*Task, init func(*Task), assign func(*Task, *Milestone)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Task)
 	for i := range nodes {
-		if nodes[i].AssigneeID == nil {
+		if nodes[i].MilestoneID == nil {
 			continue
 		}
-		fk := *nodes[i].AssigneeID
+		fk := *nodes[i].MilestoneID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -494,7 +927,7 @@ func (_q *TaskQuery) loadAssignee(ctx context.Context, query *UserQuery, nodes [
 	if len(ids) == 0 {
 		return nil
 	}
-	query.Where(user.IDIn(ids...))
+	query.Where(milestone.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
@@ -502,7 +935,7 @@ func (_q *TaskQuery) loadAssignee(ctx context.Context, query *UserQuery, nodes [
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "assignee_id" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "milestone_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -513,6 +946,9 @@ func (_q *TaskQuery) loadAssignee(ctx context.Context, query *UserQuery, nodes [
 
 func (_q *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -536,8 +972,8 @@ func (_q *TaskQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
-		if _q.withAssignee != nil {
-			_spec.Node.AddColumnOnce(task.FieldAssigneeID)
+		if _q.withMilestone != nil {
+			_spec.Node.AddColumnOnce(task.FieldMilestoneID)
 		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
@@ -595,6 +1031,90 @@ func (_q *TaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedProjectTasks tells the query-builder to eager-load the nodes that are connected to the "project_tasks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithNamedProjectTasks(name string, opts ...func(*ProjectTaskQuery)) *TaskQuery {
+	query := (&ProjectTaskClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedProjectTasks == nil {
+		_q.withNamedProjectTasks = make(map[string]*ProjectTaskQuery)
+	}
+	_q.withNamedProjectTasks[name] = query
+	return _q
+}
+
+// WithNamedAssignees tells the query-builder to eager-load the nodes that are connected to the "assignees"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithNamedAssignees(name string, opts ...func(*TaskAssigneeQuery)) *TaskQuery {
+	query := (&TaskAssigneeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedAssignees == nil {
+		_q.withNamedAssignees = make(map[string]*TaskAssigneeQuery)
+	}
+	_q.withNamedAssignees[name] = query
+	return _q
+}
+
+// WithNamedAssignmentEvents tells the query-builder to eager-load the nodes that are connected to the "assignment_events"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithNamedAssignmentEvents(name string, opts ...func(*TaskAssignmentEventQuery)) *TaskQuery {
+	query := (&TaskAssignmentEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedAssignmentEvents == nil {
+		_q.withNamedAssignmentEvents = make(map[string]*TaskAssignmentEventQuery)
+	}
+	_q.withNamedAssignmentEvents[name] = query
+	return _q
+}
+
+// WithNamedWatchers tells the query-builder to eager-load the nodes that are connected to the "watchers"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithNamedWatchers(name string, opts ...func(*TaskWatcherQuery)) *TaskQuery {
+	query := (&TaskWatcherClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedWatchers == nil {
+		_q.withNamedWatchers = make(map[string]*TaskWatcherQuery)
+	}
+	_q.withNamedWatchers[name] = query
+	return _q
+}
+
+// WithNamedActivities tells the query-builder to eager-load the nodes that are connected to the "activities"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithNamedActivities(name string, opts ...func(*TaskActivityQuery)) *TaskQuery {
+	query := (&TaskActivityClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedActivities == nil {
+		_q.withNamedActivities = make(map[string]*TaskActivityQuery)
+	}
+	_q.withNamedActivities[name] = query
+	return _q
+}
+
+// WithNamedReminders tells the query-builder to eager-load the nodes that are connected to the "reminders"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *TaskQuery) WithNamedReminders(name string, opts ...func(*TaskReminderQuery)) *TaskQuery {
+	query := (&TaskReminderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedReminders == nil {
+		_q.withNamedReminders = make(map[string]*TaskReminderQuery)
+	}
+	_q.withNamedReminders[name] = query
+	return _q
+}
+
 // TaskGroupBy is the group-by builder for Task entities.
 type TaskGroupBy struct {
 	selector
@@ -6,10 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager-dashboard-go/ent/milestone"
 	"project-manager-dashboard-go/ent/predicate"
 	"project-manager-dashboard-go/ent/projecttask"
 	"project-manager-dashboard-go/ent/task"
-	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"project-manager-dashboard-go/ent/taskwatcher"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -31,6 +36,47 @@ func (_u *TaskUpdate) Where(ps ...predicate.Task) *TaskUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskUpdate) SetDeletedAt(v time.Time) *TaskUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableDeletedAt(v *time.Time) *TaskUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskUpdate) ClearDeletedAt() *TaskUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TaskUpdate) SetVersion(v int) *TaskUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableVersion(v *int) *TaskUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TaskUpdate) AddVersion(v int) *TaskUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetTitle sets the "title" field.
 func (_u *TaskUpdate) SetTitle(v string) *TaskUpdate {
 	_u.mutation.SetTitle(v)
@@ -154,23 +200,70 @@ func (_u *TaskUpdate) SetUpdatedAt(v time.Time) *TaskUpdate {
 	return _u
 }
 
-// SetAssigneeID sets the "assignee_id" field.
-func (_u *TaskUpdate) SetAssigneeID(v uuid.UUID) *TaskUpdate {
-	_u.mutation.SetAssigneeID(v)
+// SetMilestoneID sets the "milestone_id" field.
+func (_u *TaskUpdate) SetMilestoneID(v uuid.UUID) *TaskUpdate {
+	_u.mutation.SetMilestoneID(v)
+	return _u
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableMilestoneID(v *uuid.UUID) *TaskUpdate {
+	if v != nil {
+		_u.SetMilestoneID(*v)
+	}
+	return _u
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (_u *TaskUpdate) ClearMilestoneID() *TaskUpdate {
+	_u.mutation.ClearMilestoneID()
+	return _u
+}
+
+// SetTrashedProjectID sets the "trashed_project_id" field.
+func (_u *TaskUpdate) SetTrashedProjectID(v uuid.UUID) *TaskUpdate {
+	_u.mutation.SetTrashedProjectID(v)
+	return _u
+}
+
+// SetNillableTrashedProjectID sets the "trashed_project_id" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableTrashedProjectID(v *uuid.UUID) *TaskUpdate {
+	if v != nil {
+		_u.SetTrashedProjectID(*v)
+	}
+	return _u
+}
+
+// ClearTrashedProjectID clears the value of the "trashed_project_id" field.
+func (_u *TaskUpdate) ClearTrashedProjectID() *TaskUpdate {
+	_u.mutation.ClearTrashedProjectID()
+	return _u
+}
+
+// SetTrashedPosition sets the "trashed_position" field.
+func (_u *TaskUpdate) SetTrashedPosition(v int) *TaskUpdate {
+	_u.mutation.ResetTrashedPosition()
+	_u.mutation.SetTrashedPosition(v)
 	return _u
 }
 
-// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
-func (_u *TaskUpdate) SetNillableAssigneeID(v *uuid.UUID) *TaskUpdate {
+// SetNillableTrashedPosition sets the "trashed_position" field if the given value is not nil.
+func (_u *TaskUpdate) SetNillableTrashedPosition(v *int) *TaskUpdate {
 	if v != nil {
-		_u.SetAssigneeID(*v)
+		_u.SetTrashedPosition(*v)
 	}
 	return _u
 }
 
-// ClearAssigneeID clears the value of the "assignee_id" field.
-func (_u *TaskUpdate) ClearAssigneeID() *TaskUpdate {
-	_u.mutation.ClearAssigneeID()
+// AddTrashedPosition adds value to the "trashed_position" field.
+func (_u *TaskUpdate) AddTrashedPosition(v int) *TaskUpdate {
+	_u.mutation.AddTrashedPosition(v)
+	return _u
+}
+
+// ClearTrashedPosition clears the value of the "trashed_position" field.
+func (_u *TaskUpdate) ClearTrashedPosition() *TaskUpdate {
+	_u.mutation.ClearTrashedPosition()
 	return _u
 }
 
@@ -189,9 +282,84 @@ func (_u *TaskUpdate) AddProjectTasks(v ...*ProjectTask) *TaskUpdate {
 	return _u.AddProjectTaskIDs(ids...)
 }
 
-// SetAssignee sets the "assignee" edge to the User entity.
-func (_u *TaskUpdate) SetAssignee(v *User) *TaskUpdate {
-	return _u.SetAssigneeID(v.ID)
+// AddAssigneeIDs adds the "assignees" edge to the TaskAssignee entity by IDs.
+func (_u *TaskUpdate) AddAssigneeIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.AddAssigneeIDs(ids...)
+	return _u
+}
+
+// AddAssignees adds the "assignees" edges to the TaskAssignee entity.
+func (_u *TaskUpdate) AddAssignees(v ...*TaskAssignee) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssigneeIDs(ids...)
+}
+
+// AddAssignmentEventIDs adds the "assignment_events" edge to the TaskAssignmentEvent entity by IDs.
+func (_u *TaskUpdate) AddAssignmentEventIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.AddAssignmentEventIDs(ids...)
+	return _u
+}
+
+// AddAssignmentEvents adds the "assignment_events" edges to the TaskAssignmentEvent entity.
+func (_u *TaskUpdate) AddAssignmentEvents(v ...*TaskAssignmentEvent) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentEventIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the TaskWatcher entity by IDs.
+func (_u *TaskUpdate) AddWatcherIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.AddWatcherIDs(ids...)
+	return _u
+}
+
+// AddWatchers adds the "watchers" edges to the TaskWatcher entity.
+func (_u *TaskUpdate) AddWatchers(v ...*TaskWatcher) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWatcherIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the TaskActivity entity by IDs.
+func (_u *TaskUpdate) AddActivityIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.AddActivityIDs(ids...)
+	return _u
+}
+
+// AddActivities adds the "activities" edges to the TaskActivity entity.
+func (_u *TaskUpdate) AddActivities(v ...*TaskActivity) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActivityIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the TaskReminder entity by IDs.
+func (_u *TaskUpdate) AddReminderIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.AddReminderIDs(ids...)
+	return _u
+}
+
+// AddReminders adds the "reminders" edges to the TaskReminder entity.
+func (_u *TaskUpdate) AddReminders(v ...*TaskReminder) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderIDs(ids...)
+}
+
+// SetMilestone sets the "milestone" edge to the Milestone entity.
+func (_u *TaskUpdate) SetMilestone(v *Milestone) *TaskUpdate {
+	return _u.SetMilestoneID(v.ID)
 }
 
 // Mutation returns the TaskMutation object of the builder.
@@ -220,15 +388,122 @@ func (_u *TaskUpdate) RemoveProjectTasks(v ...*ProjectTask) *TaskUpdate {
 	return _u.RemoveProjectTaskIDs(ids...)
 }
 
-// ClearAssignee clears the "assignee" edge to the User entity.
-func (_u *TaskUpdate) ClearAssignee() *TaskUpdate {
-	_u.mutation.ClearAssignee()
+// ClearAssignees clears all "assignees" edges to the TaskAssignee entity.
+func (_u *TaskUpdate) ClearAssignees() *TaskUpdate {
+	_u.mutation.ClearAssignees()
+	return _u
+}
+
+// RemoveAssigneeIDs removes the "assignees" edge to TaskAssignee entities by IDs.
+func (_u *TaskUpdate) RemoveAssigneeIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.RemoveAssigneeIDs(ids...)
+	return _u
+}
+
+// RemoveAssignees removes "assignees" edges to TaskAssignee entities.
+func (_u *TaskUpdate) RemoveAssignees(v ...*TaskAssignee) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssigneeIDs(ids...)
+}
+
+// ClearAssignmentEvents clears all "assignment_events" edges to the TaskAssignmentEvent entity.
+func (_u *TaskUpdate) ClearAssignmentEvents() *TaskUpdate {
+	_u.mutation.ClearAssignmentEvents()
+	return _u
+}
+
+// RemoveAssignmentEventIDs removes the "assignment_events" edge to TaskAssignmentEvent entities by IDs.
+func (_u *TaskUpdate) RemoveAssignmentEventIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.RemoveAssignmentEventIDs(ids...)
+	return _u
+}
+
+// RemoveAssignmentEvents removes "assignment_events" edges to TaskAssignmentEvent entities.
+func (_u *TaskUpdate) RemoveAssignmentEvents(v ...*TaskAssignmentEvent) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentEventIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the TaskWatcher entity.
+func (_u *TaskUpdate) ClearWatchers() *TaskUpdate {
+	_u.mutation.ClearWatchers()
+	return _u
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to TaskWatcher entities by IDs.
+func (_u *TaskUpdate) RemoveWatcherIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.RemoveWatcherIDs(ids...)
+	return _u
+}
+
+// RemoveWatchers removes "watchers" edges to TaskWatcher entities.
+func (_u *TaskUpdate) RemoveWatchers(v ...*TaskWatcher) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWatcherIDs(ids...)
+}
+
+// ClearActivities clears all "activities" edges to the TaskActivity entity.
+func (_u *TaskUpdate) ClearActivities() *TaskUpdate {
+	_u.mutation.ClearActivities()
+	return _u
+}
+
+// RemoveActivityIDs removes the "activities" edge to TaskActivity entities by IDs.
+func (_u *TaskUpdate) RemoveActivityIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.RemoveActivityIDs(ids...)
+	return _u
+}
+
+// RemoveActivities removes "activities" edges to TaskActivity entities.
+func (_u *TaskUpdate) RemoveActivities(v ...*TaskActivity) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActivityIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the TaskReminder entity.
+func (_u *TaskUpdate) ClearReminders() *TaskUpdate {
+	_u.mutation.ClearReminders()
+	return _u
+}
+
+// RemoveReminderIDs removes the "reminders" edge to TaskReminder entities by IDs.
+func (_u *TaskUpdate) RemoveReminderIDs(ids ...uuid.UUID) *TaskUpdate {
+	_u.mutation.RemoveReminderIDs(ids...)
+	return _u
+}
+
+// RemoveReminders removes "reminders" edges to TaskReminder entities.
+func (_u *TaskUpdate) RemoveReminders(v ...*TaskReminder) *TaskUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderIDs(ids...)
+}
+
+// ClearMilestone clears the "milestone" edge to the Milestone entity.
+func (_u *TaskUpdate) ClearMilestone() *TaskUpdate {
+	_u.mutation.ClearMilestone()
 	return _u
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TaskUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -255,11 +530,15 @@ func (_u *TaskUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *TaskUpdate) defaults() {
+func (_u *TaskUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if task.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized task.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := task.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -289,6 +568,18 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(task.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(task.FieldTitle, field.TypeString, value)
 	}
@@ -322,6 +613,21 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.TrashedProjectID(); ok {
+		_spec.SetField(task.FieldTrashedProjectID, field.TypeUUID, value)
+	}
+	if _u.mutation.TrashedProjectIDCleared() {
+		_spec.ClearField(task.FieldTrashedProjectID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.TrashedPosition(); ok {
+		_spec.SetField(task.FieldTrashedPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrashedPosition(); ok {
+		_spec.AddField(task.FieldTrashedPosition, field.TypeInt, value)
+	}
+	if _u.mutation.TrashedPositionCleared() {
+		_spec.ClearField(task.FieldTrashedPosition, field.TypeInt)
+	}
 	if _u.mutation.ProjectTasksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -367,28 +673,44 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssigneeCleared() {
+	if _u.mutation.AssigneesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   task.AssigneeTable,
-			Columns: []string{task.AssigneeColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: []string{task.AssigneesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssigneeIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedAssigneesIDs(); len(nodes) > 0 && !_u.mutation.AssigneesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   task.AssigneeTable,
-			Columns: []string{task.AssigneeColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: []string{task.AssigneesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: []string{task.AssigneesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -396,52 +718,302 @@ func (_u *TaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{task.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.AssignmentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssignmentEventsTable,
+			Columns: []string{task.AssignmentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// TaskUpdateOne is the builder for updating a single Task entity.
-type TaskUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *TaskMutation
-}
-
-// SetTitle sets the "title" field.
-func (_u *TaskUpdateOne) SetTitle(v string) *TaskUpdateOne {
-	_u.mutation.SetTitle(v)
-	return _u
-}
-
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *TaskUpdateOne) SetNillableTitle(v *string) *TaskUpdateOne {
-	if v != nil {
-		_u.SetTitle(*v)
+	if nodes := _u.mutation.RemovedAssignmentEventsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssignmentEventsTable,
+			Columns: []string{task.AssignmentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDescription sets the "description" field.
-func (_u *TaskUpdateOne) SetDescription(v string) *TaskUpdateOne {
-	_u.mutation.SetDescription(v)
-	return _u
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *TaskUpdateOne) SetNillableDescription(v *string) *TaskUpdateOne {
-	if v != nil {
-		_u.SetDescription(*v)
+	if nodes := _u.mutation.AssignmentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssignmentEventsTable,
+			Columns: []string{task.AssignmentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
+	if _u.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !_u.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !_u.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !_u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MilestoneCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MilestoneIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{task.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskUpdateOne is the builder for updating a single Task entity.
+type TaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskUpdateOne) SetDeletedAt(v time.Time) *TaskUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableDeletedAt(v *time.Time) *TaskUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskUpdateOne) ClearDeletedAt() *TaskUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *TaskUpdateOne) SetVersion(v int) *TaskUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableVersion(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *TaskUpdateOne) AddVersion(v int) *TaskUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TaskUpdateOne) SetTitle(v string) *TaskUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableTitle(v *string) *TaskUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TaskUpdateOne) SetDescription(v string) *TaskUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableDescription(v *string) *TaskUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
 }
 
 // ClearDescription clears the value of the "description" field.
@@ -539,23 +1111,70 @@ func (_u *TaskUpdateOne) SetUpdatedAt(v time.Time) *TaskUpdateOne {
 	return _u
 }
 
-// SetAssigneeID sets the "assignee_id" field.
-func (_u *TaskUpdateOne) SetAssigneeID(v uuid.UUID) *TaskUpdateOne {
-	_u.mutation.SetAssigneeID(v)
+// SetMilestoneID sets the "milestone_id" field.
+func (_u *TaskUpdateOne) SetMilestoneID(v uuid.UUID) *TaskUpdateOne {
+	_u.mutation.SetMilestoneID(v)
+	return _u
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableMilestoneID(v *uuid.UUID) *TaskUpdateOne {
+	if v != nil {
+		_u.SetMilestoneID(*v)
+	}
+	return _u
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (_u *TaskUpdateOne) ClearMilestoneID() *TaskUpdateOne {
+	_u.mutation.ClearMilestoneID()
+	return _u
+}
+
+// SetTrashedProjectID sets the "trashed_project_id" field.
+func (_u *TaskUpdateOne) SetTrashedProjectID(v uuid.UUID) *TaskUpdateOne {
+	_u.mutation.SetTrashedProjectID(v)
 	return _u
 }
 
-// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
-func (_u *TaskUpdateOne) SetNillableAssigneeID(v *uuid.UUID) *TaskUpdateOne {
+// SetNillableTrashedProjectID sets the "trashed_project_id" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableTrashedProjectID(v *uuid.UUID) *TaskUpdateOne {
 	if v != nil {
-		_u.SetAssigneeID(*v)
+		_u.SetTrashedProjectID(*v)
 	}
 	return _u
 }
 
-// ClearAssigneeID clears the value of the "assignee_id" field.
-func (_u *TaskUpdateOne) ClearAssigneeID() *TaskUpdateOne {
-	_u.mutation.ClearAssigneeID()
+// ClearTrashedProjectID clears the value of the "trashed_project_id" field.
+func (_u *TaskUpdateOne) ClearTrashedProjectID() *TaskUpdateOne {
+	_u.mutation.ClearTrashedProjectID()
+	return _u
+}
+
+// SetTrashedPosition sets the "trashed_position" field.
+func (_u *TaskUpdateOne) SetTrashedPosition(v int) *TaskUpdateOne {
+	_u.mutation.ResetTrashedPosition()
+	_u.mutation.SetTrashedPosition(v)
+	return _u
+}
+
+// SetNillableTrashedPosition sets the "trashed_position" field if the given value is not nil.
+func (_u *TaskUpdateOne) SetNillableTrashedPosition(v *int) *TaskUpdateOne {
+	if v != nil {
+		_u.SetTrashedPosition(*v)
+	}
+	return _u
+}
+
+// AddTrashedPosition adds value to the "trashed_position" field.
+func (_u *TaskUpdateOne) AddTrashedPosition(v int) *TaskUpdateOne {
+	_u.mutation.AddTrashedPosition(v)
+	return _u
+}
+
+// ClearTrashedPosition clears the value of the "trashed_position" field.
+func (_u *TaskUpdateOne) ClearTrashedPosition() *TaskUpdateOne {
+	_u.mutation.ClearTrashedPosition()
 	return _u
 }
 
@@ -574,9 +1193,84 @@ func (_u *TaskUpdateOne) AddProjectTasks(v ...*ProjectTask) *TaskUpdateOne {
 	return _u.AddProjectTaskIDs(ids...)
 }
 
-// SetAssignee sets the "assignee" edge to the User entity.
-func (_u *TaskUpdateOne) SetAssignee(v *User) *TaskUpdateOne {
-	return _u.SetAssigneeID(v.ID)
+// AddAssigneeIDs adds the "assignees" edge to the TaskAssignee entity by IDs.
+func (_u *TaskUpdateOne) AddAssigneeIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.AddAssigneeIDs(ids...)
+	return _u
+}
+
+// AddAssignees adds the "assignees" edges to the TaskAssignee entity.
+func (_u *TaskUpdateOne) AddAssignees(v ...*TaskAssignee) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssigneeIDs(ids...)
+}
+
+// AddAssignmentEventIDs adds the "assignment_events" edge to the TaskAssignmentEvent entity by IDs.
+func (_u *TaskUpdateOne) AddAssignmentEventIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.AddAssignmentEventIDs(ids...)
+	return _u
+}
+
+// AddAssignmentEvents adds the "assignment_events" edges to the TaskAssignmentEvent entity.
+func (_u *TaskUpdateOne) AddAssignmentEvents(v ...*TaskAssignmentEvent) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentEventIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the TaskWatcher entity by IDs.
+func (_u *TaskUpdateOne) AddWatcherIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.AddWatcherIDs(ids...)
+	return _u
+}
+
+// AddWatchers adds the "watchers" edges to the TaskWatcher entity.
+func (_u *TaskUpdateOne) AddWatchers(v ...*TaskWatcher) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWatcherIDs(ids...)
+}
+
+// AddActivityIDs adds the "activities" edge to the TaskActivity entity by IDs.
+func (_u *TaskUpdateOne) AddActivityIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.AddActivityIDs(ids...)
+	return _u
+}
+
+// AddActivities adds the "activities" edges to the TaskActivity entity.
+func (_u *TaskUpdateOne) AddActivities(v ...*TaskActivity) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActivityIDs(ids...)
+}
+
+// AddReminderIDs adds the "reminders" edge to the TaskReminder entity by IDs.
+func (_u *TaskUpdateOne) AddReminderIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.AddReminderIDs(ids...)
+	return _u
+}
+
+// AddReminders adds the "reminders" edges to the TaskReminder entity.
+func (_u *TaskUpdateOne) AddReminders(v ...*TaskReminder) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderIDs(ids...)
+}
+
+// SetMilestone sets the "milestone" edge to the Milestone entity.
+func (_u *TaskUpdateOne) SetMilestone(v *Milestone) *TaskUpdateOne {
+	return _u.SetMilestoneID(v.ID)
 }
 
 // Mutation returns the TaskMutation object of the builder.
@@ -605,9 +1299,114 @@ func (_u *TaskUpdateOne) RemoveProjectTasks(v ...*ProjectTask) *TaskUpdateOne {
 	return _u.RemoveProjectTaskIDs(ids...)
 }
 
-// ClearAssignee clears the "assignee" edge to the User entity.
-func (_u *TaskUpdateOne) ClearAssignee() *TaskUpdateOne {
-	_u.mutation.ClearAssignee()
+// ClearAssignees clears all "assignees" edges to the TaskAssignee entity.
+func (_u *TaskUpdateOne) ClearAssignees() *TaskUpdateOne {
+	_u.mutation.ClearAssignees()
+	return _u
+}
+
+// RemoveAssigneeIDs removes the "assignees" edge to TaskAssignee entities by IDs.
+func (_u *TaskUpdateOne) RemoveAssigneeIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.RemoveAssigneeIDs(ids...)
+	return _u
+}
+
+// RemoveAssignees removes "assignees" edges to TaskAssignee entities.
+func (_u *TaskUpdateOne) RemoveAssignees(v ...*TaskAssignee) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssigneeIDs(ids...)
+}
+
+// ClearAssignmentEvents clears all "assignment_events" edges to the TaskAssignmentEvent entity.
+func (_u *TaskUpdateOne) ClearAssignmentEvents() *TaskUpdateOne {
+	_u.mutation.ClearAssignmentEvents()
+	return _u
+}
+
+// RemoveAssignmentEventIDs removes the "assignment_events" edge to TaskAssignmentEvent entities by IDs.
+func (_u *TaskUpdateOne) RemoveAssignmentEventIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.RemoveAssignmentEventIDs(ids...)
+	return _u
+}
+
+// RemoveAssignmentEvents removes "assignment_events" edges to TaskAssignmentEvent entities.
+func (_u *TaskUpdateOne) RemoveAssignmentEvents(v ...*TaskAssignmentEvent) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentEventIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the TaskWatcher entity.
+func (_u *TaskUpdateOne) ClearWatchers() *TaskUpdateOne {
+	_u.mutation.ClearWatchers()
+	return _u
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to TaskWatcher entities by IDs.
+func (_u *TaskUpdateOne) RemoveWatcherIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.RemoveWatcherIDs(ids...)
+	return _u
+}
+
+// RemoveWatchers removes "watchers" edges to TaskWatcher entities.
+func (_u *TaskUpdateOne) RemoveWatchers(v ...*TaskWatcher) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWatcherIDs(ids...)
+}
+
+// ClearActivities clears all "activities" edges to the TaskActivity entity.
+func (_u *TaskUpdateOne) ClearActivities() *TaskUpdateOne {
+	_u.mutation.ClearActivities()
+	return _u
+}
+
+// RemoveActivityIDs removes the "activities" edge to TaskActivity entities by IDs.
+func (_u *TaskUpdateOne) RemoveActivityIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.RemoveActivityIDs(ids...)
+	return _u
+}
+
+// RemoveActivities removes "activities" edges to TaskActivity entities.
+func (_u *TaskUpdateOne) RemoveActivities(v ...*TaskActivity) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActivityIDs(ids...)
+}
+
+// ClearReminders clears all "reminders" edges to the TaskReminder entity.
+func (_u *TaskUpdateOne) ClearReminders() *TaskUpdateOne {
+	_u.mutation.ClearReminders()
+	return _u
+}
+
+// RemoveReminderIDs removes the "reminders" edge to TaskReminder entities by IDs.
+func (_u *TaskUpdateOne) RemoveReminderIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	_u.mutation.RemoveReminderIDs(ids...)
+	return _u
+}
+
+// RemoveReminders removes "reminders" edges to TaskReminder entities.
+func (_u *TaskUpdateOne) RemoveReminders(v ...*TaskReminder) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderIDs(ids...)
+}
+
+// ClearMilestone clears the "milestone" edge to the Milestone entity.
+func (_u *TaskUpdateOne) ClearMilestone() *TaskUpdateOne {
+	_u.mutation.ClearMilestone()
 	return _u
 }
 
@@ -626,7 +1425,9 @@ func (_u *TaskUpdateOne) Select(field string, fields ...string) *TaskUpdateOne {
 
 // Save executes the query and returns the updated Task entity.
 func (_u *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -653,11 +1454,15 @@ func (_u *TaskUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *TaskUpdateOne) defaults() {
+func (_u *TaskUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if task.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized task.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := task.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -704,6 +1509,18 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(task.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(task.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(task.FieldTitle, field.TypeString, value)
 	}
@@ -737,6 +1554,21 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.TrashedProjectID(); ok {
+		_spec.SetField(task.FieldTrashedProjectID, field.TypeUUID, value)
+	}
+	if _u.mutation.TrashedProjectIDCleared() {
+		_spec.ClearField(task.FieldTrashedProjectID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.TrashedPosition(); ok {
+		_spec.SetField(task.FieldTrashedPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrashedPosition(); ok {
+		_spec.AddField(task.FieldTrashedPosition, field.TypeInt, value)
+	}
+	if _u.mutation.TrashedPositionCleared() {
+		_spec.ClearField(task.FieldTrashedPosition, field.TypeInt)
+	}
 	if _u.mutation.ProjectTasksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -782,28 +1614,253 @@ func (_u *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.AssigneeCleared() {
+	if _u.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: []string{task.AssigneesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssigneesIDs(); len(nodes) > 0 && !_u.mutation.AssigneesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: []string{task.AssigneesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssigneesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssigneesTable,
+			Columns: []string{task.AssigneesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssignmentEventsTable,
+			Columns: []string{task.AssignmentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentEventsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssignmentEventsTable,
+			Columns: []string{task.AssignmentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AssignmentEventsTable,
+			Columns: []string{task.AssignmentEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !_u.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.WatchersTable,
+			Columns: []string{task.WatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !_u.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.ActivitiesTable,
+			Columns: []string{task.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !_u.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.RemindersTable,
+			Columns: []string{task.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MilestoneCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   task.AssigneeTable,
-			Columns: []string{task.AssigneeColumn},
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssigneeIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MilestoneIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   task.AssigneeTable,
-			Columns: []string{task.AssigneeColumn},
+			Table:   task.MilestoneTable,
+			Columns: []string{task.MilestoneColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
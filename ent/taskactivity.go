@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TaskActivity is the model entity for the TaskActivity schema.
+type TaskActivity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind taskactivity.Kind `json:"kind,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID *uuid.UUID `json:"actor_id,omitempty"`
+	// FromValue holds the value of the "from_value" field.
+	FromValue *string `json:"from_value,omitempty"`
+	// ToValue holds the value of the "to_value" field.
+	ToValue *string `json:"to_value,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskActivityQuery when eager-loading is set.
+	Edges           TaskActivityEdges `json:"edges"`
+	task_activities *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// TaskActivityEdges holds the relations/edges for other nodes in the graph.
+type TaskActivityEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskActivityEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskActivity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskactivity.FieldActorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case taskactivity.FieldKind, taskactivity.FieldFromValue, taskactivity.FieldToValue:
+			values[i] = new(sql.NullString)
+		case taskactivity.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taskactivity.FieldID:
+			values[i] = new(uuid.UUID)
+		case taskactivity.ForeignKeys[0]: // task_activities
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskActivity fields.
+func (_m *TaskActivity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskactivity.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case taskactivity.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = taskactivity.Kind(value.String)
+			}
+		case taskactivity.FieldActorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = new(uuid.UUID)
+				*_m.ActorID = *value.S.(*uuid.UUID)
+			}
+		case taskactivity.FieldFromValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_value", values[i])
+			} else if value.Valid {
+				_m.FromValue = new(string)
+				*_m.FromValue = value.String
+			}
+		case taskactivity.FieldToValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_value", values[i])
+			} else if value.Valid {
+				_m.ToValue = new(string)
+				*_m.ToValue = value.String
+			}
+		case taskactivity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case taskactivity.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field task_activities", values[i])
+			} else if value.Valid {
+				_m.task_activities = new(uuid.UUID)
+				*_m.task_activities = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskActivity.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskActivity) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskActivity entity.
+func (_m *TaskActivity) QueryTask() *TaskQuery {
+	return NewTaskActivityClient(_m.config).QueryTask(_m)
+}
+
+// Update returns a builder for updating this TaskActivity.
+// Note that you need to call TaskActivity.Unwrap() before calling this method if this TaskActivity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskActivity) Update() *TaskActivityUpdateOne {
+	return NewTaskActivityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskActivity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskActivity) Unwrap() *TaskActivity {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskActivity is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskActivity) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskActivity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	if v := _m.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FromValue; v != nil {
+		builder.WriteString("from_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ToValue; v != nil {
+		builder.WriteString("to_value=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskActivities is a parsable slice of TaskActivity.
+type TaskActivities []*TaskActivity
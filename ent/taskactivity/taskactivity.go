@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskactivity
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the taskactivity type in the database.
+	Label = "task_activity"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldFromValue holds the string denoting the from_value field in the database.
+	FieldFromValue = "from_value"
+	// FieldToValue holds the string denoting the to_value field in the database.
+	FieldToValue = "to_value"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTask holds the string denoting the task edge name in mutations.
+	EdgeTask = "task"
+	// Table holds the table name of the taskactivity in the database.
+	Table = "task_activities"
+	// TaskTable is the table that holds the task relation/edge.
+	TaskTable = "task_activities"
+	// TaskInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	TaskInverseTable = "tasks"
+	// TaskColumn is the table column denoting the task relation/edge.
+	TaskColumn = "task_activities"
+)
+
+// Columns holds all SQL columns for taskactivity fields.
+var Columns = []string{
+	FieldID,
+	FieldKind,
+	FieldActorID,
+	FieldFromValue,
+	FieldToValue,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "task_activities"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"task_activities",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindCreated            Kind = "created"
+	KindTitleChanged       Kind = "title_changed"
+	KindDescriptionChanged Kind = "description_changed"
+	KindStatusChanged      Kind = "status_changed"
+	KindMoved              Kind = "moved"
+	KindAssigned           Kind = "assigned"
+	KindUnassigned         Kind = "unassigned"
+	KindMilestoneChanged   Kind = "milestone_changed"
+	KindDeleted            Kind = "deleted"
+	KindRestored           Kind = "restored"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindCreated, KindTitleChanged, KindDescriptionChanged, KindStatusChanged, KindMoved, KindAssigned, KindUnassigned, KindMilestoneChanged, KindDeleted, KindRestored:
+		return nil
+	default:
+		return fmt.Errorf("taskactivity: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the TaskActivity queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
+// ByFromValue orders the results by the from_value field.
+func ByFromValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromValue, opts...).ToFunc()
+}
+
+// ByToValue orders the results by the to_value field.
+func ByToValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToValue, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTaskField orders the results by task field.
+func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTaskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Kind) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Kind) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Kind(str)
+	if err := KindValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Kind", str)
+	}
+	return nil
+}
@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskactivity
+
+import (
+	"project-manager-dashboard-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldID, id))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldActorID, v))
+}
+
+// FromValue applies equality check predicate on the "from_value" field. It's identical to FromValueEQ.
+func FromValue(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldFromValue, v))
+}
+
+// ToValue applies equality check predicate on the "to_value" field. It's identical to ToValueEQ.
+func ToValue(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldToValue, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uuid.UUID) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldActorID))
+}
+
+// FromValueEQ applies the EQ predicate on the "from_value" field.
+func FromValueEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldFromValue, v))
+}
+
+// FromValueNEQ applies the NEQ predicate on the "from_value" field.
+func FromValueNEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldFromValue, v))
+}
+
+// FromValueIn applies the In predicate on the "from_value" field.
+func FromValueIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldFromValue, vs...))
+}
+
+// FromValueNotIn applies the NotIn predicate on the "from_value" field.
+func FromValueNotIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldFromValue, vs...))
+}
+
+// FromValueGT applies the GT predicate on the "from_value" field.
+func FromValueGT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldFromValue, v))
+}
+
+// FromValueGTE applies the GTE predicate on the "from_value" field.
+func FromValueGTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldFromValue, v))
+}
+
+// FromValueLT applies the LT predicate on the "from_value" field.
+func FromValueLT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldFromValue, v))
+}
+
+// FromValueLTE applies the LTE predicate on the "from_value" field.
+func FromValueLTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldFromValue, v))
+}
+
+// FromValueContains applies the Contains predicate on the "from_value" field.
+func FromValueContains(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContains(FieldFromValue, v))
+}
+
+// FromValueHasPrefix applies the HasPrefix predicate on the "from_value" field.
+func FromValueHasPrefix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasPrefix(FieldFromValue, v))
+}
+
+// FromValueHasSuffix applies the HasSuffix predicate on the "from_value" field.
+func FromValueHasSuffix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasSuffix(FieldFromValue, v))
+}
+
+// FromValueIsNil applies the IsNil predicate on the "from_value" field.
+func FromValueIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldFromValue))
+}
+
+// FromValueNotNil applies the NotNil predicate on the "from_value" field.
+func FromValueNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldFromValue))
+}
+
+// FromValueEqualFold applies the EqualFold predicate on the "from_value" field.
+func FromValueEqualFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEqualFold(FieldFromValue, v))
+}
+
+// FromValueContainsFold applies the ContainsFold predicate on the "from_value" field.
+func FromValueContainsFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContainsFold(FieldFromValue, v))
+}
+
+// ToValueEQ applies the EQ predicate on the "to_value" field.
+func ToValueEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldToValue, v))
+}
+
+// ToValueNEQ applies the NEQ predicate on the "to_value" field.
+func ToValueNEQ(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldToValue, v))
+}
+
+// ToValueIn applies the In predicate on the "to_value" field.
+func ToValueIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldToValue, vs...))
+}
+
+// ToValueNotIn applies the NotIn predicate on the "to_value" field.
+func ToValueNotIn(vs ...string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldToValue, vs...))
+}
+
+// ToValueGT applies the GT predicate on the "to_value" field.
+func ToValueGT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldToValue, v))
+}
+
+// ToValueGTE applies the GTE predicate on the "to_value" field.
+func ToValueGTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldToValue, v))
+}
+
+// ToValueLT applies the LT predicate on the "to_value" field.
+func ToValueLT(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldToValue, v))
+}
+
+// ToValueLTE applies the LTE predicate on the "to_value" field.
+func ToValueLTE(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldToValue, v))
+}
+
+// ToValueContains applies the Contains predicate on the "to_value" field.
+func ToValueContains(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContains(FieldToValue, v))
+}
+
+// ToValueHasPrefix applies the HasPrefix predicate on the "to_value" field.
+func ToValueHasPrefix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasPrefix(FieldToValue, v))
+}
+
+// ToValueHasSuffix applies the HasSuffix predicate on the "to_value" field.
+func ToValueHasSuffix(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldHasSuffix(FieldToValue, v))
+}
+
+// ToValueIsNil applies the IsNil predicate on the "to_value" field.
+func ToValueIsNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIsNull(FieldToValue))
+}
+
+// ToValueNotNil applies the NotNil predicate on the "to_value" field.
+func ToValueNotNil() predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotNull(FieldToValue))
+}
+
+// ToValueEqualFold applies the EqualFold predicate on the "to_value" field.
+func ToValueEqualFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEqualFold(FieldToValue, v))
+}
+
+// ToValueContainsFold applies the ContainsFold predicate on the "to_value" field.
+func ToValueContainsFold(v string) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldContainsFold(FieldToValue, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.TaskActivity {
+	return predicate.TaskActivity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.TaskActivity {
+	return predicate.TaskActivity(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskActivity) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskActivity) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskActivity) predicate.TaskActivity {
+	return predicate.TaskActivity(sql.NotPredicates(p))
+}
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskActivityCreate is the builder for creating a TaskActivity entity.
+type TaskActivityCreate struct {
+	config
+	mutation *TaskActivityMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *TaskActivityCreate) SetKind(v taskactivity.Kind) *TaskActivityCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *TaskActivityCreate) SetActorID(v uuid.UUID) *TaskActivityCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *TaskActivityCreate) SetNillableActorID(v *uuid.UUID) *TaskActivityCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetFromValue sets the "from_value" field.
+func (_c *TaskActivityCreate) SetFromValue(v string) *TaskActivityCreate {
+	_c.mutation.SetFromValue(v)
+	return _c
+}
+
+// SetNillableFromValue sets the "from_value" field if the given value is not nil.
+func (_c *TaskActivityCreate) SetNillableFromValue(v *string) *TaskActivityCreate {
+	if v != nil {
+		_c.SetFromValue(*v)
+	}
+	return _c
+}
+
+// SetToValue sets the "to_value" field.
+func (_c *TaskActivityCreate) SetToValue(v string) *TaskActivityCreate {
+	_c.mutation.SetToValue(v)
+	return _c
+}
+
+// SetNillableToValue sets the "to_value" field if the given value is not nil.
+func (_c *TaskActivityCreate) SetNillableToValue(v *string) *TaskActivityCreate {
+	if v != nil {
+		_c.SetToValue(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskActivityCreate) SetCreatedAt(v time.Time) *TaskActivityCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskActivityCreate) SetNillableCreatedAt(v *time.Time) *TaskActivityCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskActivityCreate) SetID(v uuid.UUID) *TaskActivityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TaskActivityCreate) SetNillableID(v *uuid.UUID) *TaskActivityCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_c *TaskActivityCreate) SetTaskID(id uuid.UUID) *TaskActivityCreate {
+	_c.mutation.SetTaskID(id)
+	return _c
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_c *TaskActivityCreate) SetTask(v *Task) *TaskActivityCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskActivityMutation object of the builder.
+func (_c *TaskActivityCreate) Mutation() *TaskActivityMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskActivity in the database.
+func (_c *TaskActivityCreate) Save(ctx context.Context) (*TaskActivity, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskActivityCreate) SaveX(ctx context.Context) *TaskActivity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskActivityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskActivityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskActivityCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := taskactivity.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := taskactivity.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskActivityCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "TaskActivity.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := taskactivity.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "TaskActivity.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskActivity.created_at"`)}
+	}
+	if len(_c.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskActivity.task"`)}
+	}
+	return nil
+}
+
+func (_c *TaskActivityCreate) sqlSave(ctx context.Context) (*TaskActivity, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskActivityCreate) createSpec() (*TaskActivity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskActivity{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskactivity.Table, sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(taskactivity.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(taskactivity.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if value, ok := _c.mutation.FromValue(); ok {
+		_spec.SetField(taskactivity.FieldFromValue, field.TypeString, value)
+		_node.FromValue = &value
+	}
+	if value, ok := _c.mutation.ToValue(); ok {
+		_spec.SetField(taskactivity.FieldToValue, field.TypeString, value)
+		_node.ToValue = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskactivity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.task_activities = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskActivityCreateBulk is the builder for creating many TaskActivity entities in bulk.
+type TaskActivityCreateBulk struct {
+	config
+	err      error
+	builders []*TaskActivityCreate
+}
+
+// Save creates the TaskActivity entities in the database.
+func (_c *TaskActivityCreateBulk) Save(ctx context.Context) ([]*TaskActivity, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskActivity, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskActivityCreateBulk) SaveX(ctx context.Context) []*TaskActivity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
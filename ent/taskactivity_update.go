@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskActivityUpdate is the builder for updating TaskActivity entities.
+type TaskActivityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskActivityMutation
+}
+
+// Where appends a list predicates to the TaskActivityUpdate builder.
+func (_u *TaskActivityUpdate) Where(ps ...predicate.TaskActivity) *TaskActivityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskActivityUpdate) SetTaskID(id uuid.UUID) *TaskActivityUpdate {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskActivityUpdate) SetTask(v *Task) *TaskActivityUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskActivityMutation object of the builder.
+func (_u *TaskActivityUpdate) Mutation() *TaskActivityMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskActivityUpdate) ClearTask() *TaskActivityUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskActivityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskActivityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskActivityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskActivityUpdate) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskActivity.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskActivityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskactivity.Table, taskactivity.Columns, sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(taskactivity.FieldActorID, field.TypeUUID)
+	}
+	if _u.mutation.FromValueCleared() {
+		_spec.ClearField(taskactivity.FieldFromValue, field.TypeString)
+	}
+	if _u.mutation.ToValueCleared() {
+		_spec.ClearField(taskactivity.FieldToValue, field.TypeString)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskActivityUpdateOne is the builder for updating a single TaskActivity entity.
+type TaskActivityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskActivityMutation
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskActivityUpdateOne) SetTaskID(id uuid.UUID) *TaskActivityUpdateOne {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskActivityUpdateOne) SetTask(v *Task) *TaskActivityUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskActivityMutation object of the builder.
+func (_u *TaskActivityUpdateOne) Mutation() *TaskActivityMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskActivityUpdateOne) ClearTask() *TaskActivityUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// Where appends a list predicates to the TaskActivityUpdate builder.
+func (_u *TaskActivityUpdateOne) Where(ps ...predicate.TaskActivity) *TaskActivityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskActivityUpdateOne) Select(field string, fields ...string) *TaskActivityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskActivity entity.
+func (_u *TaskActivityUpdateOne) Save(ctx context.Context) (*TaskActivity, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskActivityUpdateOne) SaveX(ctx context.Context) *TaskActivity {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskActivityUpdateOne) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskActivity.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskActivityUpdateOne) sqlSave(ctx context.Context) (_node *TaskActivity, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskactivity.Table, taskactivity.Columns, sqlgraph.NewFieldSpec(taskactivity.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskActivity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskactivity.FieldID)
+		for _, f := range fields {
+			if !taskactivity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskactivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(taskactivity.FieldActorID, field.TypeUUID)
+	}
+	if _u.mutation.FromValueCleared() {
+		_spec.ClearField(taskactivity.FieldFromValue, field.TypeString)
+	}
+	if _u.mutation.ToValueCleared() {
+		_spec.ClearField(taskactivity.FieldToValue, field.TypeString)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskactivity.TaskTable,
+			Columns: []string{taskactivity.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskActivity{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskactivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
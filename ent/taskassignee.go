@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TaskAssignee is the model entity for the TaskAssignee schema.
+type TaskAssignee struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role *taskassignee.Role `json:"role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskAssigneeQuery when eager-loading is set.
+	Edges                 TaskAssigneeEdges `json:"edges"`
+	task_assignees        *uuid.UUID
+	user_task_assignments *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// TaskAssigneeEdges holds the relations/edges for other nodes in the graph.
+type TaskAssigneeEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskAssigneeEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskAssigneeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskAssignee) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskassignee.FieldRole:
+			values[i] = new(sql.NullString)
+		case taskassignee.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taskassignee.FieldID:
+			values[i] = new(uuid.UUID)
+		case taskassignee.ForeignKeys[0]: // task_assignees
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case taskassignee.ForeignKeys[1]: // user_task_assignments
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskAssignee fields.
+func (_m *TaskAssignee) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskassignee.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case taskassignee.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = new(taskassignee.Role)
+				*_m.Role = taskassignee.Role(value.String)
+			}
+		case taskassignee.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case taskassignee.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field task_assignees", values[i])
+			} else if value.Valid {
+				_m.task_assignees = new(uuid.UUID)
+				*_m.task_assignees = *value.S.(*uuid.UUID)
+			}
+		case taskassignee.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_task_assignments", values[i])
+			} else if value.Valid {
+				_m.user_task_assignments = new(uuid.UUID)
+				*_m.user_task_assignments = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskAssignee.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskAssignee) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskAssignee entity.
+func (_m *TaskAssignee) QueryTask() *TaskQuery {
+	return NewTaskAssigneeClient(_m.config).QueryTask(_m)
+}
+
+// QueryUser queries the "user" edge of the TaskAssignee entity.
+func (_m *TaskAssignee) QueryUser() *UserQuery {
+	return NewTaskAssigneeClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this TaskAssignee.
+// Note that you need to call TaskAssignee.Unwrap() before calling this method if this TaskAssignee
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskAssignee) Update() *TaskAssigneeUpdateOne {
+	return NewTaskAssigneeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskAssignee entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskAssignee) Unwrap() *TaskAssignee {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskAssignee is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskAssignee) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskAssignee(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.Role; v != nil {
+		builder.WriteString("role=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskAssignees is a parsable slice of TaskAssignee.
+type TaskAssignees []*TaskAssignee
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/taskassignee"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskAssigneeDelete is the builder for deleting a TaskAssignee entity.
+type TaskAssigneeDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskAssigneeMutation
+}
+
+// Where appends a list predicates to the TaskAssigneeDelete builder.
+func (_d *TaskAssigneeDelete) Where(ps ...predicate.TaskAssignee) *TaskAssigneeDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TaskAssigneeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TaskAssigneeDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TaskAssigneeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskassignee.Table, sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TaskAssigneeDeleteOne is the builder for deleting a single TaskAssignee entity.
+type TaskAssigneeDeleteOne struct {
+	_d *TaskAssigneeDelete
+}
+
+// Where appends a list predicates to the TaskAssigneeDelete builder.
+func (_d *TaskAssigneeDeleteOne) Where(ps ...predicate.TaskAssignee) *TaskAssigneeDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TaskAssigneeDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskassignee.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TaskAssigneeDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskAssigneeUpdate is the builder for updating TaskAssignee entities.
+type TaskAssigneeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskAssigneeMutation
+}
+
+// Where appends a list predicates to the TaskAssigneeUpdate builder.
+func (_u *TaskAssigneeUpdate) Where(ps ...predicate.TaskAssignee) *TaskAssigneeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *TaskAssigneeUpdate) SetRole(v taskassignee.Role) *TaskAssigneeUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TaskAssigneeUpdate) SetNillableRole(v *taskassignee.Role) *TaskAssigneeUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *TaskAssigneeUpdate) ClearRole() *TaskAssigneeUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TaskAssigneeUpdate) SetCreatedAt(v time.Time) *TaskAssigneeUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TaskAssigneeUpdate) SetNillableCreatedAt(v *time.Time) *TaskAssigneeUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskAssigneeUpdate) SetTaskID(id uuid.UUID) *TaskAssigneeUpdate {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskAssigneeUpdate) SetTask(v *Task) *TaskAssigneeUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *TaskAssigneeUpdate) SetUserID(id uuid.UUID) *TaskAssigneeUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TaskAssigneeUpdate) SetUser(v *User) *TaskAssigneeUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TaskAssigneeMutation object of the builder.
+func (_u *TaskAssigneeUpdate) Mutation() *TaskAssigneeMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskAssigneeUpdate) ClearTask() *TaskAssigneeUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TaskAssigneeUpdate) ClearUser() *TaskAssigneeUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskAssigneeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskAssigneeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskAssigneeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskAssigneeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskAssigneeUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := taskassignee.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TaskAssignee.role": %w`, err)}
+		}
+	}
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskAssignee.task"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskAssignee.user"`)
+	}
+	return nil
+}
+
+func (_u *TaskAssigneeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskassignee.Table, taskassignee.Columns, sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(taskassignee.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(taskassignee.FieldRole, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(taskassignee.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignee.TaskTable,
+			Columns: []string{taskassignee.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignee.TaskTable,
+			Columns: []string{taskassignee.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignee.UserTable,
+			Columns: []string{taskassignee.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignee.UserTable,
+			Columns: []string{taskassignee.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskassignee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskAssigneeUpdateOne is the builder for updating a single TaskAssignee entity.
+type TaskAssigneeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskAssigneeMutation
+}
+
+// SetRole sets the "role" field.
+func (_u *TaskAssigneeUpdateOne) SetRole(v taskassignee.Role) *TaskAssigneeUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TaskAssigneeUpdateOne) SetNillableRole(v *taskassignee.Role) *TaskAssigneeUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *TaskAssigneeUpdateOne) ClearRole() *TaskAssigneeUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TaskAssigneeUpdateOne) SetCreatedAt(v time.Time) *TaskAssigneeUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TaskAssigneeUpdateOne) SetNillableCreatedAt(v *time.Time) *TaskAssigneeUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskAssigneeUpdateOne) SetTaskID(id uuid.UUID) *TaskAssigneeUpdateOne {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskAssigneeUpdateOne) SetTask(v *Task) *TaskAssigneeUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *TaskAssigneeUpdateOne) SetUserID(id uuid.UUID) *TaskAssigneeUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TaskAssigneeUpdateOne) SetUser(v *User) *TaskAssigneeUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TaskAssigneeMutation object of the builder.
+func (_u *TaskAssigneeUpdateOne) Mutation() *TaskAssigneeMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskAssigneeUpdateOne) ClearTask() *TaskAssigneeUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TaskAssigneeUpdateOne) ClearUser() *TaskAssigneeUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the TaskAssigneeUpdate builder.
+func (_u *TaskAssigneeUpdateOne) Where(ps ...predicate.TaskAssignee) *TaskAssigneeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskAssigneeUpdateOne) Select(field string, fields ...string) *TaskAssigneeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskAssignee entity.
+func (_u *TaskAssigneeUpdateOne) Save(ctx context.Context) (*TaskAssignee, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskAssigneeUpdateOne) SaveX(ctx context.Context) *TaskAssignee {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskAssigneeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskAssigneeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskAssigneeUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := taskassignee.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TaskAssignee.role": %w`, err)}
+		}
+	}
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskAssignee.task"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskAssignee.user"`)
+	}
+	return nil
+}
+
+func (_u *TaskAssigneeUpdateOne) sqlSave(ctx context.Context) (_node *TaskAssignee, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskassignee.Table, taskassignee.Columns, sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskAssignee.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskassignee.FieldID)
+		for _, f := range fields {
+			if !taskassignee.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskassignee.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(taskassignee.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(taskassignee.FieldRole, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(taskassignee.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignee.TaskTable,
+			Columns: []string{taskassignee.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignee.TaskTable,
+			Columns: []string{taskassignee.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignee.UserTable,
+			Columns: []string{taskassignee.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignee.UserTable,
+			Columns: []string{taskassignee.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskAssignee{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskassignee.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TaskAssignmentEvent is the model entity for the TaskAssignmentEvent schema.
+type TaskAssignmentEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action taskassignmentevent.Action `json:"action,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID uuid.UUID `json:"actor_id,omitempty"`
+	// FromUserID holds the value of the "from_user_id" field.
+	FromUserID *uuid.UUID `json:"from_user_id,omitempty"`
+	// ToUserID holds the value of the "to_user_id" field.
+	ToUserID *uuid.UUID `json:"to_user_id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role *string `json:"role,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskAssignmentEventQuery when eager-loading is set.
+	Edges                  TaskAssignmentEventEdges `json:"edges"`
+	task_assignment_events *uuid.UUID
+	selectValues           sql.SelectValues
+}
+
+// TaskAssignmentEventEdges holds the relations/edges for other nodes in the graph.
+type TaskAssignmentEventEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskAssignmentEventEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskAssignmentEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskassignmentevent.FieldFromUserID, taskassignmentevent.FieldToUserID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case taskassignmentevent.FieldAction, taskassignmentevent.FieldRole:
+			values[i] = new(sql.NullString)
+		case taskassignmentevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taskassignmentevent.FieldID, taskassignmentevent.FieldActorID:
+			values[i] = new(uuid.UUID)
+		case taskassignmentevent.ForeignKeys[0]: // task_assignment_events
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskAssignmentEvent fields.
+func (_m *TaskAssignmentEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskassignmentevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case taskassignmentevent.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = taskassignmentevent.Action(value.String)
+			}
+		case taskassignmentevent.FieldActorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value != nil {
+				_m.ActorID = *value
+			}
+		case taskassignmentevent.FieldFromUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field from_user_id", values[i])
+			} else if value.Valid {
+				_m.FromUserID = new(uuid.UUID)
+				*_m.FromUserID = *value.S.(*uuid.UUID)
+			}
+		case taskassignmentevent.FieldToUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field to_user_id", values[i])
+			} else if value.Valid {
+				_m.ToUserID = new(uuid.UUID)
+				*_m.ToUserID = *value.S.(*uuid.UUID)
+			}
+		case taskassignmentevent.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = new(string)
+				*_m.Role = value.String
+			}
+		case taskassignmentevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case taskassignmentevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field task_assignment_events", values[i])
+			} else if value.Valid {
+				_m.task_assignment_events = new(uuid.UUID)
+				*_m.task_assignment_events = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskAssignmentEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskAssignmentEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskAssignmentEvent entity.
+func (_m *TaskAssignmentEvent) QueryTask() *TaskQuery {
+	return NewTaskAssignmentEventClient(_m.config).QueryTask(_m)
+}
+
+// Update returns a builder for updating this TaskAssignmentEvent.
+// Note that you need to call TaskAssignmentEvent.Unwrap() before calling this method if this TaskAssignmentEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskAssignmentEvent) Update() *TaskAssignmentEventUpdateOne {
+	return NewTaskAssignmentEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskAssignmentEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskAssignmentEvent) Unwrap() *TaskAssignmentEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskAssignmentEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskAssignmentEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskAssignmentEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActorID))
+	builder.WriteString(", ")
+	if v := _m.FromUserID; v != nil {
+		builder.WriteString("from_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ToUserID; v != nil {
+		builder.WriteString("to_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Role; v != nil {
+		builder.WriteString("role=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskAssignmentEvents is a parsable slice of TaskAssignmentEvent.
+type TaskAssignmentEvents []*TaskAssignmentEvent
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskassignmentevent
+
+import (
+	"project-manager-dashboard-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLTE(FieldID, id))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldActorID, v))
+}
+
+// FromUserID applies equality check predicate on the "from_user_id" field. It's identical to FromUserIDEQ.
+func FromUserID(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldFromUserID, v))
+}
+
+// ToUserID applies equality check predicate on the "to_user_id" field. It's identical to ToUserIDEQ.
+func ToUserID(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldToUserID, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldRole, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLTE(FieldActorID, v))
+}
+
+// FromUserIDEQ applies the EQ predicate on the "from_user_id" field.
+func FromUserIDEQ(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldFromUserID, v))
+}
+
+// FromUserIDNEQ applies the NEQ predicate on the "from_user_id" field.
+func FromUserIDNEQ(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNEQ(FieldFromUserID, v))
+}
+
+// FromUserIDIn applies the In predicate on the "from_user_id" field.
+func FromUserIDIn(vs ...uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIn(FieldFromUserID, vs...))
+}
+
+// FromUserIDNotIn applies the NotIn predicate on the "from_user_id" field.
+func FromUserIDNotIn(vs ...uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotIn(FieldFromUserID, vs...))
+}
+
+// FromUserIDGT applies the GT predicate on the "from_user_id" field.
+func FromUserIDGT(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGT(FieldFromUserID, v))
+}
+
+// FromUserIDGTE applies the GTE predicate on the "from_user_id" field.
+func FromUserIDGTE(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGTE(FieldFromUserID, v))
+}
+
+// FromUserIDLT applies the LT predicate on the "from_user_id" field.
+func FromUserIDLT(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLT(FieldFromUserID, v))
+}
+
+// FromUserIDLTE applies the LTE predicate on the "from_user_id" field.
+func FromUserIDLTE(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLTE(FieldFromUserID, v))
+}
+
+// FromUserIDIsNil applies the IsNil predicate on the "from_user_id" field.
+func FromUserIDIsNil() predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIsNull(FieldFromUserID))
+}
+
+// FromUserIDNotNil applies the NotNil predicate on the "from_user_id" field.
+func FromUserIDNotNil() predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotNull(FieldFromUserID))
+}
+
+// ToUserIDEQ applies the EQ predicate on the "to_user_id" field.
+func ToUserIDEQ(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldToUserID, v))
+}
+
+// ToUserIDNEQ applies the NEQ predicate on the "to_user_id" field.
+func ToUserIDNEQ(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNEQ(FieldToUserID, v))
+}
+
+// ToUserIDIn applies the In predicate on the "to_user_id" field.
+func ToUserIDIn(vs ...uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIn(FieldToUserID, vs...))
+}
+
+// ToUserIDNotIn applies the NotIn predicate on the "to_user_id" field.
+func ToUserIDNotIn(vs ...uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotIn(FieldToUserID, vs...))
+}
+
+// ToUserIDGT applies the GT predicate on the "to_user_id" field.
+func ToUserIDGT(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGT(FieldToUserID, v))
+}
+
+// ToUserIDGTE applies the GTE predicate on the "to_user_id" field.
+func ToUserIDGTE(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGTE(FieldToUserID, v))
+}
+
+// ToUserIDLT applies the LT predicate on the "to_user_id" field.
+func ToUserIDLT(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLT(FieldToUserID, v))
+}
+
+// ToUserIDLTE applies the LTE predicate on the "to_user_id" field.
+func ToUserIDLTE(v uuid.UUID) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLTE(FieldToUserID, v))
+}
+
+// ToUserIDIsNil applies the IsNil predicate on the "to_user_id" field.
+func ToUserIDIsNil() predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIsNull(FieldToUserID))
+}
+
+// ToUserIDNotNil applies the NotNil predicate on the "to_user_id" field.
+func ToUserIDNotNil() predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotNull(FieldToUserID))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleIsNil applies the IsNil predicate on the "role" field.
+func RoleIsNil() predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIsNull(FieldRole))
+}
+
+// RoleNotNil applies the NotNil predicate on the "role" field.
+func RoleNotNil() predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotNull(FieldRole))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldContainsFold(FieldRole, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskAssignmentEvent) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskAssignmentEvent) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskAssignmentEvent) predicate.TaskAssignmentEvent {
+	return predicate.TaskAssignmentEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskAssignmentEventCreate is the builder for creating a TaskAssignmentEvent entity.
+type TaskAssignmentEventCreate struct {
+	config
+	mutation *TaskAssignmentEventMutation
+	hooks    []Hook
+}
+
+// SetAction sets the "action" field.
+func (_c *TaskAssignmentEventCreate) SetAction(v taskassignmentevent.Action) *TaskAssignmentEventCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *TaskAssignmentEventCreate) SetActorID(v uuid.UUID) *TaskAssignmentEventCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (_c *TaskAssignmentEventCreate) SetFromUserID(v uuid.UUID) *TaskAssignmentEventCreate {
+	_c.mutation.SetFromUserID(v)
+	return _c
+}
+
+// SetNillableFromUserID sets the "from_user_id" field if the given value is not nil.
+func (_c *TaskAssignmentEventCreate) SetNillableFromUserID(v *uuid.UUID) *TaskAssignmentEventCreate {
+	if v != nil {
+		_c.SetFromUserID(*v)
+	}
+	return _c
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (_c *TaskAssignmentEventCreate) SetToUserID(v uuid.UUID) *TaskAssignmentEventCreate {
+	_c.mutation.SetToUserID(v)
+	return _c
+}
+
+// SetNillableToUserID sets the "to_user_id" field if the given value is not nil.
+func (_c *TaskAssignmentEventCreate) SetNillableToUserID(v *uuid.UUID) *TaskAssignmentEventCreate {
+	if v != nil {
+		_c.SetToUserID(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *TaskAssignmentEventCreate) SetRole(v string) *TaskAssignmentEventCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *TaskAssignmentEventCreate) SetNillableRole(v *string) *TaskAssignmentEventCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskAssignmentEventCreate) SetCreatedAt(v time.Time) *TaskAssignmentEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskAssignmentEventCreate) SetNillableCreatedAt(v *time.Time) *TaskAssignmentEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskAssignmentEventCreate) SetID(v uuid.UUID) *TaskAssignmentEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TaskAssignmentEventCreate) SetNillableID(v *uuid.UUID) *TaskAssignmentEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_c *TaskAssignmentEventCreate) SetTaskID(id uuid.UUID) *TaskAssignmentEventCreate {
+	_c.mutation.SetTaskID(id)
+	return _c
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_c *TaskAssignmentEventCreate) SetTask(v *Task) *TaskAssignmentEventCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskAssignmentEventMutation object of the builder.
+func (_c *TaskAssignmentEventCreate) Mutation() *TaskAssignmentEventMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskAssignmentEvent in the database.
+func (_c *TaskAssignmentEventCreate) Save(ctx context.Context) (*TaskAssignmentEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskAssignmentEventCreate) SaveX(ctx context.Context) *TaskAssignmentEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskAssignmentEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskAssignmentEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskAssignmentEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := taskassignmentevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := taskassignmentevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskAssignmentEventCreate) check() error {
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "TaskAssignmentEvent.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := taskassignmentevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "TaskAssignmentEvent.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "TaskAssignmentEvent.actor_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskAssignmentEvent.created_at"`)}
+	}
+	if len(_c.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskAssignmentEvent.task"`)}
+	}
+	return nil
+}
+
+func (_c *TaskAssignmentEventCreate) sqlSave(ctx context.Context) (*TaskAssignmentEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskAssignmentEventCreate) createSpec() (*TaskAssignmentEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskAssignmentEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskassignmentevent.Table, sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(taskassignmentevent.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(taskassignmentevent.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = value
+	}
+	if value, ok := _c.mutation.FromUserID(); ok {
+		_spec.SetField(taskassignmentevent.FieldFromUserID, field.TypeUUID, value)
+		_node.FromUserID = &value
+	}
+	if value, ok := _c.mutation.ToUserID(); ok {
+		_spec.SetField(taskassignmentevent.FieldToUserID, field.TypeUUID, value)
+		_node.ToUserID = &value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(taskassignmentevent.FieldRole, field.TypeString, value)
+		_node.Role = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskassignmentevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignmentevent.TaskTable,
+			Columns: []string{taskassignmentevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.task_assignment_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskAssignmentEventCreateBulk is the builder for creating many TaskAssignmentEvent entities in bulk.
+type TaskAssignmentEventCreateBulk struct {
+	config
+	err      error
+	builders []*TaskAssignmentEventCreate
+}
+
+// Save creates the TaskAssignmentEvent entities in the database.
+func (_c *TaskAssignmentEventCreateBulk) Save(ctx context.Context) ([]*TaskAssignmentEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskAssignmentEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskAssignmentEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskAssignmentEventCreateBulk) SaveX(ctx context.Context) []*TaskAssignmentEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskAssignmentEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskAssignmentEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskAssignmentEventDelete is the builder for deleting a TaskAssignmentEvent entity.
+type TaskAssignmentEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskAssignmentEventMutation
+}
+
+// Where appends a list predicates to the TaskAssignmentEventDelete builder.
+func (_d *TaskAssignmentEventDelete) Where(ps ...predicate.TaskAssignmentEvent) *TaskAssignmentEventDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TaskAssignmentEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TaskAssignmentEventDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TaskAssignmentEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskassignmentevent.Table, sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TaskAssignmentEventDeleteOne is the builder for deleting a single TaskAssignmentEvent entity.
+type TaskAssignmentEventDeleteOne struct {
+	_d *TaskAssignmentEventDelete
+}
+
+// Where appends a list predicates to the TaskAssignmentEventDelete builder.
+func (_d *TaskAssignmentEventDeleteOne) Where(ps ...predicate.TaskAssignmentEvent) *TaskAssignmentEventDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TaskAssignmentEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskassignmentevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TaskAssignmentEventDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
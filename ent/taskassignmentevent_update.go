@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskAssignmentEventUpdate is the builder for updating TaskAssignmentEvent entities.
+type TaskAssignmentEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskAssignmentEventMutation
+}
+
+// Where appends a list predicates to the TaskAssignmentEventUpdate builder.
+func (_u *TaskAssignmentEventUpdate) Where(ps ...predicate.TaskAssignmentEvent) *TaskAssignmentEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskAssignmentEventUpdate) SetTaskID(id uuid.UUID) *TaskAssignmentEventUpdate {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskAssignmentEventUpdate) SetTask(v *Task) *TaskAssignmentEventUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskAssignmentEventMutation object of the builder.
+func (_u *TaskAssignmentEventUpdate) Mutation() *TaskAssignmentEventMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskAssignmentEventUpdate) ClearTask() *TaskAssignmentEventUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskAssignmentEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskAssignmentEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskAssignmentEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskAssignmentEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskAssignmentEventUpdate) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskAssignmentEvent.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskAssignmentEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskassignmentevent.Table, taskassignmentevent.Columns, sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FromUserIDCleared() {
+		_spec.ClearField(taskassignmentevent.FieldFromUserID, field.TypeUUID)
+	}
+	if _u.mutation.ToUserIDCleared() {
+		_spec.ClearField(taskassignmentevent.FieldToUserID, field.TypeUUID)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(taskassignmentevent.FieldRole, field.TypeString)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignmentevent.TaskTable,
+			Columns: []string{taskassignmentevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignmentevent.TaskTable,
+			Columns: []string{taskassignmentevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskassignmentevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskAssignmentEventUpdateOne is the builder for updating a single TaskAssignmentEvent entity.
+type TaskAssignmentEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskAssignmentEventMutation
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskAssignmentEventUpdateOne) SetTaskID(id uuid.UUID) *TaskAssignmentEventUpdateOne {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskAssignmentEventUpdateOne) SetTask(v *Task) *TaskAssignmentEventUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskAssignmentEventMutation object of the builder.
+func (_u *TaskAssignmentEventUpdateOne) Mutation() *TaskAssignmentEventMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskAssignmentEventUpdateOne) ClearTask() *TaskAssignmentEventUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// Where appends a list predicates to the TaskAssignmentEventUpdate builder.
+func (_u *TaskAssignmentEventUpdateOne) Where(ps ...predicate.TaskAssignmentEvent) *TaskAssignmentEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskAssignmentEventUpdateOne) Select(field string, fields ...string) *TaskAssignmentEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskAssignmentEvent entity.
+func (_u *TaskAssignmentEventUpdateOne) Save(ctx context.Context) (*TaskAssignmentEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskAssignmentEventUpdateOne) SaveX(ctx context.Context) *TaskAssignmentEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskAssignmentEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskAssignmentEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskAssignmentEventUpdateOne) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskAssignmentEvent.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskAssignmentEventUpdateOne) sqlSave(ctx context.Context) (_node *TaskAssignmentEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskassignmentevent.Table, taskassignmentevent.Columns, sqlgraph.NewFieldSpec(taskassignmentevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskAssignmentEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskassignmentevent.FieldID)
+		for _, f := range fields {
+			if !taskassignmentevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskassignmentevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FromUserIDCleared() {
+		_spec.ClearField(taskassignmentevent.FieldFromUserID, field.TypeUUID)
+	}
+	if _u.mutation.ToUserIDCleared() {
+		_spec.ClearField(taskassignmentevent.FieldToUserID, field.TypeUUID)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(taskassignmentevent.FieldRole, field.TypeString)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignmentevent.TaskTable,
+			Columns: []string{taskassignmentevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskassignmentevent.TaskTable,
+			Columns: []string{taskassignmentevent.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskAssignmentEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskassignmentevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TaskReminder is the model entity for the TaskReminder schema.
+type TaskReminder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind taskreminder.Kind `json:"kind,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskReminderQuery when eager-loading is set.
+	Edges          TaskReminderEdges `json:"edges"`
+	task_reminders *uuid.UUID
+	selectValues   sql.SelectValues
+}
+
+// TaskReminderEdges holds the relations/edges for other nodes in the graph.
+type TaskReminderEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskReminderEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskReminder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskreminder.FieldKind:
+			values[i] = new(sql.NullString)
+		case taskreminder.FieldDueDate, taskreminder.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taskreminder.FieldID:
+			values[i] = new(uuid.UUID)
+		case taskreminder.ForeignKeys[0]: // task_reminders
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskReminder fields.
+func (_m *TaskReminder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskreminder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case taskreminder.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = taskreminder.Kind(value.String)
+			}
+		case taskreminder.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				_m.DueDate = value.Time
+			}
+		case taskreminder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case taskreminder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field task_reminders", values[i])
+			} else if value.Valid {
+				_m.task_reminders = new(uuid.UUID)
+				*_m.task_reminders = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskReminder.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskReminder) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskReminder entity.
+func (_m *TaskReminder) QueryTask() *TaskQuery {
+	return NewTaskReminderClient(_m.config).QueryTask(_m)
+}
+
+// Update returns a builder for updating this TaskReminder.
+// Note that you need to call TaskReminder.Unwrap() before calling this method if this TaskReminder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskReminder) Update() *TaskReminderUpdateOne {
+	return NewTaskReminderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskReminder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskReminder) Unwrap() *TaskReminder {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskReminder is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskReminder) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskReminder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(_m.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskReminders is a parsable slice of TaskReminder.
+type TaskReminders []*TaskReminder
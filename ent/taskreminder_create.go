@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskReminderCreate is the builder for creating a TaskReminder entity.
+type TaskReminderCreate struct {
+	config
+	mutation *TaskReminderMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *TaskReminderCreate) SetKind(v taskreminder.Kind) *TaskReminderCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetDueDate sets the "due_date" field.
+func (_c *TaskReminderCreate) SetDueDate(v time.Time) *TaskReminderCreate {
+	_c.mutation.SetDueDate(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskReminderCreate) SetCreatedAt(v time.Time) *TaskReminderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskReminderCreate) SetNillableCreatedAt(v *time.Time) *TaskReminderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskReminderCreate) SetID(v uuid.UUID) *TaskReminderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TaskReminderCreate) SetNillableID(v *uuid.UUID) *TaskReminderCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_c *TaskReminderCreate) SetTaskID(id uuid.UUID) *TaskReminderCreate {
+	_c.mutation.SetTaskID(id)
+	return _c
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_c *TaskReminderCreate) SetTask(v *Task) *TaskReminderCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskReminderMutation object of the builder.
+func (_c *TaskReminderCreate) Mutation() *TaskReminderMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskReminder in the database.
+func (_c *TaskReminderCreate) Save(ctx context.Context) (*TaskReminder, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskReminderCreate) SaveX(ctx context.Context) *TaskReminder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskReminderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskReminderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskReminderCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := taskreminder.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := taskreminder.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskReminderCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "TaskReminder.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := taskreminder.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "TaskReminder.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "TaskReminder.due_date"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskReminder.created_at"`)}
+	}
+	if len(_c.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskReminder.task"`)}
+	}
+	return nil
+}
+
+func (_c *TaskReminderCreate) sqlSave(ctx context.Context) (*TaskReminder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskReminderCreate) createSpec() (*TaskReminder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskReminder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskreminder.Table, sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(taskreminder.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.DueDate(); ok {
+		_spec.SetField(taskreminder.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskreminder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.task_reminders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskReminderCreateBulk is the builder for creating many TaskReminder entities in bulk.
+type TaskReminderCreateBulk struct {
+	config
+	err      error
+	builders []*TaskReminderCreate
+}
+
+// Save creates the TaskReminder entities in the database.
+func (_c *TaskReminderCreateBulk) Save(ctx context.Context) ([]*TaskReminder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskReminder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskReminderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskReminderCreateBulk) SaveX(ctx context.Context) []*TaskReminder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskReminderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskReminderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskreminder"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskReminderUpdate is the builder for updating TaskReminder entities.
+type TaskReminderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskReminderMutation
+}
+
+// Where appends a list predicates to the TaskReminderUpdate builder.
+func (_u *TaskReminderUpdate) Where(ps ...predicate.TaskReminder) *TaskReminderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskReminderUpdate) SetTaskID(id uuid.UUID) *TaskReminderUpdate {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskReminderUpdate) SetTask(v *Task) *TaskReminderUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskReminderMutation object of the builder.
+func (_u *TaskReminderUpdate) Mutation() *TaskReminderMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskReminderUpdate) ClearTask() *TaskReminderUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskReminderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskReminderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskReminderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskReminderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskReminderUpdate) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskReminder.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskReminderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskreminder.Table, taskreminder.Columns, sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskreminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskReminderUpdateOne is the builder for updating a single TaskReminder entity.
+type TaskReminderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskReminderMutation
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskReminderUpdateOne) SetTaskID(id uuid.UUID) *TaskReminderUpdateOne {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskReminderUpdateOne) SetTask(v *Task) *TaskReminderUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// Mutation returns the TaskReminderMutation object of the builder.
+func (_u *TaskReminderUpdateOne) Mutation() *TaskReminderMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskReminderUpdateOne) ClearTask() *TaskReminderUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// Where appends a list predicates to the TaskReminderUpdate builder.
+func (_u *TaskReminderUpdateOne) Where(ps ...predicate.TaskReminder) *TaskReminderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskReminderUpdateOne) Select(field string, fields ...string) *TaskReminderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskReminder entity.
+func (_u *TaskReminderUpdateOne) Save(ctx context.Context) (*TaskReminder, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskReminderUpdateOne) SaveX(ctx context.Context) *TaskReminder {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskReminderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskReminderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskReminderUpdateOne) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskReminder.task"`)
+	}
+	return nil
+}
+
+func (_u *TaskReminderUpdateOne) sqlSave(ctx context.Context) (_node *TaskReminder, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskreminder.Table, taskreminder.Columns, sqlgraph.NewFieldSpec(taskreminder.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskReminder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskreminder.FieldID)
+		for _, f := range fields {
+			if !taskreminder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskreminder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskreminder.TaskTable,
+			Columns: []string{taskreminder.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskReminder{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskreminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
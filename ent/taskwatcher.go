@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TaskWatcher is the model entity for the TaskWatcher schema.
+type TaskWatcher struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskWatcherQuery when eager-loading is set.
+	Edges              TaskWatcherEdges `json:"edges"`
+	task_watchers      *uuid.UUID
+	user_watched_tasks *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// TaskWatcherEdges holds the relations/edges for other nodes in the graph.
+type TaskWatcherEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskWatcherEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskWatcherEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskWatcher) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskwatcher.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taskwatcher.FieldID:
+			values[i] = new(uuid.UUID)
+		case taskwatcher.ForeignKeys[0]: // task_watchers
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case taskwatcher.ForeignKeys[1]: // user_watched_tasks
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskWatcher fields.
+func (_m *TaskWatcher) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskwatcher.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case taskwatcher.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case taskwatcher.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field task_watchers", values[i])
+			} else if value.Valid {
+				_m.task_watchers = new(uuid.UUID)
+				*_m.task_watchers = *value.S.(*uuid.UUID)
+			}
+		case taskwatcher.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_watched_tasks", values[i])
+			} else if value.Valid {
+				_m.user_watched_tasks = new(uuid.UUID)
+				*_m.user_watched_tasks = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskWatcher.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaskWatcher) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskWatcher entity.
+func (_m *TaskWatcher) QueryTask() *TaskQuery {
+	return NewTaskWatcherClient(_m.config).QueryTask(_m)
+}
+
+// QueryUser queries the "user" edge of the TaskWatcher entity.
+func (_m *TaskWatcher) QueryUser() *UserQuery {
+	return NewTaskWatcherClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this TaskWatcher.
+// Note that you need to call TaskWatcher.Unwrap() before calling this method if this TaskWatcher
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaskWatcher) Update() *TaskWatcherUpdateOne {
+	return NewTaskWatcherClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaskWatcher entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaskWatcher) Unwrap() *TaskWatcher {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskWatcher is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaskWatcher) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskWatcher(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskWatchers is a parsable slice of TaskWatcher.
+type TaskWatchers []*TaskWatcher
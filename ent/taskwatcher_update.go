@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskWatcherUpdate is the builder for updating TaskWatcher entities.
+type TaskWatcherUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskWatcherMutation
+}
+
+// Where appends a list predicates to the TaskWatcherUpdate builder.
+func (_u *TaskWatcherUpdate) Where(ps ...predicate.TaskWatcher) *TaskWatcherUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TaskWatcherUpdate) SetCreatedAt(v time.Time) *TaskWatcherUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TaskWatcherUpdate) SetNillableCreatedAt(v *time.Time) *TaskWatcherUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskWatcherUpdate) SetTaskID(id uuid.UUID) *TaskWatcherUpdate {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskWatcherUpdate) SetTask(v *Task) *TaskWatcherUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *TaskWatcherUpdate) SetUserID(id uuid.UUID) *TaskWatcherUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TaskWatcherUpdate) SetUser(v *User) *TaskWatcherUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TaskWatcherMutation object of the builder.
+func (_u *TaskWatcherUpdate) Mutation() *TaskWatcherMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskWatcherUpdate) ClearTask() *TaskWatcherUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TaskWatcherUpdate) ClearUser() *TaskWatcherUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskWatcherUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskWatcherUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskWatcherUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskWatcherUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskWatcherUpdate) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.task"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.user"`)
+	}
+	return nil
+}
+
+func (_u *TaskWatcherUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskwatcher.Table, taskwatcher.Columns, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(taskwatcher.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskwatcher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskWatcherUpdateOne is the builder for updating a single TaskWatcher entity.
+type TaskWatcherUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskWatcherMutation
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TaskWatcherUpdateOne) SetCreatedAt(v time.Time) *TaskWatcherUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TaskWatcherUpdateOne) SetNillableCreatedAt(v *time.Time) *TaskWatcherUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the Task entity by ID.
+func (_u *TaskWatcherUpdateOne) SetTaskID(id uuid.UUID) *TaskWatcherUpdateOne {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (_u *TaskWatcherUpdateOne) SetTask(v *Task) *TaskWatcherUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *TaskWatcherUpdateOne) SetUserID(id uuid.UUID) *TaskWatcherUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TaskWatcherUpdateOne) SetUser(v *User) *TaskWatcherUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TaskWatcherMutation object of the builder.
+func (_u *TaskWatcherUpdateOne) Mutation() *TaskWatcherMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (_u *TaskWatcherUpdateOne) ClearTask() *TaskWatcherUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TaskWatcherUpdateOne) ClearUser() *TaskWatcherUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the TaskWatcherUpdate builder.
+func (_u *TaskWatcherUpdateOne) Where(ps ...predicate.TaskWatcher) *TaskWatcherUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskWatcherUpdateOne) Select(field string, fields ...string) *TaskWatcherUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskWatcher entity.
+func (_u *TaskWatcherUpdateOne) Save(ctx context.Context) (*TaskWatcher, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskWatcherUpdateOne) SaveX(ctx context.Context) *TaskWatcher {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskWatcherUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskWatcherUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskWatcherUpdateOne) check() error {
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.task"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.user"`)
+	}
+	return nil
+}
+
+func (_u *TaskWatcherUpdateOne) sqlSave(ctx context.Context) (_node *TaskWatcher, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskwatcher.Table, taskwatcher.Columns, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskWatcher.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskwatcher.FieldID)
+		for _, f := range fields {
+			if !taskwatcher.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskwatcher.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(taskwatcher.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TaskWatcher{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskwatcher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
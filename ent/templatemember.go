@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"project-manager-dashboard-go/ent/templatemember"
+	"project-manager-dashboard-go/ent/user"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TemplateMember is the model entity for the TemplateMember schema.
+type TemplateMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role templatemember.Role `json:"role,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TemplateMemberQuery when eager-loading is set.
+	Edges                     TemplateMemberEdges `json:"edges"`
+	project_template_members  *uuid.UUID
+	user_template_memberships *uuid.UUID
+	selectValues              sql.SelectValues
+}
+
+// TemplateMemberEdges holds the relations/edges for other nodes in the graph.
+type TemplateMemberEdges struct {
+	// Template holds the value of the template edge.
+	Template *ProjectTemplate `json:"template,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// TemplateOrErr returns the Template value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateMemberEdges) TemplateOrErr() (*ProjectTemplate, error) {
+	if e.Template != nil {
+		return e.Template, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: projecttemplate.Label}
+	}
+	return nil, &NotLoadedError{edge: "template"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateMemberEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TemplateMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case templatemember.FieldRole:
+			values[i] = new(sql.NullString)
+		case templatemember.FieldID:
+			values[i] = new(uuid.UUID)
+		case templatemember.ForeignKeys[0]: // project_template_members
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case templatemember.ForeignKeys[1]: // user_template_memberships
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TemplateMember fields.
+func (_m *TemplateMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case templatemember.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case templatemember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = templatemember.Role(value.String)
+			}
+		case templatemember.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field project_template_members", values[i])
+			} else if value.Valid {
+				_m.project_template_members = new(uuid.UUID)
+				*_m.project_template_members = *value.S.(*uuid.UUID)
+			}
+		case templatemember.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_template_memberships", values[i])
+			} else if value.Valid {
+				_m.user_template_memberships = new(uuid.UUID)
+				*_m.user_template_memberships = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TemplateMember.
+// This includes values selected through modifiers, order, etc.
+func (_m *TemplateMember) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTemplate queries the "template" edge of the TemplateMember entity.
+func (_m *TemplateMember) QueryTemplate() *ProjectTemplateQuery {
+	return NewTemplateMemberClient(_m.config).QueryTemplate(_m)
+}
+
+// QueryUser queries the "user" edge of the TemplateMember entity.
+func (_m *TemplateMember) QueryUser() *UserQuery {
+	return NewTemplateMemberClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this TemplateMember.
+// Note that you need to call TemplateMember.Unwrap() before calling this method if this TemplateMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TemplateMember) Update() *TemplateMemberUpdateOne {
+	return NewTemplateMemberClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TemplateMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TemplateMember) Unwrap() *TemplateMember {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TemplateMember is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TemplateMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("TemplateMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TemplateMembers is a parsable slice of TemplateMember.
+type TemplateMembers []*TemplateMember
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"project-manager-dashboard-go/ent/templatemember"
+	"project-manager-dashboard-go/ent/user"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TemplateMemberCreate is the builder for creating a TemplateMember entity.
+type TemplateMemberCreate struct {
+	config
+	mutation *TemplateMemberMutation
+	hooks    []Hook
+}
+
+// SetRole sets the "role" field.
+func (_c *TemplateMemberCreate) SetRole(v templatemember.Role) *TemplateMemberCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *TemplateMemberCreate) SetNillableRole(v *templatemember.Role) *TemplateMemberCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TemplateMemberCreate) SetID(v uuid.UUID) *TemplateMemberCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TemplateMemberCreate) SetNillableID(v *uuid.UUID) *TemplateMemberCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTemplateID sets the "template" edge to the ProjectTemplate entity by ID.
+func (_c *TemplateMemberCreate) SetTemplateID(id uuid.UUID) *TemplateMemberCreate {
+	_c.mutation.SetTemplateID(id)
+	return _c
+}
+
+// SetTemplate sets the "template" edge to the ProjectTemplate entity.
+func (_c *TemplateMemberCreate) SetTemplate(v *ProjectTemplate) *TemplateMemberCreate {
+	return _c.SetTemplateID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *TemplateMemberCreate) SetUserID(id uuid.UUID) *TemplateMemberCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *TemplateMemberCreate) SetUser(v *User) *TemplateMemberCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the TemplateMemberMutation object of the builder.
+func (_c *TemplateMemberCreate) Mutation() *TemplateMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the TemplateMember in the database.
+func (_c *TemplateMemberCreate) Save(ctx context.Context) (*TemplateMember, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TemplateMemberCreate) SaveX(ctx context.Context) *TemplateMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TemplateMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TemplateMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TemplateMemberCreate) defaults() {
+	if _, ok := _c.mutation.Role(); !ok {
+		v := templatemember.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := templatemember.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TemplateMemberCreate) check() error {
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "TemplateMember.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := templatemember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TemplateMember.role": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TemplateIDs()) == 0 {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required edge "TemplateMember.template"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TemplateMember.user"`)}
+	}
+	return nil
+}
+
+func (_c *TemplateMemberCreate) sqlSave(ctx context.Context) (*TemplateMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TemplateMemberCreate) createSpec() (*TemplateMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TemplateMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(templatemember.Table, sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(templatemember.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if nodes := _c.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.TemplateTable,
+			Columns: []string{templatemember.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_template_members = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.UserTable,
+			Columns: []string{templatemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_template_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TemplateMemberCreateBulk is the builder for creating many TemplateMember entities in bulk.
+type TemplateMemberCreateBulk struct {
+	config
+	err      error
+	builders []*TemplateMemberCreate
+}
+
+// Save creates the TemplateMember entities in the database.
+func (_c *TemplateMemberCreateBulk) Save(ctx context.Context) ([]*TemplateMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TemplateMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TemplateMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TemplateMemberCreateBulk) SaveX(ctx context.Context) []*TemplateMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TemplateMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TemplateMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"project-manager-dashboard-go/ent/templatemember"
+	"project-manager-dashboard-go/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TemplateMemberUpdate is the builder for updating TemplateMember entities.
+type TemplateMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TemplateMemberMutation
+}
+
+// Where appends a list predicates to the TemplateMemberUpdate builder.
+func (_u *TemplateMemberUpdate) Where(ps ...predicate.TemplateMember) *TemplateMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *TemplateMemberUpdate) SetRole(v templatemember.Role) *TemplateMemberUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TemplateMemberUpdate) SetNillableRole(v *templatemember.Role) *TemplateMemberUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetTemplateID sets the "template" edge to the ProjectTemplate entity by ID.
+func (_u *TemplateMemberUpdate) SetTemplateID(id uuid.UUID) *TemplateMemberUpdate {
+	_u.mutation.SetTemplateID(id)
+	return _u
+}
+
+// SetTemplate sets the "template" edge to the ProjectTemplate entity.
+func (_u *TemplateMemberUpdate) SetTemplate(v *ProjectTemplate) *TemplateMemberUpdate {
+	return _u.SetTemplateID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *TemplateMemberUpdate) SetUserID(id uuid.UUID) *TemplateMemberUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TemplateMemberUpdate) SetUser(v *User) *TemplateMemberUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TemplateMemberMutation object of the builder.
+func (_u *TemplateMemberUpdate) Mutation() *TemplateMemberMutation {
+	return _u.mutation
+}
+
+// ClearTemplate clears the "template" edge to the ProjectTemplate entity.
+func (_u *TemplateMemberUpdate) ClearTemplate() *TemplateMemberUpdate {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TemplateMemberUpdate) ClearUser() *TemplateMemberUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TemplateMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TemplateMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TemplateMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TemplateMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TemplateMemberUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := templatemember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TemplateMember.role": %w`, err)}
+		}
+	}
+	if _u.mutation.TemplateCleared() && len(_u.mutation.TemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TemplateMember.template"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TemplateMember.user"`)
+	}
+	return nil
+}
+
+func (_u *TemplateMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templatemember.Table, templatemember.Columns, sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(templatemember.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.TemplateTable,
+			Columns: []string{templatemember.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.TemplateTable,
+			Columns: []string{templatemember.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.UserTable,
+			Columns: []string{templatemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.UserTable,
+			Columns: []string{templatemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templatemember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TemplateMemberUpdateOne is the builder for updating a single TemplateMember entity.
+type TemplateMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TemplateMemberMutation
+}
+
+// SetRole sets the "role" field.
+func (_u *TemplateMemberUpdateOne) SetRole(v templatemember.Role) *TemplateMemberUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *TemplateMemberUpdateOne) SetNillableRole(v *templatemember.Role) *TemplateMemberUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetTemplateID sets the "template" edge to the ProjectTemplate entity by ID.
+func (_u *TemplateMemberUpdateOne) SetTemplateID(id uuid.UUID) *TemplateMemberUpdateOne {
+	_u.mutation.SetTemplateID(id)
+	return _u
+}
+
+// SetTemplate sets the "template" edge to the ProjectTemplate entity.
+func (_u *TemplateMemberUpdateOne) SetTemplate(v *ProjectTemplate) *TemplateMemberUpdateOne {
+	return _u.SetTemplateID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *TemplateMemberUpdateOne) SetUserID(id uuid.UUID) *TemplateMemberUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TemplateMemberUpdateOne) SetUser(v *User) *TemplateMemberUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TemplateMemberMutation object of the builder.
+func (_u *TemplateMemberUpdateOne) Mutation() *TemplateMemberMutation {
+	return _u.mutation
+}
+
+// ClearTemplate clears the "template" edge to the ProjectTemplate entity.
+func (_u *TemplateMemberUpdateOne) ClearTemplate() *TemplateMemberUpdateOne {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TemplateMemberUpdateOne) ClearUser() *TemplateMemberUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the TemplateMemberUpdate builder.
+func (_u *TemplateMemberUpdateOne) Where(ps ...predicate.TemplateMember) *TemplateMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TemplateMemberUpdateOne) Select(field string, fields ...string) *TemplateMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TemplateMember entity.
+func (_u *TemplateMemberUpdateOne) Save(ctx context.Context) (*TemplateMember, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TemplateMemberUpdateOne) SaveX(ctx context.Context) *TemplateMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TemplateMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TemplateMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TemplateMemberUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := templatemember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TemplateMember.role": %w`, err)}
+		}
+	}
+	if _u.mutation.TemplateCleared() && len(_u.mutation.TemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TemplateMember.template"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TemplateMember.user"`)
+	}
+	return nil
+}
+
+func (_u *TemplateMemberUpdateOne) sqlSave(ctx context.Context) (_node *TemplateMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templatemember.Table, templatemember.Columns, sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TemplateMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, templatemember.FieldID)
+		for _, f := range fields {
+			if !templatemember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != templatemember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(templatemember.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.TemplateTable,
+			Columns: []string{templatemember.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.TemplateTable,
+			Columns: []string{templatemember.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.UserTable,
+			Columns: []string{templatemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatemember.UserTable,
+			Columns: []string{templatemember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TemplateMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templatemember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"project-manager-dashboard-go/ent/templatetask"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TemplateTask is the model entity for the TemplateTask schema.
+type TemplateTask struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority templatetask.Priority `json:"priority,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// DueOffsetDays holds the value of the "due_offset_days" field.
+	DueOffsetDays *int `json:"due_offset_days,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TemplateTaskQuery when eager-loading is set.
+	Edges                  TemplateTaskEdges `json:"edges"`
+	project_template_tasks *uuid.UUID
+	selectValues           sql.SelectValues
+}
+
+// TemplateTaskEdges holds the relations/edges for other nodes in the graph.
+type TemplateTaskEdges struct {
+	// Template holds the value of the template edge.
+	Template *ProjectTemplate `json:"template,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TemplateOrErr returns the Template value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateTaskEdges) TemplateOrErr() (*ProjectTemplate, error) {
+	if e.Template != nil {
+		return e.Template, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: projecttemplate.Label}
+	}
+	return nil, &NotLoadedError{edge: "template"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TemplateTask) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case templatetask.FieldPosition, templatetask.FieldDueOffsetDays:
+			values[i] = new(sql.NullInt64)
+		case templatetask.FieldTitle, templatetask.FieldDescription, templatetask.FieldPriority:
+			values[i] = new(sql.NullString)
+		case templatetask.FieldID:
+			values[i] = new(uuid.UUID)
+		case templatetask.ForeignKeys[0]: // project_template_tasks
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TemplateTask fields.
+func (_m *TemplateTask) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case templatetask.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case templatetask.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case templatetask.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case templatetask.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = templatetask.Priority(value.String)
+			}
+		case templatetask.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case templatetask.FieldDueOffsetDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field due_offset_days", values[i])
+			} else if value.Valid {
+				_m.DueOffsetDays = new(int)
+				*_m.DueOffsetDays = int(value.Int64)
+			}
+		case templatetask.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field project_template_tasks", values[i])
+			} else if value.Valid {
+				_m.project_template_tasks = new(uuid.UUID)
+				*_m.project_template_tasks = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TemplateTask.
+// This includes values selected through modifiers, order, etc.
+func (_m *TemplateTask) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTemplate queries the "template" edge of the TemplateTask entity.
+func (_m *TemplateTask) QueryTemplate() *ProjectTemplateQuery {
+	return NewTemplateTaskClient(_m.config).QueryTemplate(_m)
+}
+
+// Update returns a builder for updating this TemplateTask.
+// Note that you need to call TemplateTask.Unwrap() before calling this method if this TemplateTask
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TemplateTask) Update() *TemplateTaskUpdateOne {
+	return NewTemplateTaskClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TemplateTask entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TemplateTask) Unwrap() *TemplateTask {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TemplateTask is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TemplateTask) String() string {
+	var builder strings.Builder
+	builder.WriteString("TemplateTask(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	if v := _m.DueOffsetDays; v != nil {
+		builder.WriteString("due_offset_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TemplateTasks is a parsable slice of TemplateTask.
+type TemplateTasks []*TemplateTask
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package templatetask
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the templatetask type in the database.
+	Label = "template_task"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldDueOffsetDays holds the string denoting the due_offset_days field in the database.
+	FieldDueOffsetDays = "due_offset_days"
+	// EdgeTemplate holds the string denoting the template edge name in mutations.
+	EdgeTemplate = "template"
+	// Table holds the table name of the templatetask in the database.
+	Table = "template_tasks"
+	// TemplateTable is the table that holds the template relation/edge.
+	TemplateTable = "template_tasks"
+	// TemplateInverseTable is the table name for the ProjectTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "projecttemplate" package.
+	TemplateInverseTable = "project_templates"
+	// TemplateColumn is the table column denoting the template relation/edge.
+	TemplateColumn = "project_template_tasks"
+)
+
+// Columns holds all SQL columns for templatetask fields.
+var Columns = []string{
+	FieldID,
+	FieldTitle,
+	FieldDescription,
+	FieldPriority,
+	FieldPosition,
+	FieldDueOffsetDays,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "template_tasks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"project_template_tasks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Priority defines the type for the "priority" enum field.
+type Priority string
+
+// PriorityMedium is the default value of the Priority enum.
+const DefaultPriority = PriorityMedium
+
+// Priority values.
+const (
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+)
+
+func (pr Priority) String() string {
+	return string(pr)
+}
+
+// PriorityValidator is a validator for the "priority" field enum values. It is called by the builders before save.
+func PriorityValidator(pr Priority) error {
+	switch pr {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return nil
+	default:
+		return fmt.Errorf("templatetask: invalid enum value for priority field: %q", pr)
+	}
+}
+
+// OrderOption defines the ordering options for the TemplateTask queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByDueOffsetDays orders the results by the due_offset_days field.
+func ByDueOffsetDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueOffsetDays, opts...).ToFunc()
+}
+
+// ByTemplateField orders the results by template field.
+func ByTemplateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTemplateStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTemplateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TemplateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TemplateTable, TemplateColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Priority) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Priority) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Priority(str)
+	if err := PriorityValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Priority", str)
+	}
+	return nil
+}
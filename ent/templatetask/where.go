@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package templatetask
+
+import (
+	"project-manager-dashboard-go/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLTE(FieldID, id))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldDescription, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldPosition, v))
+}
+
+// DueOffsetDays applies equality check predicate on the "due_offset_days" field. It's identical to DueOffsetDaysEQ.
+func DueOffsetDays(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldDueOffsetDays, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLTE(FieldPosition, v))
+}
+
+// DueOffsetDaysEQ applies the EQ predicate on the "due_offset_days" field.
+func DueOffsetDaysEQ(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldEQ(FieldDueOffsetDays, v))
+}
+
+// DueOffsetDaysNEQ applies the NEQ predicate on the "due_offset_days" field.
+func DueOffsetDaysNEQ(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNEQ(FieldDueOffsetDays, v))
+}
+
+// DueOffsetDaysIn applies the In predicate on the "due_offset_days" field.
+func DueOffsetDaysIn(vs ...int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldIn(FieldDueOffsetDays, vs...))
+}
+
+// DueOffsetDaysNotIn applies the NotIn predicate on the "due_offset_days" field.
+func DueOffsetDaysNotIn(vs ...int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNotIn(FieldDueOffsetDays, vs...))
+}
+
+// DueOffsetDaysGT applies the GT predicate on the "due_offset_days" field.
+func DueOffsetDaysGT(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGT(FieldDueOffsetDays, v))
+}
+
+// DueOffsetDaysGTE applies the GTE predicate on the "due_offset_days" field.
+func DueOffsetDaysGTE(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldGTE(FieldDueOffsetDays, v))
+}
+
+// DueOffsetDaysLT applies the LT predicate on the "due_offset_days" field.
+func DueOffsetDaysLT(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLT(FieldDueOffsetDays, v))
+}
+
+// DueOffsetDaysLTE applies the LTE predicate on the "due_offset_days" field.
+func DueOffsetDaysLTE(v int) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldLTE(FieldDueOffsetDays, v))
+}
+
+// DueOffsetDaysIsNil applies the IsNil predicate on the "due_offset_days" field.
+func DueOffsetDaysIsNil() predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldIsNull(FieldDueOffsetDays))
+}
+
+// DueOffsetDaysNotNil applies the NotNil predicate on the "due_offset_days" field.
+func DueOffsetDaysNotNil() predicate.TemplateTask {
+	return predicate.TemplateTask(sql.FieldNotNull(FieldDueOffsetDays))
+}
+
+// HasTemplate applies the HasEdge predicate on the "template" edge.
+func HasTemplate() predicate.TemplateTask {
+	return predicate.TemplateTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TemplateTable, TemplateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTemplateWith applies the HasEdge predicate on the "template" edge with a given conditions (other predicates).
+func HasTemplateWith(preds ...predicate.ProjectTemplate) predicate.TemplateTask {
+	return predicate.TemplateTask(func(s *sql.Selector) {
+		step := newTemplateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TemplateTask) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TemplateTask) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TemplateTask) predicate.TemplateTask {
+	return predicate.TemplateTask(sql.NotPredicates(p))
+}
@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"project-manager-dashboard-go/ent/templatetask"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TemplateTaskCreate is the builder for creating a TemplateTask entity.
+type TemplateTaskCreate struct {
+	config
+	mutation *TemplateTaskMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (_c *TemplateTaskCreate) SetTitle(v string) *TemplateTaskCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TemplateTaskCreate) SetDescription(v string) *TemplateTaskCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TemplateTaskCreate) SetNillableDescription(v *string) *TemplateTaskCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *TemplateTaskCreate) SetPriority(v templatetask.Priority) *TemplateTaskCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *TemplateTaskCreate) SetNillablePriority(v *templatetask.Priority) *TemplateTaskCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *TemplateTaskCreate) SetPosition(v int) *TemplateTaskCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *TemplateTaskCreate) SetNillablePosition(v *int) *TemplateTaskCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetDueOffsetDays sets the "due_offset_days" field.
+func (_c *TemplateTaskCreate) SetDueOffsetDays(v int) *TemplateTaskCreate {
+	_c.mutation.SetDueOffsetDays(v)
+	return _c
+}
+
+// SetNillableDueOffsetDays sets the "due_offset_days" field if the given value is not nil.
+func (_c *TemplateTaskCreate) SetNillableDueOffsetDays(v *int) *TemplateTaskCreate {
+	if v != nil {
+		_c.SetDueOffsetDays(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TemplateTaskCreate) SetID(v uuid.UUID) *TemplateTaskCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TemplateTaskCreate) SetNillableID(v *uuid.UUID) *TemplateTaskCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTemplateID sets the "template" edge to the ProjectTemplate entity by ID.
+func (_c *TemplateTaskCreate) SetTemplateID(id uuid.UUID) *TemplateTaskCreate {
+	_c.mutation.SetTemplateID(id)
+	return _c
+}
+
+// SetTemplate sets the "template" edge to the ProjectTemplate entity.
+func (_c *TemplateTaskCreate) SetTemplate(v *ProjectTemplate) *TemplateTaskCreate {
+	return _c.SetTemplateID(v.ID)
+}
+
+// Mutation returns the TemplateTaskMutation object of the builder.
+func (_c *TemplateTaskCreate) Mutation() *TemplateTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the TemplateTask in the database.
+func (_c *TemplateTaskCreate) Save(ctx context.Context) (*TemplateTask, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TemplateTaskCreate) SaveX(ctx context.Context) *TemplateTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TemplateTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TemplateTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TemplateTaskCreate) defaults() {
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := templatetask.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := templatetask.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := templatetask.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TemplateTaskCreate) check() error {
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "TemplateTask.title"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "TemplateTask.priority"`)}
+	}
+	if v, ok := _c.mutation.Priority(); ok {
+		if err := templatetask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "TemplateTask.priority": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "TemplateTask.position"`)}
+	}
+	if len(_c.mutation.TemplateIDs()) == 0 {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required edge "TemplateTask.template"`)}
+	}
+	return nil
+}
+
+func (_c *TemplateTaskCreate) sqlSave(ctx context.Context) (*TemplateTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TemplateTaskCreate) createSpec() (*TemplateTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TemplateTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(templatetask.Table, sqlgraph.NewFieldSpec(templatetask.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(templatetask.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(templatetask.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(templatetask.FieldPriority, field.TypeEnum, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(templatetask.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.DueOffsetDays(); ok {
+		_spec.SetField(templatetask.FieldDueOffsetDays, field.TypeInt, value)
+		_node.DueOffsetDays = &value
+	}
+	if nodes := _c.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatetask.TemplateTable,
+			Columns: []string{templatetask.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.project_template_tasks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TemplateTaskCreateBulk is the builder for creating many TemplateTask entities in bulk.
+type TemplateTaskCreateBulk struct {
+	config
+	err      error
+	builders []*TemplateTaskCreate
+}
+
+// Save creates the TemplateTask entities in the database.
+func (_c *TemplateTaskCreateBulk) Save(ctx context.Context) ([]*TemplateTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TemplateTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TemplateTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TemplateTaskCreateBulk) SaveX(ctx context.Context) []*TemplateTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TemplateTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TemplateTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,547 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"project-manager-dashboard-go/ent/templatetask"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TemplateTaskUpdate is the builder for updating TemplateTask entities.
+type TemplateTaskUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TemplateTaskMutation
+}
+
+// Where appends a list predicates to the TemplateTaskUpdate builder.
+func (_u *TemplateTaskUpdate) Where(ps ...predicate.TemplateTask) *TemplateTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TemplateTaskUpdate) SetTitle(v string) *TemplateTaskUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TemplateTaskUpdate) SetNillableTitle(v *string) *TemplateTaskUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TemplateTaskUpdate) SetDescription(v string) *TemplateTaskUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TemplateTaskUpdate) SetNillableDescription(v *string) *TemplateTaskUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TemplateTaskUpdate) ClearDescription() *TemplateTaskUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TemplateTaskUpdate) SetPriority(v templatetask.Priority) *TemplateTaskUpdate {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TemplateTaskUpdate) SetNillablePriority(v *templatetask.Priority) *TemplateTaskUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *TemplateTaskUpdate) SetPosition(v int) *TemplateTaskUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *TemplateTaskUpdate) SetNillablePosition(v *int) *TemplateTaskUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *TemplateTaskUpdate) AddPosition(v int) *TemplateTaskUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetDueOffsetDays sets the "due_offset_days" field.
+func (_u *TemplateTaskUpdate) SetDueOffsetDays(v int) *TemplateTaskUpdate {
+	_u.mutation.ResetDueOffsetDays()
+	_u.mutation.SetDueOffsetDays(v)
+	return _u
+}
+
+// SetNillableDueOffsetDays sets the "due_offset_days" field if the given value is not nil.
+func (_u *TemplateTaskUpdate) SetNillableDueOffsetDays(v *int) *TemplateTaskUpdate {
+	if v != nil {
+		_u.SetDueOffsetDays(*v)
+	}
+	return _u
+}
+
+// AddDueOffsetDays adds value to the "due_offset_days" field.
+func (_u *TemplateTaskUpdate) AddDueOffsetDays(v int) *TemplateTaskUpdate {
+	_u.mutation.AddDueOffsetDays(v)
+	return _u
+}
+
+// ClearDueOffsetDays clears the value of the "due_offset_days" field.
+func (_u *TemplateTaskUpdate) ClearDueOffsetDays() *TemplateTaskUpdate {
+	_u.mutation.ClearDueOffsetDays()
+	return _u
+}
+
+// SetTemplateID sets the "template" edge to the ProjectTemplate entity by ID.
+func (_u *TemplateTaskUpdate) SetTemplateID(id uuid.UUID) *TemplateTaskUpdate {
+	_u.mutation.SetTemplateID(id)
+	return _u
+}
+
+// SetTemplate sets the "template" edge to the ProjectTemplate entity.
+func (_u *TemplateTaskUpdate) SetTemplate(v *ProjectTemplate) *TemplateTaskUpdate {
+	return _u.SetTemplateID(v.ID)
+}
+
+// Mutation returns the TemplateTaskMutation object of the builder.
+func (_u *TemplateTaskUpdate) Mutation() *TemplateTaskMutation {
+	return _u.mutation
+}
+
+// ClearTemplate clears the "template" edge to the ProjectTemplate entity.
+func (_u *TemplateTaskUpdate) ClearTemplate() *TemplateTaskUpdate {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TemplateTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TemplateTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TemplateTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TemplateTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TemplateTaskUpdate) check() error {
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := templatetask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "TemplateTask.priority": %w`, err)}
+		}
+	}
+	if _u.mutation.TemplateCleared() && len(_u.mutation.TemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TemplateTask.template"`)
+	}
+	return nil
+}
+
+func (_u *TemplateTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templatetask.Table, templatetask.Columns, sqlgraph.NewFieldSpec(templatetask.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(templatetask.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(templatetask.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(templatetask.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(templatetask.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(templatetask.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(templatetask.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DueOffsetDays(); ok {
+		_spec.SetField(templatetask.FieldDueOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDueOffsetDays(); ok {
+		_spec.AddField(templatetask.FieldDueOffsetDays, field.TypeInt, value)
+	}
+	if _u.mutation.DueOffsetDaysCleared() {
+		_spec.ClearField(templatetask.FieldDueOffsetDays, field.TypeInt)
+	}
+	if _u.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatetask.TemplateTable,
+			Columns: []string{templatetask.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatetask.TemplateTable,
+			Columns: []string{templatetask.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templatetask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TemplateTaskUpdateOne is the builder for updating a single TemplateTask entity.
+type TemplateTaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TemplateTaskMutation
+}
+
+// SetTitle sets the "title" field.
+func (_u *TemplateTaskUpdateOne) SetTitle(v string) *TemplateTaskUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TemplateTaskUpdateOne) SetNillableTitle(v *string) *TemplateTaskUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TemplateTaskUpdateOne) SetDescription(v string) *TemplateTaskUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TemplateTaskUpdateOne) SetNillableDescription(v *string) *TemplateTaskUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TemplateTaskUpdateOne) ClearDescription() *TemplateTaskUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TemplateTaskUpdateOne) SetPriority(v templatetask.Priority) *TemplateTaskUpdateOne {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TemplateTaskUpdateOne) SetNillablePriority(v *templatetask.Priority) *TemplateTaskUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *TemplateTaskUpdateOne) SetPosition(v int) *TemplateTaskUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *TemplateTaskUpdateOne) SetNillablePosition(v *int) *TemplateTaskUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *TemplateTaskUpdateOne) AddPosition(v int) *TemplateTaskUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetDueOffsetDays sets the "due_offset_days" field.
+func (_u *TemplateTaskUpdateOne) SetDueOffsetDays(v int) *TemplateTaskUpdateOne {
+	_u.mutation.ResetDueOffsetDays()
+	_u.mutation.SetDueOffsetDays(v)
+	return _u
+}
+
+// SetNillableDueOffsetDays sets the "due_offset_days" field if the given value is not nil.
+func (_u *TemplateTaskUpdateOne) SetNillableDueOffsetDays(v *int) *TemplateTaskUpdateOne {
+	if v != nil {
+		_u.SetDueOffsetDays(*v)
+	}
+	return _u
+}
+
+// AddDueOffsetDays adds value to the "due_offset_days" field.
+func (_u *TemplateTaskUpdateOne) AddDueOffsetDays(v int) *TemplateTaskUpdateOne {
+	_u.mutation.AddDueOffsetDays(v)
+	return _u
+}
+
+// ClearDueOffsetDays clears the value of the "due_offset_days" field.
+func (_u *TemplateTaskUpdateOne) ClearDueOffsetDays() *TemplateTaskUpdateOne {
+	_u.mutation.ClearDueOffsetDays()
+	return _u
+}
+
+// SetTemplateID sets the "template" edge to the ProjectTemplate entity by ID.
+func (_u *TemplateTaskUpdateOne) SetTemplateID(id uuid.UUID) *TemplateTaskUpdateOne {
+	_u.mutation.SetTemplateID(id)
+	return _u
+}
+
+// SetTemplate sets the "template" edge to the ProjectTemplate entity.
+func (_u *TemplateTaskUpdateOne) SetTemplate(v *ProjectTemplate) *TemplateTaskUpdateOne {
+	return _u.SetTemplateID(v.ID)
+}
+
+// Mutation returns the TemplateTaskMutation object of the builder.
+func (_u *TemplateTaskUpdateOne) Mutation() *TemplateTaskMutation {
+	return _u.mutation
+}
+
+// ClearTemplate clears the "template" edge to the ProjectTemplate entity.
+func (_u *TemplateTaskUpdateOne) ClearTemplate() *TemplateTaskUpdateOne {
+	_u.mutation.ClearTemplate()
+	return _u
+}
+
+// Where appends a list predicates to the TemplateTaskUpdate builder.
+func (_u *TemplateTaskUpdateOne) Where(ps ...predicate.TemplateTask) *TemplateTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TemplateTaskUpdateOne) Select(field string, fields ...string) *TemplateTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TemplateTask entity.
+func (_u *TemplateTaskUpdateOne) Save(ctx context.Context) (*TemplateTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TemplateTaskUpdateOne) SaveX(ctx context.Context) *TemplateTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TemplateTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TemplateTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TemplateTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := templatetask.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "TemplateTask.priority": %w`, err)}
+		}
+	}
+	if _u.mutation.TemplateCleared() && len(_u.mutation.TemplateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TemplateTask.template"`)
+	}
+	return nil
+}
+
+func (_u *TemplateTaskUpdateOne) sqlSave(ctx context.Context) (_node *TemplateTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templatetask.Table, templatetask.Columns, sqlgraph.NewFieldSpec(templatetask.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TemplateTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, templatetask.FieldID)
+		for _, f := range fields {
+			if !templatetask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != templatetask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(templatetask.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(templatetask.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(templatetask.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(templatetask.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(templatetask.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(templatetask.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DueOffsetDays(); ok {
+		_spec.SetField(templatetask.FieldDueOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDueOffsetDays(); ok {
+		_spec.AddField(templatetask.FieldDueOffsetDays, field.TypeInt, value)
+	}
+	if _u.mutation.DueOffsetDaysCleared() {
+		_spec.ClearField(templatetask.FieldDueOffsetDays, field.TypeInt)
+	}
+	if _u.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatetask.TemplateTable,
+			Columns: []string{templatetask.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templatetask.TemplateTable,
+			Columns: []string{templatetask.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttemplate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TemplateTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templatetask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
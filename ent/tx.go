@@ -12,16 +12,50 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// MailMessage is the client for interacting with the MailMessage builders.
+	MailMessage *MailMessageClient
+	// Milestone is the client for interacting with the Milestone builders.
+	Milestone *MilestoneClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
 	// ProjectTask is the client for interacting with the ProjectTask builders.
 	ProjectTask *ProjectTaskClient
+	// ProjectTemplate is the client for interacting with the ProjectTemplate builders.
+	ProjectTemplate *ProjectTemplateClient
 	// ProjectUser is the client for interacting with the ProjectUser builders.
 	ProjectUser *ProjectUserClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskActivity is the client for interacting with the TaskActivity builders.
+	TaskActivity *TaskActivityClient
+	// TaskAssignee is the client for interacting with the TaskAssignee builders.
+	TaskAssignee *TaskAssigneeClient
+	// TaskAssignmentEvent is the client for interacting with the TaskAssignmentEvent builders.
+	TaskAssignmentEvent *TaskAssignmentEventClient
+	// TaskReminder is the client for interacting with the TaskReminder builders.
+	TaskReminder *TaskReminderClient
+	// TaskWatcher is the client for interacting with the TaskWatcher builders.
+	TaskWatcher *TaskWatcherClient
+	// TemplateMember is the client for interacting with the TemplateMember builders.
+	TemplateMember *TemplateMemberClient
+	// TemplateTask is the client for interacting with the TemplateTask builders.
+	TemplateTask *TemplateTaskClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,11 +187,28 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.MailMessage = NewMailMessageClient(tx.config)
+	tx.Milestone = NewMilestoneClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
 	tx.ProjectTask = NewProjectTaskClient(tx.config)
+	tx.ProjectTemplate = NewProjectTemplateClient(tx.config)
 	tx.ProjectUser = NewProjectUserClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.TaskActivity = NewTaskActivityClient(tx.config)
+	tx.TaskAssignee = NewTaskAssigneeClient(tx.config)
+	tx.TaskAssignmentEvent = NewTaskAssignmentEventClient(tx.config)
+	tx.TaskReminder = NewTaskReminderClient(tx.config)
+	tx.TaskWatcher = NewTaskWatcherClient(tx.config)
+	tx.TemplateMember = NewTemplateMemberClient(tx.config)
+	tx.TemplateTask = NewTemplateTaskClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -167,7 +218,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Project.QueryXXX(), the query will be executed
+// applies a query, for example: AuditEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -24,6 +24,8 @@ type User struct {
 	Name string `json:"name,omitempty"`
 	// Country holds the value of the "country" field.
 	Country string `json:"country,omitempty"`
+	// EmailMode holds the value of the "email_mode" field.
+	EmailMode user.EmailMode `json:"email_mode,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -34,39 +36,92 @@ type User struct {
 
 // UserEdges holds the relations/edges for other nodes in the graph.
 type UserEdges struct {
-	// AssignedTasks holds the value of the assigned_tasks edge.
-	AssignedTasks []*Task `json:"assigned_tasks,omitempty"`
+	// TaskAssignments holds the value of the task_assignments edge.
+	TaskAssignments []*TaskAssignee `json:"task_assignments,omitempty"`
+	// WatchedTasks holds the value of the watched_tasks edge.
+	WatchedTasks []*TaskWatcher `json:"watched_tasks,omitempty"`
 	// Memberships holds the value of the memberships edge.
 	Memberships []*ProjectUser `json:"memberships,omitempty"`
+	// TemplateMemberships holds the value of the template_memberships edge.
+	TemplateMemberships []*TemplateMember `json:"template_memberships,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// NotificationPreferences holds the value of the notification_preferences edge.
+	NotificationPreferences []*NotificationPreference `json:"notification_preferences,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedTaskAssignments         map[string][]*TaskAssignee
+	namedWatchedTasks            map[string][]*TaskWatcher
+	namedMemberships             map[string][]*ProjectUser
+	namedTemplateMemberships     map[string][]*TemplateMember
+	namedNotifications           map[string][]*Notification
+	namedNotificationPreferences map[string][]*NotificationPreference
 }
 
-// AssignedTasksOrErr returns the AssignedTasks value or an error if the edge
+// TaskAssignmentsOrErr returns the TaskAssignments value or an error if the edge
 // was not loaded in eager-loading.
-func (e UserEdges) AssignedTasksOrErr() ([]*Task, error) {
+func (e UserEdges) TaskAssignmentsOrErr() ([]*TaskAssignee, error) {
 	if e.loadedTypes[0] {
-		return e.AssignedTasks, nil
+		return e.TaskAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "task_assignments"}
+}
+
+// WatchedTasksOrErr returns the WatchedTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WatchedTasksOrErr() ([]*TaskWatcher, error) {
+	if e.loadedTypes[1] {
+		return e.WatchedTasks, nil
 	}
-	return nil, &NotLoadedError{edge: "assigned_tasks"}
+	return nil, &NotLoadedError{edge: "watched_tasks"}
 }
 
 // MembershipsOrErr returns the Memberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) MembershipsOrErr() ([]*ProjectUser, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Memberships, nil
 	}
 	return nil, &NotLoadedError{edge: "memberships"}
 }
 
+// TemplateMembershipsOrErr returns the TemplateMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TemplateMembershipsOrErr() ([]*TemplateMember, error) {
+	if e.loadedTypes[3] {
+		return e.TemplateMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "template_memberships"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[4] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// NotificationPreferencesOrErr returns the NotificationPreferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationPreferencesOrErr() ([]*NotificationPreference, error) {
+	if e.loadedTypes[5] {
+		return e.NotificationPreferences, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_preferences"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmail, user.FieldName, user.FieldCountry:
+		case user.FieldEmail, user.FieldName, user.FieldCountry, user.FieldEmailMode:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -111,6 +166,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Country = value.String
 			}
+		case user.FieldEmailMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email_mode", values[i])
+			} else if value.Valid {
+				_m.EmailMode = user.EmailMode(value.String)
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -130,9 +191,14 @@ func (_m *User) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
-// QueryAssignedTasks queries the "assigned_tasks" edge of the User entity.
-func (_m *User) QueryAssignedTasks() *TaskQuery {
-	return NewUserClient(_m.config).QueryAssignedTasks(_m)
+// QueryTaskAssignments queries the "task_assignments" edge of the User entity.
+func (_m *User) QueryTaskAssignments() *TaskAssigneeQuery {
+	return NewUserClient(_m.config).QueryTaskAssignments(_m)
+}
+
+// QueryWatchedTasks queries the "watched_tasks" edge of the User entity.
+func (_m *User) QueryWatchedTasks() *TaskWatcherQuery {
+	return NewUserClient(_m.config).QueryWatchedTasks(_m)
 }
 
 // QueryMemberships queries the "memberships" edge of the User entity.
@@ -140,6 +206,21 @@ func (_m *User) QueryMemberships() *ProjectUserQuery {
 	return NewUserClient(_m.config).QueryMemberships(_m)
 }
 
+// QueryTemplateMemberships queries the "template_memberships" edge of the User entity.
+func (_m *User) QueryTemplateMemberships() *TemplateMemberQuery {
+	return NewUserClient(_m.config).QueryTemplateMemberships(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (_m *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(_m.config).QueryNotifications(_m)
+}
+
+// QueryNotificationPreferences queries the "notification_preferences" edge of the User entity.
+func (_m *User) QueryNotificationPreferences() *NotificationPreferenceQuery {
+	return NewUserClient(_m.config).QueryNotificationPreferences(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -172,11 +253,158 @@ func (_m *User) String() string {
 	builder.WriteString("country=")
 	builder.WriteString(_m.Country)
 	builder.WriteString(", ")
+	builder.WriteString("email_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EmailMode))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedTaskAssignments returns the TaskAssignments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedTaskAssignments(name string) ([]*TaskAssignee, error) {
+	if _m.Edges.namedTaskAssignments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedTaskAssignments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedTaskAssignments(name string, edges ...*TaskAssignee) {
+	if _m.Edges.namedTaskAssignments == nil {
+		_m.Edges.namedTaskAssignments = make(map[string][]*TaskAssignee)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedTaskAssignments[name] = []*TaskAssignee{}
+	} else {
+		_m.Edges.namedTaskAssignments[name] = append(_m.Edges.namedTaskAssignments[name], edges...)
+	}
+}
+
+// NamedWatchedTasks returns the WatchedTasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedWatchedTasks(name string) ([]*TaskWatcher, error) {
+	if _m.Edges.namedWatchedTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedWatchedTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedWatchedTasks(name string, edges ...*TaskWatcher) {
+	if _m.Edges.namedWatchedTasks == nil {
+		_m.Edges.namedWatchedTasks = make(map[string][]*TaskWatcher)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedWatchedTasks[name] = []*TaskWatcher{}
+	} else {
+		_m.Edges.namedWatchedTasks[name] = append(_m.Edges.namedWatchedTasks[name], edges...)
+	}
+}
+
+// NamedMemberships returns the Memberships named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedMemberships(name string) ([]*ProjectUser, error) {
+	if _m.Edges.namedMemberships == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedMemberships[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedMemberships(name string, edges ...*ProjectUser) {
+	if _m.Edges.namedMemberships == nil {
+		_m.Edges.namedMemberships = make(map[string][]*ProjectUser)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedMemberships[name] = []*ProjectUser{}
+	} else {
+		_m.Edges.namedMemberships[name] = append(_m.Edges.namedMemberships[name], edges...)
+	}
+}
+
+// NamedTemplateMemberships returns the TemplateMemberships named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedTemplateMemberships(name string) ([]*TemplateMember, error) {
+	if _m.Edges.namedTemplateMemberships == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedTemplateMemberships[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedTemplateMemberships(name string, edges ...*TemplateMember) {
+	if _m.Edges.namedTemplateMemberships == nil {
+		_m.Edges.namedTemplateMemberships = make(map[string][]*TemplateMember)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedTemplateMemberships[name] = []*TemplateMember{}
+	} else {
+		_m.Edges.namedTemplateMemberships[name] = append(_m.Edges.namedTemplateMemberships[name], edges...)
+	}
+}
+
+// NamedNotifications returns the Notifications named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedNotifications(name string) ([]*Notification, error) {
+	if _m.Edges.namedNotifications == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedNotifications[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedNotifications(name string, edges ...*Notification) {
+	if _m.Edges.namedNotifications == nil {
+		_m.Edges.namedNotifications = make(map[string][]*Notification)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedNotifications[name] = []*Notification{}
+	} else {
+		_m.Edges.namedNotifications[name] = append(_m.Edges.namedNotifications[name], edges...)
+	}
+}
+
+// NamedNotificationPreferences returns the NotificationPreferences named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedNotificationPreferences(name string) ([]*NotificationPreference, error) {
+	if _m.Edges.namedNotificationPreferences == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedNotificationPreferences[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedNotificationPreferences(name string, edges ...*NotificationPreference) {
+	if _m.Edges.namedNotificationPreferences == nil {
+		_m.Edges.namedNotificationPreferences = make(map[string][]*NotificationPreference)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedNotificationPreferences[name] = []*NotificationPreference{}
+	} else {
+		_m.Edges.namedNotificationPreferences[name] = append(_m.Edges.namedNotificationPreferences[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
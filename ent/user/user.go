@@ -3,6 +3,9 @@
 package user
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -21,21 +24,38 @@ const (
 	FieldName = "name"
 	// FieldCountry holds the string denoting the country field in the database.
 	FieldCountry = "country"
+	// FieldEmailMode holds the string denoting the email_mode field in the database.
+	FieldEmailMode = "email_mode"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
-	// EdgeAssignedTasks holds the string denoting the assigned_tasks edge name in mutations.
-	EdgeAssignedTasks = "assigned_tasks"
+	// EdgeTaskAssignments holds the string denoting the task_assignments edge name in mutations.
+	EdgeTaskAssignments = "task_assignments"
+	// EdgeWatchedTasks holds the string denoting the watched_tasks edge name in mutations.
+	EdgeWatchedTasks = "watched_tasks"
 	// EdgeMemberships holds the string denoting the memberships edge name in mutations.
 	EdgeMemberships = "memberships"
+	// EdgeTemplateMemberships holds the string denoting the template_memberships edge name in mutations.
+	EdgeTemplateMemberships = "template_memberships"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeNotificationPreferences holds the string denoting the notification_preferences edge name in mutations.
+	EdgeNotificationPreferences = "notification_preferences"
 	// Table holds the table name of the user in the database.
 	Table = "users"
-	// AssignedTasksTable is the table that holds the assigned_tasks relation/edge.
-	AssignedTasksTable = "tasks"
-	// AssignedTasksInverseTable is the table name for the Task entity.
-	// It exists in this package in order to avoid circular dependency with the "task" package.
-	AssignedTasksInverseTable = "tasks"
-	// AssignedTasksColumn is the table column denoting the assigned_tasks relation/edge.
-	AssignedTasksColumn = "assignee_id"
+	// TaskAssignmentsTable is the table that holds the task_assignments relation/edge.
+	TaskAssignmentsTable = "task_assignees"
+	// TaskAssignmentsInverseTable is the table name for the TaskAssignee entity.
+	// It exists in this package in order to avoid circular dependency with the "taskassignee" package.
+	TaskAssignmentsInverseTable = "task_assignees"
+	// TaskAssignmentsColumn is the table column denoting the task_assignments relation/edge.
+	TaskAssignmentsColumn = "user_task_assignments"
+	// WatchedTasksTable is the table that holds the watched_tasks relation/edge.
+	WatchedTasksTable = "task_watchers"
+	// WatchedTasksInverseTable is the table name for the TaskWatcher entity.
+	// It exists in this package in order to avoid circular dependency with the "taskwatcher" package.
+	WatchedTasksInverseTable = "task_watchers"
+	// WatchedTasksColumn is the table column denoting the watched_tasks relation/edge.
+	WatchedTasksColumn = "user_watched_tasks"
 	// MembershipsTable is the table that holds the memberships relation/edge.
 	MembershipsTable = "project_users"
 	// MembershipsInverseTable is the table name for the ProjectUser entity.
@@ -43,6 +63,27 @@ const (
 	MembershipsInverseTable = "project_users"
 	// MembershipsColumn is the table column denoting the memberships relation/edge.
 	MembershipsColumn = "user_memberships"
+	// TemplateMembershipsTable is the table that holds the template_memberships relation/edge.
+	TemplateMembershipsTable = "template_members"
+	// TemplateMembershipsInverseTable is the table name for the TemplateMember entity.
+	// It exists in this package in order to avoid circular dependency with the "templatemember" package.
+	TemplateMembershipsInverseTable = "template_members"
+	// TemplateMembershipsColumn is the table column denoting the template_memberships relation/edge.
+	TemplateMembershipsColumn = "user_template_memberships"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "user_id"
+	// NotificationPreferencesTable is the table that holds the notification_preferences relation/edge.
+	NotificationPreferencesTable = "notification_preferences"
+	// NotificationPreferencesInverseTable is the table name for the NotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationpreference" package.
+	NotificationPreferencesInverseTable = "notification_preferences"
+	// NotificationPreferencesColumn is the table column denoting the notification_preferences relation/edge.
+	NotificationPreferencesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -51,6 +92,7 @@ var Columns = []string{
 	FieldEmail,
 	FieldName,
 	FieldCountry,
+	FieldEmailMode,
 	FieldCreatedAt,
 }
 
@@ -71,6 +113,33 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// EmailMode defines the type for the "email_mode" enum field.
+type EmailMode string
+
+// EmailModeInstant is the default value of the EmailMode enum.
+const DefaultEmailMode = EmailModeInstant
+
+// EmailMode values.
+const (
+	EmailModeInstant EmailMode = "instant"
+	EmailModeDaily   EmailMode = "daily"
+	EmailModeOff     EmailMode = "off"
+)
+
+func (em EmailMode) String() string {
+	return string(em)
+}
+
+// EmailModeValidator is a validator for the "email_mode" field enum values. It is called by the builders before save.
+func EmailModeValidator(em EmailMode) error {
+	switch em {
+	case EmailModeInstant, EmailModeDaily, EmailModeOff:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for email_mode field: %q", em)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -94,22 +163,41 @@ func ByCountry(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCountry, opts...).ToFunc()
 }
 
+// ByEmailMode orders the results by the email_mode field.
+func ByEmailMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailMode, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
-// ByAssignedTasksCount orders the results by assigned_tasks count.
-func ByAssignedTasksCount(opts ...sql.OrderTermOption) OrderOption {
+// ByTaskAssignmentsCount orders the results by task_assignments count.
+func ByTaskAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaskAssignmentsStep(), opts...)
+	}
+}
+
+// ByTaskAssignments orders the results by task_assignments terms.
+func ByTaskAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newAssignedTasksStep(), opts...)
+		sqlgraph.OrderByNeighborTerms(s, newTaskAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
-// ByAssignedTasks orders the results by assigned_tasks terms.
-func ByAssignedTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByWatchedTasksCount orders the results by watched_tasks count.
+func ByWatchedTasksCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newAssignedTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborsCount(s, newWatchedTasksStep(), opts...)
+	}
+}
+
+// ByWatchedTasks orders the results by watched_tasks terms.
+func ByWatchedTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchedTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -126,11 +214,60 @@ func ByMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
-func newAssignedTasksStep() *sqlgraph.Step {
+
+// ByTemplateMembershipsCount orders the results by template_memberships count.
+func ByTemplateMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTemplateMembershipsStep(), opts...)
+	}
+}
+
+// ByTemplateMemberships orders the results by template_memberships terms.
+func ByTemplateMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTemplateMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationPreferencesCount orders the results by notification_preferences count.
+func ByNotificationPreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationPreferencesStep(), opts...)
+	}
+}
+
+// ByNotificationPreferences orders the results by notification_preferences terms.
+func ByNotificationPreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationPreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTaskAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TaskAssignmentsTable, TaskAssignmentsColumn),
+	)
+}
+func newWatchedTasksStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(AssignedTasksInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, AssignedTasksTable, AssignedTasksColumn),
+		sqlgraph.To(WatchedTasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WatchedTasksTable, WatchedTasksColumn),
 	)
 }
 func newMembershipsStep() *sqlgraph.Step {
@@ -140,3 +277,42 @@ func newMembershipsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
 	)
 }
+func newTemplateMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TemplateMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TemplateMembershipsTable, TemplateMembershipsColumn),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
+func newNotificationPreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationPreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e EmailMode) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *EmailMode) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = EmailMode(str)
+	if err := EmailModeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid EmailMode", str)
+	}
+	return nil
+}
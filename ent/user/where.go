@@ -281,6 +281,26 @@ func CountryContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldCountry, v))
 }
 
+// EmailModeEQ applies the EQ predicate on the "email_mode" field.
+func EmailModeEQ(v EmailMode) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailMode, v))
+}
+
+// EmailModeNEQ applies the NEQ predicate on the "email_mode" field.
+func EmailModeNEQ(v EmailMode) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailMode, v))
+}
+
+// EmailModeIn applies the In predicate on the "email_mode" field.
+func EmailModeIn(vs ...EmailMode) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailMode, vs...))
+}
+
+// EmailModeNotIn applies the NotIn predicate on the "email_mode" field.
+func EmailModeNotIn(vs ...EmailMode) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailMode, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -321,21 +341,44 @@ func CreatedAtLTE(v time.Time) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldCreatedAt, v))
 }
 
-// HasAssignedTasks applies the HasEdge predicate on the "assigned_tasks" edge.
-func HasAssignedTasks() predicate.User {
+// HasTaskAssignments applies the HasEdge predicate on the "task_assignments" edge.
+func HasTaskAssignments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TaskAssignmentsTable, TaskAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskAssignmentsWith applies the HasEdge predicate on the "task_assignments" edge with a given conditions (other predicates).
+func HasTaskAssignmentsWith(preds ...predicate.TaskAssignee) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newTaskAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWatchedTasks applies the HasEdge predicate on the "watched_tasks" edge.
+func HasWatchedTasks() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, AssignedTasksTable, AssignedTasksColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, WatchedTasksTable, WatchedTasksColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasAssignedTasksWith applies the HasEdge predicate on the "assigned_tasks" edge with a given conditions (other predicates).
-func HasAssignedTasksWith(preds ...predicate.Task) predicate.User {
+// HasWatchedTasksWith applies the HasEdge predicate on the "watched_tasks" edge with a given conditions (other predicates).
+func HasWatchedTasksWith(preds ...predicate.TaskWatcher) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
-		step := newAssignedTasksStep()
+		step := newWatchedTasksStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -367,6 +410,75 @@ func HasMembershipsWith(preds ...predicate.ProjectUser) predicate.User {
 	})
 }
 
+// HasTemplateMemberships applies the HasEdge predicate on the "template_memberships" edge.
+func HasTemplateMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TemplateMembershipsTable, TemplateMembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTemplateMembershipsWith applies the HasEdge predicate on the "template_memberships" edge with a given conditions (other predicates).
+func HasTemplateMembershipsWith(preds ...predicate.TemplateMember) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newTemplateMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationPreferences applies the HasEdge predicate on the "notification_preferences" edge.
+func HasNotificationPreferences() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationPreferencesWith applies the HasEdge predicate on the "notification_preferences" edge with a given conditions (other predicates).
+func HasNotificationPreferencesWith(preds ...predicate.NotificationPreference) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationPreferencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -6,8 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager-dashboard-go/ent/notification"
+	"project-manager-dashboard-go/ent/notificationpreference"
 	"project-manager-dashboard-go/ent/projectuser"
-	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/templatemember"
 	"project-manager-dashboard-go/ent/user"
 	"time"
 
@@ -49,6 +53,20 @@ func (_c *UserCreate) SetNillableCountry(v *string) *UserCreate {
 	return _c
 }
 
+// SetEmailMode sets the "email_mode" field.
+func (_c *UserCreate) SetEmailMode(v user.EmailMode) *UserCreate {
+	_c.mutation.SetEmailMode(v)
+	return _c
+}
+
+// SetNillableEmailMode sets the "email_mode" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEmailMode(v *user.EmailMode) *UserCreate {
+	if v != nil {
+		_c.SetEmailMode(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -77,19 +95,34 @@ func (_c *UserCreate) SetNillableID(v *uuid.UUID) *UserCreate {
 	return _c
 }
 
-// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
-func (_c *UserCreate) AddAssignedTaskIDs(ids ...uuid.UUID) *UserCreate {
-	_c.mutation.AddAssignedTaskIDs(ids...)
+// AddTaskAssignmentIDs adds the "task_assignments" edge to the TaskAssignee entity by IDs.
+func (_c *UserCreate) AddTaskAssignmentIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddTaskAssignmentIDs(ids...)
 	return _c
 }
 
-// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
-func (_c *UserCreate) AddAssignedTasks(v ...*Task) *UserCreate {
+// AddTaskAssignments adds the "task_assignments" edges to the TaskAssignee entity.
+func (_c *UserCreate) AddTaskAssignments(v ...*TaskAssignee) *UserCreate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _c.AddAssignedTaskIDs(ids...)
+	return _c.AddTaskAssignmentIDs(ids...)
+}
+
+// AddWatchedTaskIDs adds the "watched_tasks" edge to the TaskWatcher entity by IDs.
+func (_c *UserCreate) AddWatchedTaskIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddWatchedTaskIDs(ids...)
+	return _c
+}
+
+// AddWatchedTasks adds the "watched_tasks" edges to the TaskWatcher entity.
+func (_c *UserCreate) AddWatchedTasks(v ...*TaskWatcher) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWatchedTaskIDs(ids...)
 }
 
 // AddMembershipIDs adds the "memberships" edge to the ProjectUser entity by IDs.
@@ -107,6 +140,51 @@ func (_c *UserCreate) AddMemberships(v ...*ProjectUser) *UserCreate {
 	return _c.AddMembershipIDs(ids...)
 }
 
+// AddTemplateMembershipIDs adds the "template_memberships" edge to the TemplateMember entity by IDs.
+func (_c *UserCreate) AddTemplateMembershipIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddTemplateMembershipIDs(ids...)
+	return _c
+}
+
+// AddTemplateMemberships adds the "template_memberships" edges to the TemplateMember entity.
+func (_c *UserCreate) AddTemplateMemberships(v ...*TemplateMember) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTemplateMembershipIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_c *UserCreate) AddNotificationIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddNotificationIDs(ids...)
+	return _c
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_c *UserCreate) AddNotifications(v ...*Notification) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_c *UserCreate) AddNotificationPreferenceIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddNotificationPreferenceIDs(ids...)
+	return _c
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_c *UserCreate) AddNotificationPreferences(v ...*NotificationPreference) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationPreferenceIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -142,6 +220,10 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.EmailMode(); !ok {
+		v := user.DefaultEmailMode
+		_c.mutation.SetEmailMode(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -160,6 +242,14 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
 	}
+	if _, ok := _c.mutation.EmailMode(); !ok {
+		return &ValidationError{Name: "email_mode", err: errors.New(`ent: missing required field "User.email_mode"`)}
+	}
+	if v, ok := _c.mutation.EmailMode(); ok {
+		if err := user.EmailModeValidator(v); err != nil {
+			return &ValidationError{Name: "email_mode", err: fmt.Errorf(`ent: validator failed for field "User.email_mode": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -210,19 +300,39 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldCountry, field.TypeString, value)
 		_node.Country = value
 	}
+	if value, ok := _c.mutation.EmailMode(); ok {
+		_spec.SetField(user.FieldEmailMode, field.TypeEnum, value)
+		_node.EmailMode = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
-	if nodes := _c.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.TaskAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedTasksTable,
-			Columns: []string{user.AssignedTasksColumn},
+			Table:   user.TaskAssignmentsTable,
+			Columns: []string{user.TaskAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WatchedTasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchedTasksTable,
+			Columns: []string{user.WatchedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -246,6 +356,54 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TemplateMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TemplateMembershipsTable,
+			Columns: []string{user.TemplateMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
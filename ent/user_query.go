@@ -7,9 +7,13 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"project-manager-dashboard-go/ent/notification"
+	"project-manager-dashboard-go/ent/notificationpreference"
 	"project-manager-dashboard-go/ent/predicate"
 	"project-manager-dashboard-go/ent/projectuser"
-	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/templatemember"
 	"project-manager-dashboard-go/ent/user"
 
 	"entgo.io/ent"
@@ -22,12 +26,24 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx               *QueryContext
-	order             []user.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.User
-	withAssignedTasks *TaskQuery
-	withMemberships   *ProjectUserQuery
+	ctx                              *QueryContext
+	order                            []user.OrderOption
+	inters                           []Interceptor
+	predicates                       []predicate.User
+	withTaskAssignments              *TaskAssigneeQuery
+	withWatchedTasks                 *TaskWatcherQuery
+	withMemberships                  *ProjectUserQuery
+	withTemplateMemberships          *TemplateMemberQuery
+	withNotifications                *NotificationQuery
+	withNotificationPreferences      *NotificationPreferenceQuery
+	modifiers                        []func(*sql.Selector)
+	loadTotal                        []func(context.Context, []*User) error
+	withNamedTaskAssignments         map[string]*TaskAssigneeQuery
+	withNamedWatchedTasks            map[string]*TaskWatcherQuery
+	withNamedMemberships             map[string]*ProjectUserQuery
+	withNamedTemplateMemberships     map[string]*TemplateMemberQuery
+	withNamedNotifications           map[string]*NotificationQuery
+	withNamedNotificationPreferences map[string]*NotificationPreferenceQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -64,9 +80,9 @@ func (_q *UserQuery) Order(o ...user.OrderOption) *UserQuery {
 	return _q
 }
 
-// QueryAssignedTasks chains the current query on the "assigned_tasks" edge.
-func (_q *UserQuery) QueryAssignedTasks() *TaskQuery {
-	query := (&TaskClient{config: _q.config}).Query()
+// QueryTaskAssignments chains the current query on the "task_assignments" edge.
+func (_q *UserQuery) QueryTaskAssignments() *TaskAssigneeQuery {
+	query := (&TaskAssigneeClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -77,8 +93,30 @@ func (_q *UserQuery) QueryAssignedTasks() *TaskQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
-			sqlgraph.To(task.Table, task.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.AssignedTasksTable, user.AssignedTasksColumn),
+			sqlgraph.To(taskassignee.Table, taskassignee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TaskAssignmentsTable, user.TaskAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWatchedTasks chains the current query on the "watched_tasks" edge.
+func (_q *UserQuery) QueryWatchedTasks() *TaskWatcherQuery {
+	query := (&TaskWatcherClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(taskwatcher.Table, taskwatcher.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WatchedTasksTable, user.WatchedTasksColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -108,6 +146,72 @@ func (_q *UserQuery) QueryMemberships() *ProjectUserQuery {
 	return query
 }
 
+// QueryTemplateMemberships chains the current query on the "template_memberships" edge.
+func (_q *UserQuery) QueryTemplateMemberships() *TemplateMemberQuery {
+	query := (&TemplateMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(templatemember.Table, templatemember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TemplateMembershipsTable, user.TemplateMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotifications chains the current query on the "notifications" edge.
+func (_q *UserQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotificationPreferences chains the current query on the "notification_preferences" edge.
+func (_q *UserQuery) QueryNotificationPreferences() *NotificationPreferenceQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notificationpreference.Table, notificationpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationPreferencesTable, user.NotificationPreferencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -295,27 +399,42 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:            _q.config,
-		ctx:               _q.ctx.Clone(),
-		order:             append([]user.OrderOption{}, _q.order...),
-		inters:            append([]Interceptor{}, _q.inters...),
-		predicates:        append([]predicate.User{}, _q.predicates...),
-		withAssignedTasks: _q.withAssignedTasks.Clone(),
-		withMemberships:   _q.withMemberships.Clone(),
+		config:                      _q.config,
+		ctx:                         _q.ctx.Clone(),
+		order:                       append([]user.OrderOption{}, _q.order...),
+		inters:                      append([]Interceptor{}, _q.inters...),
+		predicates:                  append([]predicate.User{}, _q.predicates...),
+		withTaskAssignments:         _q.withTaskAssignments.Clone(),
+		withWatchedTasks:            _q.withWatchedTasks.Clone(),
+		withMemberships:             _q.withMemberships.Clone(),
+		withTemplateMemberships:     _q.withTemplateMemberships.Clone(),
+		withNotifications:           _q.withNotifications.Clone(),
+		withNotificationPreferences: _q.withNotificationPreferences.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
-// WithAssignedTasks tells the query-builder to eager-load the nodes that are connected to
-// the "assigned_tasks" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *UserQuery) WithAssignedTasks(opts ...func(*TaskQuery)) *UserQuery {
-	query := (&TaskClient{config: _q.config}).Query()
+// WithTaskAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "task_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTaskAssignments(opts ...func(*TaskAssigneeQuery)) *UserQuery {
+	query := (&TaskAssigneeClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withAssignedTasks = query
+	_q.withTaskAssignments = query
+	return _q
+}
+
+// WithWatchedTasks tells the query-builder to eager-load the nodes that are connected to
+// the "watched_tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithWatchedTasks(opts ...func(*TaskWatcherQuery)) *UserQuery {
+	query := (&TaskWatcherClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWatchedTasks = query
 	return _q
 }
 
@@ -330,6 +449,39 @@ func (_q *UserQuery) WithMemberships(opts ...func(*ProjectUserQuery)) *UserQuery
 	return _q
 }
 
+// WithTemplateMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "template_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTemplateMemberships(opts ...func(*TemplateMemberQuery)) *UserQuery {
+	query := (&TemplateMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTemplateMemberships = query
+	return _q
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotifications = query
+	return _q
+}
+
+// WithNotificationPreferences tells the query-builder to eager-load the nodes that are connected to
+// the "notification_preferences" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNotificationPreferences(opts ...func(*NotificationPreferenceQuery)) *UserQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotificationPreferences = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +560,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
-			_q.withAssignedTasks != nil,
+		loadedTypes = [6]bool{
+			_q.withTaskAssignments != nil,
+			_q.withWatchedTasks != nil,
 			_q.withMemberships != nil,
+			_q.withTemplateMemberships != nil,
+			_q.withNotifications != nil,
+			_q.withNotificationPreferences != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -422,6 +578,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -431,10 +590,17 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := _q.withAssignedTasks; query != nil {
-		if err := _q.loadAssignedTasks(ctx, query, nodes,
-			func(n *User) { n.Edges.AssignedTasks = []*Task{} },
-			func(n *User, e *Task) { n.Edges.AssignedTasks = append(n.Edges.AssignedTasks, e) }); err != nil {
+	if query := _q.withTaskAssignments; query != nil {
+		if err := _q.loadTaskAssignments(ctx, query, nodes,
+			func(n *User) { n.Edges.TaskAssignments = []*TaskAssignee{} },
+			func(n *User, e *TaskAssignee) { n.Edges.TaskAssignments = append(n.Edges.TaskAssignments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWatchedTasks; query != nil {
+		if err := _q.loadWatchedTasks(ctx, query, nodes,
+			func(n *User) { n.Edges.WatchedTasks = []*TaskWatcher{} },
+			func(n *User, e *TaskWatcher) { n.Edges.WatchedTasks = append(n.Edges.WatchedTasks, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -445,10 +611,80 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withTemplateMemberships; query != nil {
+		if err := _q.loadTemplateMemberships(ctx, query, nodes,
+			func(n *User) { n.Edges.TemplateMemberships = []*TemplateMember{} },
+			func(n *User, e *TemplateMember) { n.Edges.TemplateMemberships = append(n.Edges.TemplateMemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotifications; query != nil {
+		if err := _q.loadNotifications(ctx, query, nodes,
+			func(n *User) { n.Edges.Notifications = []*Notification{} },
+			func(n *User, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotificationPreferences; query != nil {
+		if err := _q.loadNotificationPreferences(ctx, query, nodes,
+			func(n *User) { n.Edges.NotificationPreferences = []*NotificationPreference{} },
+			func(n *User, e *NotificationPreference) {
+				n.Edges.NotificationPreferences = append(n.Edges.NotificationPreferences, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedTaskAssignments {
+		if err := _q.loadTaskAssignments(ctx, query, nodes,
+			func(n *User) { n.appendNamedTaskAssignments(name) },
+			func(n *User, e *TaskAssignee) { n.appendNamedTaskAssignments(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedWatchedTasks {
+		if err := _q.loadWatchedTasks(ctx, query, nodes,
+			func(n *User) { n.appendNamedWatchedTasks(name) },
+			func(n *User, e *TaskWatcher) { n.appendNamedWatchedTasks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedMemberships {
+		if err := _q.loadMemberships(ctx, query, nodes,
+			func(n *User) { n.appendNamedMemberships(name) },
+			func(n *User, e *ProjectUser) { n.appendNamedMemberships(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedTemplateMemberships {
+		if err := _q.loadTemplateMemberships(ctx, query, nodes,
+			func(n *User) { n.appendNamedTemplateMemberships(name) },
+			func(n *User, e *TemplateMember) { n.appendNamedTemplateMemberships(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedNotifications {
+		if err := _q.loadNotifications(ctx, query, nodes,
+			func(n *User) { n.appendNamedNotifications(name) },
+			func(n *User, e *Notification) { n.appendNamedNotifications(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedNotificationPreferences {
+		if err := _q.loadNotificationPreferences(ctx, query, nodes,
+			func(n *User) { n.appendNamedNotificationPreferences(name) },
+			func(n *User, e *NotificationPreference) { n.appendNamedNotificationPreferences(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
-func (_q *UserQuery) loadAssignedTasks(ctx context.Context, query *TaskQuery, nodes []*User, init func(*User), assign func(*User, *Task)) error {
+func (_q *UserQuery) loadTaskAssignments(ctx context.Context, query *TaskAssigneeQuery, nodes []*User, init func(*User), assign func(*User, *TaskAssignee)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
 	for i := range nodes {
@@ -458,24 +694,53 @@ func (_q *UserQuery) loadAssignedTasks(ctx context.Context, query *TaskQuery, no
 			init(nodes[i])
 		}
 	}
-	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(task.FieldAssigneeID)
+	query.withFKs = true
+	query.Where(predicate.TaskAssignee(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TaskAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_task_assignments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_task_assignments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_task_assignments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadWatchedTasks(ctx context.Context, query *TaskWatcherQuery, nodes []*User, init func(*User), assign func(*User, *TaskWatcher)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
-	query.Where(predicate.Task(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.AssignedTasksColumn), fks...))
+	query.withFKs = true
+	query.Where(predicate.TaskWatcher(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WatchedTasksColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.AssigneeID
+		fk := n.user_watched_tasks
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "assignee_id" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "user_watched_tasks" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "assignee_id" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_watched_tasks" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -512,9 +777,103 @@ func (_q *UserQuery) loadMemberships(ctx context.Context, query *ProjectUserQuer
 	}
 	return nil
 }
+func (_q *UserQuery) loadTemplateMemberships(ctx context.Context, query *TemplateMemberQuery, nodes []*User, init func(*User), assign func(*User, *TemplateMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TemplateMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TemplateMembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_template_memberships
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_template_memberships" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_template_memberships" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*User, init func(*User), assign func(*User, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldUserID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadNotificationPreferences(ctx context.Context, query *NotificationPreferenceQuery, nodes []*User, init func(*User), assign func(*User, *NotificationPreference)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notificationpreference.FieldUserID)
+	}
+	query.Where(predicate.NotificationPreference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationPreferencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -594,6 +953,90 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedTaskAssignments tells the query-builder to eager-load the nodes that are connected to the "task_assignments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedTaskAssignments(name string, opts ...func(*TaskAssigneeQuery)) *UserQuery {
+	query := (&TaskAssigneeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedTaskAssignments == nil {
+		_q.withNamedTaskAssignments = make(map[string]*TaskAssigneeQuery)
+	}
+	_q.withNamedTaskAssignments[name] = query
+	return _q
+}
+
+// WithNamedWatchedTasks tells the query-builder to eager-load the nodes that are connected to the "watched_tasks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedWatchedTasks(name string, opts ...func(*TaskWatcherQuery)) *UserQuery {
+	query := (&TaskWatcherClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedWatchedTasks == nil {
+		_q.withNamedWatchedTasks = make(map[string]*TaskWatcherQuery)
+	}
+	_q.withNamedWatchedTasks[name] = query
+	return _q
+}
+
+// WithNamedMemberships tells the query-builder to eager-load the nodes that are connected to the "memberships"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedMemberships(name string, opts ...func(*ProjectUserQuery)) *UserQuery {
+	query := (&ProjectUserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedMemberships == nil {
+		_q.withNamedMemberships = make(map[string]*ProjectUserQuery)
+	}
+	_q.withNamedMemberships[name] = query
+	return _q
+}
+
+// WithNamedTemplateMemberships tells the query-builder to eager-load the nodes that are connected to the "template_memberships"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedTemplateMemberships(name string, opts ...func(*TemplateMemberQuery)) *UserQuery {
+	query := (&TemplateMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedTemplateMemberships == nil {
+		_q.withNamedTemplateMemberships = make(map[string]*TemplateMemberQuery)
+	}
+	_q.withNamedTemplateMemberships[name] = query
+	return _q
+}
+
+// WithNamedNotifications tells the query-builder to eager-load the nodes that are connected to the "notifications"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedNotifications(name string, opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedNotifications == nil {
+		_q.withNamedNotifications = make(map[string]*NotificationQuery)
+	}
+	_q.withNamedNotifications[name] = query
+	return _q
+}
+
+// WithNamedNotificationPreferences tells the query-builder to eager-load the nodes that are connected to the "notification_preferences"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedNotificationPreferences(name string, opts ...func(*NotificationPreferenceQuery)) *UserQuery {
+	query := (&NotificationPreferenceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedNotificationPreferences == nil {
+		_q.withNamedNotificationPreferences = make(map[string]*NotificationPreferenceQuery)
+	}
+	_q.withNamedNotificationPreferences[name] = query
+	return _q
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -6,9 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"project-manager-dashboard-go/ent/notification"
+	"project-manager-dashboard-go/ent/notificationpreference"
 	"project-manager-dashboard-go/ent/predicate"
 	"project-manager-dashboard-go/ent/projectuser"
-	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/templatemember"
 	"project-manager-dashboard-go/ent/user"
 	"time"
 
@@ -79,6 +83,20 @@ func (_u *UserUpdate) ClearCountry() *UserUpdate {
 	return _u
 }
 
+// SetEmailMode sets the "email_mode" field.
+func (_u *UserUpdate) SetEmailMode(v user.EmailMode) *UserUpdate {
+	_u.mutation.SetEmailMode(v)
+	return _u
+}
+
+// SetNillableEmailMode sets the "email_mode" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmailMode(v *user.EmailMode) *UserUpdate {
+	if v != nil {
+		_u.SetEmailMode(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserUpdate) SetCreatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -93,19 +111,34 @@ func (_u *UserUpdate) SetNillableCreatedAt(v *time.Time) *UserUpdate {
 	return _u
 }
 
-// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
-func (_u *UserUpdate) AddAssignedTaskIDs(ids ...uuid.UUID) *UserUpdate {
-	_u.mutation.AddAssignedTaskIDs(ids...)
+// AddTaskAssignmentIDs adds the "task_assignments" edge to the TaskAssignee entity by IDs.
+func (_u *UserUpdate) AddTaskAssignmentIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddTaskAssignmentIDs(ids...)
 	return _u
 }
 
-// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
-func (_u *UserUpdate) AddAssignedTasks(v ...*Task) *UserUpdate {
+// AddTaskAssignments adds the "task_assignments" edges to the TaskAssignee entity.
+func (_u *UserUpdate) AddTaskAssignments(v ...*TaskAssignee) *UserUpdate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddAssignedTaskIDs(ids...)
+	return _u.AddTaskAssignmentIDs(ids...)
+}
+
+// AddWatchedTaskIDs adds the "watched_tasks" edge to the TaskWatcher entity by IDs.
+func (_u *UserUpdate) AddWatchedTaskIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddWatchedTaskIDs(ids...)
+	return _u
+}
+
+// AddWatchedTasks adds the "watched_tasks" edges to the TaskWatcher entity.
+func (_u *UserUpdate) AddWatchedTasks(v ...*TaskWatcher) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWatchedTaskIDs(ids...)
 }
 
 // AddMembershipIDs adds the "memberships" edge to the ProjectUser entity by IDs.
@@ -123,30 +156,96 @@ func (_u *UserUpdate) AddMemberships(v ...*ProjectUser) *UserUpdate {
 	return _u.AddMembershipIDs(ids...)
 }
 
+// AddTemplateMembershipIDs adds the "template_memberships" edge to the TemplateMember entity by IDs.
+func (_u *UserUpdate) AddTemplateMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddTemplateMembershipIDs(ids...)
+	return _u
+}
+
+// AddTemplateMemberships adds the "template_memberships" edges to the TemplateMember entity.
+func (_u *UserUpdate) AddTemplateMemberships(v ...*TemplateMember) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTemplateMembershipIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *UserUpdate) AddNotificationIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *UserUpdate) AddNotifications(v ...*Notification) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_u *UserUpdate) AddNotificationPreferenceIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_u *UserUpdate) AddNotificationPreferences(v ...*NotificationPreference) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationPreferenceIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
 }
 
-// ClearAssignedTasks clears all "assigned_tasks" edges to the Task entity.
-func (_u *UserUpdate) ClearAssignedTasks() *UserUpdate {
-	_u.mutation.ClearAssignedTasks()
+// ClearTaskAssignments clears all "task_assignments" edges to the TaskAssignee entity.
+func (_u *UserUpdate) ClearTaskAssignments() *UserUpdate {
+	_u.mutation.ClearTaskAssignments()
 	return _u
 }
 
-// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to Task entities by IDs.
-func (_u *UserUpdate) RemoveAssignedTaskIDs(ids ...uuid.UUID) *UserUpdate {
-	_u.mutation.RemoveAssignedTaskIDs(ids...)
+// RemoveTaskAssignmentIDs removes the "task_assignments" edge to TaskAssignee entities by IDs.
+func (_u *UserUpdate) RemoveTaskAssignmentIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveTaskAssignmentIDs(ids...)
 	return _u
 }
 
-// RemoveAssignedTasks removes "assigned_tasks" edges to Task entities.
-func (_u *UserUpdate) RemoveAssignedTasks(v ...*Task) *UserUpdate {
+// RemoveTaskAssignments removes "task_assignments" edges to TaskAssignee entities.
+func (_u *UserUpdate) RemoveTaskAssignments(v ...*TaskAssignee) *UserUpdate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAssignedTaskIDs(ids...)
+	return _u.RemoveTaskAssignmentIDs(ids...)
+}
+
+// ClearWatchedTasks clears all "watched_tasks" edges to the TaskWatcher entity.
+func (_u *UserUpdate) ClearWatchedTasks() *UserUpdate {
+	_u.mutation.ClearWatchedTasks()
+	return _u
+}
+
+// RemoveWatchedTaskIDs removes the "watched_tasks" edge to TaskWatcher entities by IDs.
+func (_u *UserUpdate) RemoveWatchedTaskIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveWatchedTaskIDs(ids...)
+	return _u
+}
+
+// RemoveWatchedTasks removes "watched_tasks" edges to TaskWatcher entities.
+func (_u *UserUpdate) RemoveWatchedTasks(v ...*TaskWatcher) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWatchedTaskIDs(ids...)
 }
 
 // ClearMemberships clears all "memberships" edges to the ProjectUser entity.
@@ -170,6 +269,69 @@ func (_u *UserUpdate) RemoveMemberships(v ...*ProjectUser) *UserUpdate {
 	return _u.RemoveMembershipIDs(ids...)
 }
 
+// ClearTemplateMemberships clears all "template_memberships" edges to the TemplateMember entity.
+func (_u *UserUpdate) ClearTemplateMemberships() *UserUpdate {
+	_u.mutation.ClearTemplateMemberships()
+	return _u
+}
+
+// RemoveTemplateMembershipIDs removes the "template_memberships" edge to TemplateMember entities by IDs.
+func (_u *UserUpdate) RemoveTemplateMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveTemplateMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveTemplateMemberships removes "template_memberships" edges to TemplateMember entities.
+func (_u *UserUpdate) RemoveTemplateMemberships(v ...*TemplateMember) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTemplateMembershipIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *UserUpdate) ClearNotifications() *UserUpdate {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *UserUpdate) RemoveNotificationIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *UserUpdate) RemoveNotifications(v ...*Notification) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// ClearNotificationPreferences clears all "notification_preferences" edges to the NotificationPreference entity.
+func (_u *UserUpdate) ClearNotificationPreferences() *UserUpdate {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to NotificationPreference entities by IDs.
+func (_u *UserUpdate) RemoveNotificationPreferenceIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to NotificationPreference entities.
+func (_u *UserUpdate) RemoveNotificationPreferences(v ...*NotificationPreference) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -197,7 +359,20 @@ func (_u *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUpdate) check() error {
+	if v, ok := _u.mutation.EmailMode(); ok {
+		if err := user.EmailModeValidator(v); err != nil {
+			return &ValidationError{Name: "email_mode", err: fmt.Errorf(`ent: validator failed for field "User.email_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -218,31 +393,79 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CountryCleared() {
 		_spec.ClearField(user.FieldCountry, field.TypeString)
 	}
+	if value, ok := _u.mutation.EmailMode(); ok {
+		_spec.SetField(user.FieldEmailMode, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
-	if _u.mutation.AssignedTasksCleared() {
+	if _u.mutation.TaskAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TaskAssignmentsTable,
+			Columns: []string{user.TaskAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTaskAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.TaskAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TaskAssignmentsTable,
+			Columns: []string{user.TaskAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedTasksTable,
-			Columns: []string{user.AssignedTasksColumn},
+			Table:   user.TaskAssignmentsTable,
+			Columns: []string{user.TaskAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WatchedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchedTasksTable,
+			Columns: []string{user.WatchedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssignedTasksIDs(); len(nodes) > 0 && !_u.mutation.AssignedTasksCleared() {
+	if nodes := _u.mutation.RemovedWatchedTasksIDs(); len(nodes) > 0 && !_u.mutation.WatchedTasksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedTasksTable,
-			Columns: []string{user.AssignedTasksColumn},
+			Table:   user.WatchedTasksTable,
+			Columns: []string{user.WatchedTasksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -250,15 +473,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WatchedTasksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedTasksTable,
-			Columns: []string{user.AssignedTasksColumn},
+			Table:   user.WatchedTasksTable,
+			Columns: []string{user.WatchedTasksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -311,6 +534,141 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TemplateMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TemplateMembershipsTable,
+			Columns: []string{user.TemplateMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTemplateMembershipsIDs(); len(nodes) > 0 && !_u.mutation.TemplateMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TemplateMembershipsTable,
+			Columns: []string{user.TemplateMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TemplateMembershipsTable,
+			Columns: []string{user.TemplateMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -379,6 +737,20 @@ func (_u *UserUpdateOne) ClearCountry() *UserUpdateOne {
 	return _u
 }
 
+// SetEmailMode sets the "email_mode" field.
+func (_u *UserUpdateOne) SetEmailMode(v user.EmailMode) *UserUpdateOne {
+	_u.mutation.SetEmailMode(v)
+	return _u
+}
+
+// SetNillableEmailMode sets the "email_mode" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailMode(v *user.EmailMode) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailMode(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *UserUpdateOne) SetCreatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -393,19 +765,34 @@ func (_u *UserUpdateOne) SetNillableCreatedAt(v *time.Time) *UserUpdateOne {
 	return _u
 }
 
-// AddAssignedTaskIDs adds the "assigned_tasks" edge to the Task entity by IDs.
-func (_u *UserUpdateOne) AddAssignedTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.AddAssignedTaskIDs(ids...)
+// AddTaskAssignmentIDs adds the "task_assignments" edge to the TaskAssignee entity by IDs.
+func (_u *UserUpdateOne) AddTaskAssignmentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddTaskAssignmentIDs(ids...)
 	return _u
 }
 
-// AddAssignedTasks adds the "assigned_tasks" edges to the Task entity.
-func (_u *UserUpdateOne) AddAssignedTasks(v ...*Task) *UserUpdateOne {
+// AddTaskAssignments adds the "task_assignments" edges to the TaskAssignee entity.
+func (_u *UserUpdateOne) AddTaskAssignments(v ...*TaskAssignee) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddAssignedTaskIDs(ids...)
+	return _u.AddTaskAssignmentIDs(ids...)
+}
+
+// AddWatchedTaskIDs adds the "watched_tasks" edge to the TaskWatcher entity by IDs.
+func (_u *UserUpdateOne) AddWatchedTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddWatchedTaskIDs(ids...)
+	return _u
+}
+
+// AddWatchedTasks adds the "watched_tasks" edges to the TaskWatcher entity.
+func (_u *UserUpdateOne) AddWatchedTasks(v ...*TaskWatcher) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWatchedTaskIDs(ids...)
 }
 
 // AddMembershipIDs adds the "memberships" edge to the ProjectUser entity by IDs.
@@ -423,30 +810,96 @@ func (_u *UserUpdateOne) AddMemberships(v ...*ProjectUser) *UserUpdateOne {
 	return _u.AddMembershipIDs(ids...)
 }
 
+// AddTemplateMembershipIDs adds the "template_memberships" edge to the TemplateMember entity by IDs.
+func (_u *UserUpdateOne) AddTemplateMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddTemplateMembershipIDs(ids...)
+	return _u
+}
+
+// AddTemplateMemberships adds the "template_memberships" edges to the TemplateMember entity.
+func (_u *UserUpdateOne) AddTemplateMemberships(v ...*TemplateMember) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTemplateMembershipIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *UserUpdateOne) AddNotificationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *UserUpdateOne) AddNotifications(v ...*Notification) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_u *UserUpdateOne) AddNotificationPreferenceIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_u *UserUpdateOne) AddNotificationPreferences(v ...*NotificationPreference) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationPreferenceIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
 }
 
-// ClearAssignedTasks clears all "assigned_tasks" edges to the Task entity.
-func (_u *UserUpdateOne) ClearAssignedTasks() *UserUpdateOne {
-	_u.mutation.ClearAssignedTasks()
+// ClearTaskAssignments clears all "task_assignments" edges to the TaskAssignee entity.
+func (_u *UserUpdateOne) ClearTaskAssignments() *UserUpdateOne {
+	_u.mutation.ClearTaskAssignments()
 	return _u
 }
 
-// RemoveAssignedTaskIDs removes the "assigned_tasks" edge to Task entities by IDs.
-func (_u *UserUpdateOne) RemoveAssignedTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveAssignedTaskIDs(ids...)
+// RemoveTaskAssignmentIDs removes the "task_assignments" edge to TaskAssignee entities by IDs.
+func (_u *UserUpdateOne) RemoveTaskAssignmentIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveTaskAssignmentIDs(ids...)
 	return _u
 }
 
-// RemoveAssignedTasks removes "assigned_tasks" edges to Task entities.
-func (_u *UserUpdateOne) RemoveAssignedTasks(v ...*Task) *UserUpdateOne {
+// RemoveTaskAssignments removes "task_assignments" edges to TaskAssignee entities.
+func (_u *UserUpdateOne) RemoveTaskAssignments(v ...*TaskAssignee) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAssignedTaskIDs(ids...)
+	return _u.RemoveTaskAssignmentIDs(ids...)
+}
+
+// ClearWatchedTasks clears all "watched_tasks" edges to the TaskWatcher entity.
+func (_u *UserUpdateOne) ClearWatchedTasks() *UserUpdateOne {
+	_u.mutation.ClearWatchedTasks()
+	return _u
+}
+
+// RemoveWatchedTaskIDs removes the "watched_tasks" edge to TaskWatcher entities by IDs.
+func (_u *UserUpdateOne) RemoveWatchedTaskIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveWatchedTaskIDs(ids...)
+	return _u
+}
+
+// RemoveWatchedTasks removes "watched_tasks" edges to TaskWatcher entities.
+func (_u *UserUpdateOne) RemoveWatchedTasks(v ...*TaskWatcher) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWatchedTaskIDs(ids...)
 }
 
 // ClearMemberships clears all "memberships" edges to the ProjectUser entity.
@@ -470,6 +923,69 @@ func (_u *UserUpdateOne) RemoveMemberships(v ...*ProjectUser) *UserUpdateOne {
 	return _u.RemoveMembershipIDs(ids...)
 }
 
+// ClearTemplateMemberships clears all "template_memberships" edges to the TemplateMember entity.
+func (_u *UserUpdateOne) ClearTemplateMemberships() *UserUpdateOne {
+	_u.mutation.ClearTemplateMemberships()
+	return _u
+}
+
+// RemoveTemplateMembershipIDs removes the "template_memberships" edge to TemplateMember entities by IDs.
+func (_u *UserUpdateOne) RemoveTemplateMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveTemplateMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveTemplateMemberships removes "template_memberships" edges to TemplateMember entities.
+func (_u *UserUpdateOne) RemoveTemplateMemberships(v ...*TemplateMember) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTemplateMembershipIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *UserUpdateOne) ClearNotifications() *UserUpdateOne {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *UserUpdateOne) RemoveNotificationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *UserUpdateOne) RemoveNotifications(v ...*Notification) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// ClearNotificationPreferences clears all "notification_preferences" edges to the NotificationPreference entity.
+func (_u *UserUpdateOne) ClearNotificationPreferences() *UserUpdateOne {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to NotificationPreference entities by IDs.
+func (_u *UserUpdateOne) RemoveNotificationPreferenceIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to NotificationPreference entities.
+func (_u *UserUpdateOne) RemoveNotificationPreferences(v ...*NotificationPreference) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -510,7 +1026,20 @@ func (_u *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUpdateOne) check() error {
+	if v, ok := _u.mutation.EmailMode(); ok {
+		if err := user.EmailModeValidator(v); err != nil {
+			return &ValidationError{Name: "email_mode", err: fmt.Errorf(`ent: validator failed for field "User.email_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -548,31 +1077,79 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.CountryCleared() {
 		_spec.ClearField(user.FieldCountry, field.TypeString)
 	}
+	if value, ok := _u.mutation.EmailMode(); ok {
+		_spec.SetField(user.FieldEmailMode, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 	}
-	if _u.mutation.AssignedTasksCleared() {
+	if _u.mutation.TaskAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TaskAssignmentsTable,
+			Columns: []string{user.TaskAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTaskAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.TaskAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TaskAssignmentsTable,
+			Columns: []string{user.TaskAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedTasksTable,
-			Columns: []string{user.AssignedTasksColumn},
+			Table:   user.TaskAssignmentsTable,
+			Columns: []string{user.TaskAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskassignee.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WatchedTasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchedTasksTable,
+			Columns: []string{user.WatchedTasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedAssignedTasksIDs(); len(nodes) > 0 && !_u.mutation.AssignedTasksCleared() {
+	if nodes := _u.mutation.RemovedWatchedTasksIDs(); len(nodes) > 0 && !_u.mutation.WatchedTasksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedTasksTable,
-			Columns: []string{user.AssignedTasksColumn},
+			Table:   user.WatchedTasksTable,
+			Columns: []string{user.WatchedTasksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -580,15 +1157,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AssignedTasksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WatchedTasksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.AssignedTasksTable,
-			Columns: []string{user.AssignedTasksColumn},
+			Table:   user.WatchedTasksTable,
+			Columns: []string{user.WatchedTasksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -641,6 +1218,141 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TemplateMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TemplateMembershipsTable,
+			Columns: []string{user.TemplateMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTemplateMembershipsIDs(); len(nodes) > 0 && !_u.mutation.TemplateMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TemplateMembershipsTable,
+			Columns: []string{user.TemplateMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TemplateMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TemplateMembershipsTable,
+			Columns: []string{user.TemplateMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templatemember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -4,6 +4,9 @@ import (
 	"database/sql"
 
 	"project-manager-dashboard-go/ent"
+	// Дефолты полей и интерсепторы (soft delete) подключаются через пакет runtime.
+	_ "project-manager-dashboard-go/ent/runtime"
+	"project-manager-dashboard-go/internal/app/usecase/audit"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
@@ -27,6 +30,7 @@ func New(databaseURL string) (*App, error) {
 
 	drv := entsql.OpenDB(dialect.Postgres, db)
 	client := ent.NewClient(ent.Driver(drv))
+	audit.Register(client)
 
 	return &App{Ent: client, DB: db}, nil
 }
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+)
+
+// MigrateData выполняет миграции данных, которые не покрывает
+// автоматическая миграция схемы ent. Вызывается после Schema.Create.
+func MigrateData(ctx context.Context, db *sql.DB) error {
+	if err := migrateLegacyAssignees(ctx, db); err != nil {
+		return err
+	}
+	if err := dropGlobalIdempotencyIndex(ctx, db); err != nil {
+		return err
+	}
+	return backfillPublishedSeq(ctx, db)
+}
+
+// backfillPublishedSeq нумерует события, опубликованные до появления
+// published_seq, их номерами вставки: так номера, уже выданные клиентам
+// доски как Last-Event-ID, остаются действительными.
+func backfillPublishedSeq(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE outbox_events SET published_seq = id
+		WHERE published_at IS NOT NULL AND published_seq IS NULL`)
+	return err
+}
+
+// dropGlobalIdempotencyIndex удаляет прежний уникальный индекс по одному
+// key: ключи идемпотентности теперь уникальны в пределах автора (scope,
+// key), а автомиграция ent старые индексы не удаляет.
+func dropGlobalIdempotencyIndex(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `DROP INDEX IF EXISTS idempotencykey_key`)
+	return err
+}
+
+// migrateLegacyAssignees переносит единственного исполнителя из
+// tasks.assignee_id в таблицу task_assignees и удаляет старую колонку.
+// Повторный запуск ничего не делает: колонки уже нет.
+func migrateLegacyAssignees(ctx context.Context, db *sql.DB) (err error) {
+	var exists bool
+	err = db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = current_schema()
+			  AND table_name = 'tasks'
+			  AND column_name = 'assignee_id'
+		)`).Scan(&exists)
+	if err != nil || !exists {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO task_assignees (id, created_at, task_assignees, user_task_assignments)
+		SELECT gen_random_uuid(), t.updated_at, t.id, t.assignee_id
+		FROM tasks t
+		WHERE t.assignee_id IS NOT NULL
+		ON CONFLICT DO NOTHING`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `ALTER TABLE tasks DROP COLUMN assignee_id`)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
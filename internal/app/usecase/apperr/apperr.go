@@ -0,0 +1,54 @@
+package apperr
+
+import "errors"
+
+// Kind — класс доменной ошибки. Транспорт переводит его в свой код
+// (HTTP-статус, gRPC-код), не зная об отдельных ошибках пакетов.
+type Kind uint8
+
+const (
+	// KindInvalid — некорректные входные данные.
+	KindInvalid Kind = iota + 1
+	// KindNotFound — сущность не найдена.
+	KindNotFound
+	// KindForbidden — у автора нет прав на операцию.
+	KindForbidden
+	// KindConflict — текущее состояние не допускает операцию.
+	KindConflict
+	// KindPrecondition — не совпала ожидаемая версия.
+	KindPrecondition
+	// KindUnprocessable — запрос понятен, но противоречит ранее принятому.
+	KindUnprocessable
+)
+
+// Error — доменная ошибка use case. Пакеты объявляют их сентинелами в
+// errors.go, поэтому errors.Is продолжает работать. Code — стабильный
+// машинный код для клиентов (project_not_found), Message — текст для
+// человека.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func Invalid(code, msg string) *Error   { return &Error{KindInvalid, code, msg} }
+func NotFound(code, msg string) *Error  { return &Error{KindNotFound, code, msg} }
+func Forbidden(code, msg string) *Error { return &Error{KindForbidden, code, msg} }
+func Conflict(code, msg string) *Error  { return &Error{KindConflict, code, msg} }
+
+func Precondition(code, msg string) *Error {
+	return &Error{KindPrecondition, code, msg}
+}
+
+func Unprocessable(code, msg string) *Error {
+	return &Error{KindUnprocessable, code, msg}
+}
+
+// As достаёт доменную ошибку из цепочки err.
+func As(err error) (*Error, bool) {
+	var e *Error
+	ok := errors.As(err, &e)
+	return e, ok
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type UseCase struct {
+	repo AuditRepository
+}
+
+func NewAuditUsecase(repo AuditRepository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+func (uc *UseCase) ListByProject(ctx context.Context, projectID uuid.UUID, f Filter, p page.Params) (page.Page[EventDTO], error) {
+	if err := validateFilter(f); err != nil {
+		return page.Page[EventDTO]{}, err
+	}
+	p = p.Normalize()
+	return uc.repo.ListByProject(ctx, projectID, f, p)
+}
+
+func (uc *UseCase) ListByEntity(ctx context.Context, entityType string, entityID uuid.UUID, f Filter, p page.Params) (page.Page[EventDTO], error) {
+	switch entityType {
+	case EntityProject, EntityTask, EntityProjectUser, EntityProjectTask:
+	default:
+		return page.Page[EventDTO]{}, ErrUnknownEntityType
+	}
+	if err := validateFilter(f); err != nil {
+		return page.Page[EventDTO]{}, err
+	}
+	p = p.Normalize()
+	return uc.repo.ListByEntity(ctx, entityType, entityID, f, p)
+}
+
+func validateFilter(f Filter) error {
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return ErrInvalidRange
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ctxKey int
+
+const (
+	actorKey ctxKey = iota
+	requestIDKey
+)
+
+// WithActor запоминает в контексте пользователя, от имени которого
+// выполняются изменения. Хук журнала берёт actor отсюда.
+func WithActor(ctx context.Context, actorID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorKey, actorID)
+}
+
+func ActorFrom(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(actorKey).(uuid.UUID)
+	return id, ok
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+func RequestIDFrom(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey).(string)
+	return id, ok && id != ""
+}
@@ -0,0 +1,8 @@
+package audit
+
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
+
+var (
+	ErrUnknownEntityType = apperr.Invalid("unknown_entity_type", "unknown entity type")
+	ErrInvalidRange      = apperr.Invalid("invalid_range", "from must be before to")
+)
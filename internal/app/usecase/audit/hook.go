@@ -0,0 +1,315 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/auditevent"
+	"project-manager-dashboard-go/ent/hook"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/projectuser"
+	"project-manager-dashboard-go/ent/schema"
+	"project-manager-dashboard-go/ent/task"
+)
+
+// Типы сущностей в журнале.
+const (
+	EntityProject     = "project"
+	EntityTask        = "task"
+	EntityProjectUser = "project_user"
+	EntityProjectTask = "project_task"
+)
+
+var entityTypes = map[string]string{
+	ent.TypeProject:     EntityProject,
+	ent.TypeTask:        EntityTask,
+	ent.TypeProjectUser: EntityProjectUser,
+	ent.TypeProjectTask: EntityProjectTask,
+}
+
+// auditedMutation — общая часть сгенерированных мутаций, которая нужна хуку.
+type auditedMutation interface {
+	ent.Mutation
+	ID() (uuid.UUID, bool)
+	IDs(ctx context.Context) ([]uuid.UUID, error)
+	Client() *ent.Client
+}
+
+// Register подключает запись журнала ко всем мутациям Project, Task,
+// ProjectUser и ProjectTask. События пишутся тем же клиентом, что и
+// мутация, поэтому внутри транзакции попадают в неё же.
+func Register(c *ent.Client) {
+	h := hook.On(Hook(), ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+	c.Project.Use(h)
+	c.Task.Use(h)
+	c.ProjectUser.Use(h)
+	c.ProjectTask.Use(h)
+}
+
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			am, ok := m.(auditedMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			entityType, ok := entityTypes[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			// Поисковые запросы хука должны видеть и удалённые в корзину записи.
+			lookupCtx := schema.SkipSoftDelete(ctx)
+			c := am.Client()
+
+			var (
+				ids      []uuid.UUID
+				changes  map[string]any
+				projects map[uuid.UUID]*uuid.UUID
+				err      error
+			)
+
+			// Всё, что пропадёт или изменится после мутации, собираем заранее.
+			if !m.Op().Is(ent.OpCreate) {
+				ids, err = am.IDs(lookupCtx)
+				if err != nil {
+					return nil, fmt.Errorf("audit: collect ids: %w", err)
+				}
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+				changes, err = collectChanges(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+				if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					projects = resolveProjects(lookupCtx, c, m, ids)
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				id, ok := am.ID()
+				if !ok {
+					return v, nil
+				}
+				ids = []uuid.UUID{id}
+				changes, err = collectChanges(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if projects == nil {
+				projects = resolveProjects(lookupCtx, c, m, ids)
+			}
+
+			if err := record(ctx, c, m, entityType, ids, changes, projects); err != nil {
+				return nil, err
+			}
+			if m.Type() == ent.TypeProjectTask && m.Op().Is(ent.OpCreate) {
+				if err := backfillTaskProject(ctx, c, m); err != nil {
+					return nil, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+func operation(op ent.Op) auditevent.Operation {
+	switch {
+	case op.Is(ent.OpCreate):
+		return auditevent.OperationCreate
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return auditevent.OperationDelete
+	default:
+		return auditevent.OperationUpdate
+	}
+}
+
+// collectChanges собирает изменённые поля. Старые значения доступны только
+// для UpdateOne; для массовых обновлений пишется новое значение или
+// приращение (add) для числовых полей.
+func collectChanges(ctx context.Context, m ent.Mutation) (map[string]any, error) {
+	if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+		return nil, nil
+	}
+
+	withOld := m.Op().Is(ent.OpUpdateOne)
+	out := make(map[string]any)
+
+	for _, name := range m.Fields() {
+		newV, _ := m.Field(name)
+		ch := map[string]any{"new": newV}
+		if withOld {
+			old, err := m.OldField(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("audit: old value of %s: %w", name, err)
+			}
+			ch["old"] = old
+		}
+		out[name] = ch
+	}
+	for _, name := range m.AddedFields() {
+		delta, _ := m.AddedField(name)
+		ch := map[string]any{"add": delta}
+		if withOld {
+			old, err := m.OldField(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("audit: old value of %s: %w", name, err)
+			}
+			ch["old"] = old
+		}
+		out[name] = ch
+	}
+	for _, name := range m.ClearedFields() {
+		ch := map[string]any{"new": nil}
+		if withOld {
+			old, err := m.OldField(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("audit: old value of %s: %w", name, err)
+			}
+			ch["old"] = old
+		}
+		out[name] = ch
+	}
+
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+func record(
+	ctx context.Context,
+	c *ent.Client,
+	m ent.Mutation,
+	entityType string,
+	ids []uuid.UUID,
+	changes map[string]any,
+	projects map[uuid.UUID]*uuid.UUID,
+) error {
+	actorID, hasActor := ActorFrom(ctx)
+	requestID, hasRequestID := RequestIDFrom(ctx)
+	op := operation(m.Op())
+
+	builders := make([]*ent.AuditEventCreate, 0, len(ids))
+	for _, id := range ids {
+		b := c.AuditEvent.
+			Create().
+			SetEntityType(entityType).
+			SetEntityID(id).
+			SetOperation(op).
+			SetNillableProjectID(projects[id])
+		if changes != nil {
+			b.SetChanges(changes)
+		}
+		if hasActor {
+			b.SetActorID(actorID)
+		}
+		if hasRequestID {
+			b.SetRequestID(requestID)
+		}
+		builders = append(builders, b)
+	}
+
+	if err := c.AuditEvent.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("audit: write events: %w", err)
+	}
+	return nil
+}
+
+// resolveProjects определяет проект каждой сущности. Ошибки поиска не
+// прерывают мутацию: событие просто останется без project_id.
+func resolveProjects(ctx context.Context, c *ent.Client, m ent.Mutation, ids []uuid.UUID) map[uuid.UUID]*uuid.UUID {
+	out := make(map[uuid.UUID]*uuid.UUID, len(ids))
+	for _, id := range ids {
+		out[id] = resolveProject(ctx, c, m, id)
+	}
+	return out
+}
+
+func resolveProject(ctx context.Context, c *ent.Client, m ent.Mutation, id uuid.UUID) *uuid.UUID {
+	switch mm := m.(type) {
+	case *ent.ProjectMutation:
+		return &id
+
+	case *ent.ProjectUserMutation:
+		if p, ok := mm.ProjectID(); ok {
+			return &p
+		}
+		p, err := c.ProjectUser.Query().Where(projectuser.IDEQ(id)).QueryProject().OnlyID(ctx)
+		if err != nil {
+			return nil
+		}
+		return &p
+
+	case *ent.ProjectTaskMutation:
+		if p, ok := mm.ProjectID(); ok {
+			return &p
+		}
+		p, err := c.ProjectTask.Query().Where(projecttask.IDEQ(id)).QueryProject().OnlyID(ctx)
+		if err != nil {
+			return nil
+		}
+		return &p
+
+	case *ent.TaskMutation:
+		if p, ok := mm.TrashedProjectID(); ok {
+			return &p
+		}
+		p, err := c.ProjectTask.
+			Query().
+			Where(projecttask.HasTaskWith(task.IDEQ(id))).
+			QueryProject().
+			FirstID(ctx)
+		if err == nil {
+			return &p
+		}
+		t, err := c.Task.Get(ctx, id)
+		if err != nil {
+			return nil
+		}
+		return t.TrashedProjectID
+	}
+	return nil
+}
+
+// backfillTaskProject проставляет project_id событиям задачи, записанным до
+// того, как задача была привязана к проекту (создание задачи идёт раньше
+// создания ProjectTask).
+func backfillTaskProject(ctx context.Context, c *ent.Client, m ent.Mutation) error {
+	mm, ok := m.(*ent.ProjectTaskMutation)
+	if !ok {
+		return nil
+	}
+	taskID, ok := mm.TaskID()
+	if !ok {
+		return nil
+	}
+	projectID, ok := mm.ProjectID()
+	if !ok {
+		return nil
+	}
+
+	_, err := c.AuditEvent.
+		Update().
+		Where(
+			auditevent.EntityTypeEQ(EntityTask),
+			auditevent.EntityIDEQ(taskID),
+			auditevent.ProjectIDIsNil(),
+		).
+		SetProjectID(projectID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("audit: backfill task project: %w", err)
+	}
+	return nil
+}
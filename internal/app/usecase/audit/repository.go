@@ -0,0 +1,96 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/auditevent"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type EntRepo struct {
+	client *ent.Client
+}
+
+func NewEntRepo(c *ent.Client) *EntRepo {
+	return &EntRepo{client: c}
+}
+
+func (r *EntRepo) ListByProject(ctx context.Context, projectID uuid.UUID, f Filter, p page.Params) (page.Page[EventDTO], error) {
+	return r.list(ctx, append(filterPredicates(f), auditevent.ProjectIDEQ(projectID)), p)
+}
+
+func (r *EntRepo) ListByEntity(ctx context.Context, entityType string, entityID uuid.UUID, f Filter, p page.Params) (page.Page[EventDTO], error) {
+	return r.list(ctx, append(filterPredicates(f),
+		auditevent.EntityTypeEQ(entityType),
+		auditevent.EntityIDEQ(entityID),
+	), p)
+}
+
+// eventKeys — журнал, новые события первыми.
+var eventKeys = page.Keyset{
+	{Field: auditevent.FieldCreatedAt, Desc: true},
+	{Field: auditevent.FieldID, Desc: true},
+}
+
+func (r *EntRepo) list(ctx context.Context, ps []predicate.AuditEvent, p page.Params) (page.Page[EventDTO], error) {
+	c, err := eventKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[EventDTO]{}, err
+	}
+
+	q := r.client.AuditEvent.
+		Query().
+		Where(ps...)
+	var res page.Page[EventDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[EventDTO]{}, err
+	}
+
+	items, err := q.
+		Where(page.Where[predicate.AuditEvent](eventKeys, c)).
+		Order(page.Order[auditevent.OrderOption](eventKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[EventDTO]{}, err
+	}
+	items, res.Next, res.Prev = page.Cut(p, c, items, func(e *ent.AuditEvent) []any {
+		return []any{e.CreatedAt, e.ID}
+	})
+
+	out := make([]EventDTO, 0, len(items))
+	for _, e := range items {
+		out = append(out, EventDTO{
+			ID:         e.ID,
+			ActorID:    e.ActorID,
+			EntityType: e.EntityType,
+			EntityID:   e.EntityID,
+			Operation:  string(e.Operation),
+			Changes:    e.Changes,
+			RequestID:  e.RequestID,
+			ProjectID:  e.ProjectID,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+	res.Items = out
+	return res, nil
+}
+
+func filterPredicates(f Filter) []predicate.AuditEvent {
+	var ps []predicate.AuditEvent
+	if f.ActorID != nil {
+		ps = append(ps, auditevent.ActorIDEQ(*f.ActorID))
+	}
+	if f.From != nil {
+		ps = append(ps, auditevent.CreatedAtGTE(*f.From))
+	}
+	if f.To != nil {
+		ps = append(ps, auditevent.CreatedAtLT(*f.To))
+	}
+	return ps
+}
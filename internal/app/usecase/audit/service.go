@@ -0,0 +1,14 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type AuditService interface {
+	ListByProject(ctx context.Context, projectID uuid.UUID, f Filter, p page.Params) (page.Page[EventDTO], error)
+	ListByEntity(ctx context.Context, entityType string, entityID uuid.UUID, f Filter, p page.Params) (page.Page[EventDTO], error)
+}
@@ -0,0 +1,35 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type EventDTO struct {
+	ID         uuid.UUID
+	ActorID    *uuid.UUID
+	EntityType string
+	EntityID   uuid.UUID
+	Operation  string
+	Changes    map[string]any
+	RequestID  *string
+	ProjectID  *uuid.UUID
+	CreatedAt  time.Time
+}
+
+// Filter — фильтр журнала. Пустые поля не ограничивают выборку;
+// From включительно, To — нет.
+type Filter struct {
+	ActorID *uuid.UUID
+	From    *time.Time
+	To      *time.Time
+}
+
+type AuditRepository interface {
+	ListByProject(ctx context.Context, projectID uuid.UUID, f Filter, p page.Params) (page.Page[EventDTO], error)
+	ListByEntity(ctx context.Context, entityType string, entityID uuid.UUID, f Filter, p page.Params) (page.Page[EventDTO], error)
+}
@@ -0,0 +1,99 @@
+package board
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+)
+
+// replayLimit — сколько пропущенных событий отдаётся при переподключении;
+// если пропущено больше, клиент получает Reset.
+const replayLimit = 500
+
+type UseCase struct {
+	repo   BoardRepository
+	bus    Subscriber
+	buffer int
+}
+
+// NewBoardUsecase: buffer — сколько событий ждут медленного подписчика,
+// прежде чем он будет отключён.
+func NewBoardUsecase(repo BoardRepository, bus Subscriber, buffer int) *UseCase {
+	if buffer <= 0 {
+		buffer = 1
+	}
+	return &UseCase{repo: repo, bus: bus, buffer: buffer}
+}
+
+// Subscribe подписывает участника проекта на события доски. lastSeq —
+// номер публикации последнего полученного события, с него продолжается
+// поток.
+func (uc *UseCase) Subscribe(ctx context.Context, projectID, userID uuid.UUID, lastSeq *int64) (*Subscription, error) {
+	ok, err := uc.repo.ProjectExists(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+
+	isMember, err := uc.repo.IsMember(ctx, projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, ErrForbidden
+	}
+
+	events := make(chan outbox.Event, uc.buffer)
+	dropped := make(chan struct{})
+	var once sync.Once
+
+	// Подписываемся до чтения пропущенного, чтобы между ними ничего не
+	// потерять; повторы отсекаются по ID события (Subscription.Fresh).
+	unsubscribe := uc.bus.Subscribe(func(ev outbox.Event) {
+		if ev.ProjectID == nil || *ev.ProjectID != projectID {
+			return
+		}
+		select {
+		case events <- ev:
+		default:
+			once.Do(func() { close(dropped) })
+		}
+	})
+
+	sub := &Subscription{
+		Events:      events,
+		Dropped:     dropped,
+		unsubscribe: unsubscribe,
+		seen:        newRecentIDs(replayLimit + uc.buffer),
+	}
+	if lastSeq == nil {
+		return sub, nil
+	}
+
+	exists, err := uc.repo.EventExists(ctx, *lastSeq)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+	if !exists {
+		sub.Reset = true
+		return sub, nil
+	}
+
+	replay, err := uc.repo.EventsSince(ctx, projectID, *lastSeq, replayLimit+1)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+	if len(replay) > replayLimit {
+		sub.Reset = true
+		return sub, nil
+	}
+	sub.Replay = replay
+	return sub, nil
+}
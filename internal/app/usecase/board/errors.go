@@ -0,0 +1,8 @@
+package board
+
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
+
+var (
+	ErrProjectNotFound = apperr.NotFound("project_not_found", "project not found")
+	ErrForbidden       = apperr.Forbidden("forbidden", "forbidden")
+)
@@ -0,0 +1,47 @@
+package board
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/projectuser"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+)
+
+type EntRepo struct {
+	client *ent.Client
+	events *outbox.EntRepo
+}
+
+func NewEntRepo(c *ent.Client) *EntRepo {
+	return &EntRepo{client: c, events: outbox.NewEntRepo(c)}
+}
+
+func (r *EntRepo) ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return r.client.Project.
+		Query().
+		Where(project.IDEQ(projectID)).
+		Exist(ctx)
+}
+
+func (r *EntRepo) IsMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error) {
+	return r.client.ProjectUser.
+		Query().
+		Where(
+			projectuser.HasProjectWith(project.IDEQ(projectID)),
+			projectuser.HasUserWith(user.IDEQ(userID)),
+		).
+		Exist(ctx)
+}
+
+func (r *EntRepo) EventExists(ctx context.Context, seq int64) (bool, error) {
+	return r.events.Exists(ctx, seq)
+}
+
+func (r *EntRepo) EventsSince(ctx context.Context, projectID uuid.UUID, afterSeq int64, limit int) ([]outbox.Event, error) {
+	return r.events.Since(ctx, projectID, afterSeq, limit)
+}
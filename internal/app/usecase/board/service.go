@@ -0,0 +1,11 @@
+package board
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type BoardService interface {
+	Subscribe(ctx context.Context, projectID, userID uuid.UUID, lastSeq *int64) (*Subscription, error)
+}
@@ -0,0 +1,80 @@
+package board
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+)
+
+// Subscriber — источник событий в реальном времени (outbox.Bus).
+type Subscriber interface {
+	Subscribe(fn func(outbox.Event)) func()
+}
+
+type BoardRepository interface {
+	ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error)
+	IsMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error)
+	// EventExists — есть ли ещё в outbox событие с этим номером публикации.
+	EventExists(ctx context.Context, seq int64) (bool, error)
+	// EventsSince возвращает события проекта с номером публикации больше
+	// afterSeq по порядку.
+	EventsSince(ctx context.Context, projectID uuid.UUID, afterSeq int64, limit int) ([]outbox.Event, error)
+}
+
+// Subscription — подписка на события доски. Replay отдаётся первым, затем
+// Events. Одно событие может прийти дважды (и в Replay, и в Events, или
+// повторно после сбоя релея): перед отправкой клиенту его проверяют
+// через Fresh. Номер для продолжения — outbox.Event.PublishedSeq.
+type Subscription struct {
+	// Replay — пропущенные события после lastSeq.
+	Replay []outbox.Event
+	// Reset: пропущенное восстановить нельзя (события уже удалены из outbox
+	// или их слишком много) — клиенту нужно перечитать доску целиком.
+	Reset  bool
+	Events <-chan outbox.Event
+	// Dropped закрывается, когда подписчик не успевает читать и буфер
+	// переполнен. Дальше события теряются: поток нужно закрыть, клиент
+	// переподключится с Last-Event-ID.
+	Dropped <-chan struct{}
+
+	unsubscribe func()
+	seen        *recentIDs
+}
+
+// Fresh сообщает, что событие ещё не отдавалось подписчику, и запоминает
+// его. Помнятся только последние события: повтор приходит вскоре после
+// оригинала, а дедупликация по номеру теряла бы опоздавшие события.
+func (s *Subscription) Fresh(ev outbox.Event) bool {
+	return s.seen.add(ev.ID)
+}
+
+func (s *Subscription) Close() {
+	s.unsubscribe()
+}
+
+// recentIDs — множество из не более чем len(ring) последних ID.
+type recentIDs struct {
+	ids  map[uuid.UUID]struct{}
+	ring []uuid.UUID
+	next int
+}
+
+func newRecentIDs(n int) *recentIDs {
+	return &recentIDs{ids: make(map[uuid.UUID]struct{}, n), ring: make([]uuid.UUID, n)}
+}
+
+// add возвращает false, если id уже есть.
+func (r *recentIDs) add(id uuid.UUID) bool {
+	if _, ok := r.ids[id]; ok {
+		return false
+	}
+	if old := r.ring[r.next]; old != uuid.Nil {
+		delete(r.ids, old)
+	}
+	r.ring[r.next] = id
+	r.next = (r.next + 1) % len(r.ring)
+	r.ids[id] = struct{}{}
+	return true
+}
@@ -0,0 +1,34 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+)
+
+func TestSubscriptionFresh(t *testing.T) {
+	sub := &Subscription{seen: newRecentIDs(2)}
+	a, b, c := outbox.Event{ID: uuid.New()}, outbox.Event{ID: uuid.New()}, outbox.Event{ID: uuid.New()}
+
+	steps := []struct {
+		ev   outbox.Event
+		want bool
+	}{
+		{a, true},
+		{a, false},
+		// Опоздавшее событие с меньшим номером не считается повтором.
+		{outbox.Event{ID: b.ID, PublishedSeq: -1}, true},
+		{b, false},
+		{c, true},
+		// a вытеснено: множество помнит только два последних события.
+		{a, true},
+		{c, false},
+	}
+	for i, s := range steps {
+		if got := sub.Fresh(s.ev); got != s.want {
+			t.Errorf("step %d: Fresh = %v, want %v", i, got, s.want)
+		}
+	}
+}
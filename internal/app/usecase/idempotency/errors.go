@@ -0,0 +1,11 @@
+package idempotency
+
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
+
+var (
+	ErrInvalidKey = apperr.Invalid("invalid_idempotency_key", "invalid idempotency key")
+	// ErrInProgress — запрос с тем же ключом ещё выполняется.
+	ErrInProgress = apperr.Conflict("idempotency_in_progress", "request with this idempotency key is in progress")
+	// ErrFingerprintMismatch — ключ уже использован с другим запросом.
+	ErrFingerprintMismatch = apperr.Unprocessable("idempotency_key_reused", "idempotency key reused with different request")
+)
@@ -0,0 +1,61 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+const maxKeyLen = 255
+
+type UseCase struct {
+	repo      IdempotencyRepository
+	retention time.Duration
+}
+
+// NewIdempotencyUsecase: ключи старше retention считаются свободными,
+// даже если фоновая очистка их ещё не удалила.
+func NewIdempotencyUsecase(repo IdempotencyRepository, retention time.Duration) *UseCase {
+	return &UseCase{repo: repo, retention: retention}
+}
+
+// Begin резервирует ключ под запрос. Если по ключу уже есть сохранённый
+// ответ для того же запроса, он возвращается для повтора; nil означает,
+// что запрос нужно выполнить и затем вызвать Complete или Release.
+func (uc *UseCase) Begin(ctx context.Context, scope, key, fingerprint string) (*Response, error) {
+	if key == "" || len(key) > maxKeyLen {
+		return nil, ErrInvalidKey
+	}
+
+	rec, created, err := uc.repo.Reserve(ctx, scope, key, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		return nil, nil
+	}
+
+	if rec.CreatedAt.Before(time.Now().Add(-uc.retention)) {
+		if err := uc.repo.DeleteKey(ctx, scope, key); err != nil {
+			return nil, err
+		}
+		return uc.Begin(ctx, scope, key, fingerprint)
+	}
+
+	if rec.Fingerprint != fingerprint {
+		return nil, ErrFingerprintMismatch
+	}
+	if rec.Response == nil {
+		return nil, ErrInProgress
+	}
+	return rec.Response, nil
+}
+
+func (uc *UseCase) Complete(ctx context.Context, scope, key string, resp Response) error {
+	return uc.repo.Complete(ctx, scope, key, resp)
+}
+
+// Release освобождает ключ, если ответ не стоит запоминать (ошибка сервера),
+// чтобы клиент мог повторить запрос.
+func (uc *UseCase) Release(ctx context.Context, scope, key string) error {
+	return uc.repo.Release(ctx, scope, key)
+}
@@ -0,0 +1,96 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/idempotencykey"
+)
+
+type EntRepo struct {
+	client *ent.Client
+}
+
+func NewEntRepo(c *ent.Client) *EntRepo {
+	return &EntRepo{client: c}
+}
+
+func (r *EntRepo) Reserve(ctx context.Context, scope, key, fingerprint string) (Record, bool, error) {
+	row, err := r.client.IdempotencyKey.Create().
+		SetScope(scope).
+		SetKey(key).
+		SetFingerprint(fingerprint).
+		Save(ctx)
+	if err == nil {
+		return toRecord(row), true, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return Record{}, false, err
+	}
+
+	// Ключ уже занят — параллельным или более ранним запросом.
+	row, err = r.client.IdempotencyKey.Query().
+		Where(idempotencykey.ScopeEQ(scope), idempotencykey.KeyEQ(key)).
+		Only(ctx)
+	if err != nil {
+		return Record{}, false, err
+	}
+	return toRecord(row), false, nil
+}
+
+func (r *EntRepo) Complete(ctx context.Context, scope, key string, resp Response) error {
+	_, err := r.client.IdempotencyKey.Update().
+		Where(
+			idempotencykey.ScopeEQ(scope),
+			idempotencykey.KeyEQ(key),
+			idempotencykey.StatusCodeIsNil(),
+		).
+		SetStatusCode(resp.StatusCode).
+		SetContentType(resp.ContentType).
+		SetResponseBody(resp.Body).
+		Save(ctx)
+	return err
+}
+
+func (r *EntRepo) Release(ctx context.Context, scope, key string) error {
+	_, err := r.client.IdempotencyKey.Delete().
+		Where(
+			idempotencykey.ScopeEQ(scope),
+			idempotencykey.KeyEQ(key),
+			idempotencykey.StatusCodeIsNil(),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (r *EntRepo) DeleteKey(ctx context.Context, scope, key string) error {
+	_, err := r.client.IdempotencyKey.Delete().
+		Where(idempotencykey.ScopeEQ(scope), idempotencykey.KeyEQ(key)).
+		Exec(ctx)
+	return err
+}
+
+// PurgeDeleted удаляет ключи, созданные раньше before. Реализует worker.Purger.
+func (r *EntRepo) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	return r.client.IdempotencyKey.Delete().
+		Where(idempotencykey.CreatedAtLT(before)).
+		Exec(ctx)
+}
+
+func toRecord(row *ent.IdempotencyKey) Record {
+	rec := Record{
+		Scope:       row.Scope,
+		Key:         row.Key,
+		Fingerprint: row.Fingerprint,
+		CreatedAt:   row.CreatedAt,
+	}
+	if row.StatusCode != nil {
+		rec.Response = &Response{
+			StatusCode:  *row.StatusCode,
+			ContentType: row.ContentType,
+			Body:        row.ResponseBody,
+		}
+	}
+	return rec
+}
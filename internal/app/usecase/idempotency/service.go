@@ -0,0 +1,9 @@
+package idempotency
+
+import "context"
+
+type IdempotencyService interface {
+	Begin(ctx context.Context, scope, key, fingerprint string) (*Response, error)
+	Complete(ctx context.Context, scope, key string, resp Response) error
+	Release(ctx context.Context, scope, key string) error
+}
@@ -0,0 +1,33 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Response — сохранённый ответ, который отдаётся при повторе запроса.
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// Record — состояние ключа. Response == nil, пока первый запрос не завершён.
+// Scope — автор запроса: ключ уникален только в его пределах.
+type Record struct {
+	Scope       string
+	Key         string
+	Fingerprint string
+	Response    *Response
+	CreatedAt   time.Time
+}
+
+type IdempotencyRepository interface {
+	// Reserve создаёт запись для ключа. Если ключ уже занят, возвращает
+	// существующую запись и created == false.
+	Reserve(ctx context.Context, scope, key, fingerprint string) (rec Record, created bool, err error)
+	Complete(ctx context.Context, scope, key string, resp Response) error
+	Release(ctx context.Context, scope, key string) error
+	DeleteKey(ctx context.Context, scope, key string) error
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,172 @@
+package mail
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/notification"
+)
+
+const (
+	queueBatch = 50
+	// maxBackoff ограничивает паузу между попытками отправки.
+	maxBackoff = 6 * time.Hour
+)
+
+type UseCase struct {
+	repo        MailRepository
+	sender      Sender
+	maxAttempts int
+}
+
+func NewMailUsecase(repo MailRepository, sender Sender, maxAttempts int) *UseCase {
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	return &UseCase{repo: repo, sender: sender, maxAttempts: maxAttempts}
+}
+
+// Deliver ставит в очередь письма по свежим уведомлениям получателей с
+// режимом instant. Остальные уведомления дождутся дайджеста (daily) или
+// останутся только во входящих (off). Реализует notification.Mailer.
+func (uc *UseCase) Deliver(ctx context.Context, items []notification.NotificationDTO) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(items))
+	byType := make(map[string][]uuid.UUID)
+	for _, n := range items {
+		userIDs = append(userIDs, n.UserID)
+		byType[n.Type] = append(byType[n.Type], n.UserID)
+	}
+
+	recipients, err := uc.repo.Recipients(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	muted := make(map[string]map[uuid.UUID]bool, len(byType))
+	for typ, users := range byType {
+		m, err := uc.repo.EmailMuted(ctx, typ, users)
+		if err != nil {
+			return err
+		}
+		muted[typ] = m
+	}
+
+	msgs := make([]Message, 0, len(items))
+	ids := make([]uuid.UUID, 0, len(items))
+	for _, n := range items {
+		rec, ok := recipients[n.UserID]
+		if !ok || rec.Email == "" || rec.EmailMode != notification.EmailInstant || muted[n.Type][n.UserID] {
+			continue
+		}
+
+		m, err := render(templateFor(n.Type), NotificationData{
+			RecipientName: rec.Name,
+			Message:       n.Message,
+			Overdue:       n.Type == notification.TypeTaskOverdue,
+		})
+		if err != nil {
+			return err
+		}
+		m.To = rec.Email
+		msgs = append(msgs, m)
+		ids = append(ids, n.ID)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return uc.repo.Enqueue(ctx, msgs, ids, time.Now())
+}
+
+// SendDigests собирает по одному письму-дайджесту на пользователя с режимом
+// daily из уведомлений, ещё не отправленных письмом.
+func (uc *UseCase) SendDigests(ctx context.Context, now time.Time) (int, error) {
+	pending, err := uc.repo.PendingDigests(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, d := range pending {
+		m, err := render(TemplateDigest, DigestData{
+			RecipientName: d.Recipient.Name,
+			Items:         d.Items,
+		})
+		if err != nil {
+			return sent, err
+		}
+		m.To = d.Recipient.Email
+
+		if err := uc.repo.Enqueue(ctx, []Message{m}, d.NotificationIDs, now); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// ProcessQueue отправляет письма, время попытки которых наступило. Ошибка
+// отправки не прерывает проход: письмо откладывается с растущей паузой,
+// а после maxAttempts попыток помечается как failed.
+func (uc *UseCase) ProcessQueue(ctx context.Context, now time.Time) (int, error) {
+	msgs, err := uc.repo.DueMessages(ctx, now, queueBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, m := range msgs {
+		sendErr := uc.sender.Send(ctx, m)
+		if sendErr == nil {
+			if err := uc.repo.MarkSent(ctx, m.ID, time.Now()); err != nil {
+				return sent, err
+			}
+			sent++
+			continue
+		}
+
+		attempts := m.Attempts + 1
+		log.Printf("mail %s to %s: attempt %d: %v", m.ID, m.To, attempts, sendErr)
+		if attempts >= uc.maxAttempts {
+			err = uc.repo.MarkFailed(ctx, m.ID, sendErr.Error())
+		} else {
+			err = uc.repo.MarkRetry(ctx, m.ID, sendErr.Error(), now.Add(backoff(attempts)))
+		}
+		if err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// backoff: 1m, 2m, 4m, ... но не больше maxBackoff.
+func backoff(attempts int) time.Duration {
+	d := time.Minute
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d
+}
+
+func templateFor(typ string) string {
+	switch typ {
+	case notification.TypeTaskAssigned:
+		return TemplateAssignment
+	case notification.TypeProjectInvited:
+		return TemplateInvitation
+	case notification.TypeTaskDueSoon, notification.TypeTaskOverdue:
+		return TemplateDueReminder
+	case notification.TypeTaskMentioned:
+		return TemplateMention
+	default:
+		return TemplateTaskUpdate
+	}
+}
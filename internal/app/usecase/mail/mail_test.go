@@ -0,0 +1,200 @@
+package mail_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/mail"
+)
+
+// memRepo — очередь писем в памяти.
+type memRepo struct {
+	digests []mail.PendingDigest
+	queue   map[uuid.UUID]*queued
+	order   []uuid.UUID
+	marked  []uuid.UUID
+}
+
+type queued struct {
+	msg    mail.Message
+	next   time.Time
+	status string
+	err    string
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{queue: map[uuid.UUID]*queued{}}
+}
+
+func (r *memRepo) Recipients(context.Context, []uuid.UUID) (map[uuid.UUID]mail.Recipient, error) {
+	return nil, nil
+}
+
+func (r *memRepo) EmailMuted(context.Context, string, []uuid.UUID) (map[uuid.UUID]bool, error) {
+	return nil, nil
+}
+
+func (r *memRepo) Enqueue(_ context.Context, msgs []mail.Message, ids []uuid.UUID, at time.Time) error {
+	for _, m := range msgs {
+		m.ID = uuid.New()
+		r.queue[m.ID] = &queued{msg: m, next: at, status: "pending"}
+		r.order = append(r.order, m.ID)
+	}
+	r.marked = append(r.marked, ids...)
+	return nil
+}
+
+func (r *memRepo) PendingDigests(context.Context, time.Time) ([]mail.PendingDigest, error) {
+	return r.digests, nil
+}
+
+func (r *memRepo) DueMessages(_ context.Context, now time.Time, limit int) ([]mail.Message, error) {
+	var out []mail.Message
+	for _, id := range r.order {
+		q := r.queue[id]
+		if q.status == "pending" && !q.next.After(now) && len(out) < limit {
+			out = append(out, q.msg)
+		}
+	}
+	return out, nil
+}
+
+func (r *memRepo) MarkSent(_ context.Context, id uuid.UUID, _ time.Time) error {
+	r.queue[id].status = "sent"
+	return nil
+}
+
+func (r *memRepo) MarkRetry(_ context.Context, id uuid.UUID, lastErr string, next time.Time) error {
+	q := r.queue[id]
+	q.msg.Attempts++
+	q.next, q.err = next, lastErr
+	return nil
+}
+
+func (r *memRepo) MarkFailed(_ context.Context, id uuid.UUID, lastErr string) error {
+	q := r.queue[id]
+	q.msg.Attempts++
+	q.status, q.err = "failed", lastErr
+	return nil
+}
+
+func TestProcessQueueRetries(t *testing.T) {
+	srv, sender := newSMTP(t)
+	repo := newMemRepo()
+	uc := mail.NewMailUsecase(repo, sender, 3)
+	ctx := context.Background()
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	if err := repo.Enqueue(ctx, []mail.Message{{To: "a@pm.test", Subject: "s", Text: "t"}}, nil, now); err != nil {
+		t.Fatal(err)
+	}
+	q := repo.queue[repo.order[0]]
+
+	// Две временные ошибки: паузы 1 и 2 минуты.
+	srv.FailNext(2)
+	for i, wait := range []time.Duration{time.Minute, 2 * time.Minute} {
+		sent, err := uc.ProcessQueue(ctx, now)
+		if err != nil || sent != 0 {
+			t.Fatalf("pass %d: sent=%d err=%v, want 0, nil", i, sent, err)
+		}
+		if q.msg.Attempts != i+1 || !q.next.Equal(now.Add(wait)) {
+			t.Fatalf("pass %d: attempts=%d next=%v, want %d, %v", i, q.msg.Attempts, q.next, i+1, now.Add(wait))
+		}
+		if !strings.Contains(q.err, "451") {
+			t.Errorf("pass %d: last error = %q, want 451", i, q.err)
+		}
+
+		// До наступления паузы письмо не отправляется.
+		if sent, _ := uc.ProcessQueue(ctx, now.Add(wait-time.Second)); sent != 0 {
+			t.Fatalf("pass %d: sent before backoff elapsed", i)
+		}
+		now = now.Add(wait)
+	}
+
+	sent, err := uc.ProcessQueue(ctx, now)
+	if err != nil || sent != 1 {
+		t.Fatalf("final pass: sent=%d err=%v, want 1, nil", sent, err)
+	}
+	if q.status != "sent" || len(srv.Messages()) != 1 {
+		t.Errorf("status=%s delivered=%d, want sent, 1", q.status, len(srv.Messages()))
+	}
+}
+
+func TestProcessQueueGivesUp(t *testing.T) {
+	srv, sender := newSMTP(t)
+	repo := newMemRepo()
+	uc := mail.NewMailUsecase(repo, sender, 2)
+	ctx := context.Background()
+
+	now := time.Now()
+	if err := repo.Enqueue(ctx, []mail.Message{{To: "a@pm.test", Subject: "s", Text: "t"}}, nil, now); err != nil {
+		t.Fatal(err)
+	}
+	q := repo.queue[repo.order[0]]
+
+	srv.FailNext(10)
+	for range 2 {
+		if _, err := uc.ProcessQueue(ctx, now); err != nil {
+			t.Fatal(err)
+		}
+		now = q.next
+	}
+	if q.status != "failed" || q.msg.Attempts != 2 {
+		t.Errorf("status=%s attempts=%d, want failed, 2", q.status, q.msg.Attempts)
+	}
+	if sent, _ := uc.ProcessQueue(ctx, now.Add(24*time.Hour)); sent != 0 {
+		t.Error("failed message was sent again")
+	}
+}
+
+func TestSendDigests(t *testing.T) {
+	srv, sender := newSMTP(t)
+	repo := newMemRepo()
+	uc := mail.NewMailUsecase(repo, sender, 3)
+	ctx := context.Background()
+
+	now := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+	repo.digests = []mail.PendingDigest{{
+		Recipient:       mail.Recipient{Email: "anna@pm.test", Name: "Anna", EmailMode: "daily"},
+		NotificationIDs: ids,
+		Items: []mail.DigestItem{
+			{Message: "Bob assigned you to Design", CreatedAt: now.Add(-3 * time.Hour)},
+			{Message: "Design is due tomorrow", CreatedAt: now.Add(-time.Hour)},
+		},
+	}}
+
+	n, err := uc.SendDigests(ctx, now)
+	if err != nil || n != 1 {
+		t.Fatalf("SendDigests = %d, %v, want 1, nil", n, err)
+	}
+	if len(repo.marked) != 2 {
+		t.Errorf("marked notifications = %v, want both", repo.marked)
+	}
+
+	if sent, err := uc.ProcessQueue(ctx, now); err != nil || sent != 1 {
+		t.Fatalf("ProcessQueue = %d, %v, want 1, nil", sent, err)
+	}
+	got := srv.Messages()
+	if len(got) != 1 || got[0].To[0] != "anna@pm.test" {
+		t.Fatalf("delivered = %+v", got)
+	}
+
+	m := parse(t, got[0].Data)
+	if s := m.header.Get("Subject"); s != "Your daily summary: 2 updates" {
+		t.Errorf("Subject = %q", s)
+	}
+	text := m.parts["text/plain; charset=utf-8"]
+	for _, want := range []string{"Hi Anna,", "Jan 2 05:00: Bob assigned you to Design", "Jan 2 07:00: Design is due tomorrow"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text part missing %q:\n%s", want, text)
+		}
+	}
+	if html := m.parts["text/html; charset=utf-8"]; !strings.Contains(html, "Design is due tomorrow") {
+		t.Errorf("html part missing digest item:\n%s", html)
+	}
+}
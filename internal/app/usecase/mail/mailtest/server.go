@@ -0,0 +1,193 @@
+// Package mailtest — встроенный SMTP-сервер для локальной разработки и
+// проверки отправки почты без настоящего почтового сервера. Письма не
+// доставляются, а сохраняются в памяти.
+package mailtest
+
+import (
+	"io"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Received — письмо, принятое сервером.
+type Received struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+type Server struct {
+	ln net.Listener
+
+	// OnMessage, если задан, вызывается для каждого принятого письма.
+	OnMessage func(Received)
+
+	mu       sync.Mutex
+	messages []Received
+	failNext int
+	wg       sync.WaitGroup
+}
+
+// NewServer начинает слушать addr, например "127.0.0.1:0".
+func NewServer(addr string) (*Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{ln: ln}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+func (s *Server) Addr() string {
+	return s.ln.Addr().String()
+}
+
+func (s *Server) Host() string {
+	host, _, _ := net.SplitHostPort(s.Addr())
+	return host
+}
+
+func (s *Server) Port() int {
+	_, port, _ := net.SplitHostPort(s.Addr())
+	n, _ := strconv.Atoi(port)
+	return n
+}
+
+// Messages возвращает копию принятых писем.
+func (s *Server) Messages() []Received {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Received, len(s.messages))
+	copy(out, s.messages)
+	return out
+}
+
+// FailNext отклоняет следующие n писем временной ошибкой 451 — для
+// проверки повторных попыток.
+func (s *Server) FailNext(n int) {
+	s.mu.Lock()
+	s.failNext = n
+	s.mu.Unlock()
+}
+
+func (s *Server) Close() error {
+	err := s.ln.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+
+	reply := func(code int, msg string) bool {
+		return tp.PrintfLine("%d %s", code, msg) == nil
+	}
+
+	if !reply(220, "mailtest ESMTP") {
+		return
+	}
+
+	var cur Received
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "HELO":
+			reply(250, "mailtest")
+		case "EHLO":
+			_ = tp.PrintfLine("250-mailtest")
+			_ = tp.PrintfLine("250-8BITMIME")
+			reply(250, "AUTH PLAIN")
+		case "AUTH":
+			// Принимаются любые учётные данные.
+			reply(235, "authenticated")
+		case "MAIL":
+			cur = Received{From: address(arg)}
+			reply(250, "ok")
+		case "RCPT":
+			cur.To = append(cur.To, address(arg))
+			reply(250, "ok")
+		case "DATA":
+			if !reply(354, "end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := io.ReadAll(tp.DotReader())
+			if err != nil {
+				return
+			}
+			cur.Data = data
+			if s.accept(cur) {
+				reply(250, "queued")
+			} else {
+				reply(451, "temporary failure")
+			}
+			cur = Received{}
+		case "RSET":
+			cur = Received{}
+			reply(250, "ok")
+		case "NOOP":
+			reply(250, "ok")
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, "command not implemented")
+		}
+	}
+}
+
+func (s *Server) accept(m Received) bool {
+	s.mu.Lock()
+	if s.failNext > 0 {
+		s.failNext--
+		s.mu.Unlock()
+		return false
+	}
+	s.messages = append(s.messages, m)
+	onMessage := s.OnMessage
+	s.mu.Unlock()
+
+	if onMessage != nil {
+		onMessage(m)
+	}
+	return true
+}
+
+// address достаёт адрес из "FROM:<a@b>" / "TO:<a@b>".
+func address(arg string) string {
+	_, v, ok := strings.Cut(arg, ":")
+	if !ok {
+		return ""
+	}
+	v = strings.TrimSpace(v)
+	if i := strings.IndexByte(v, ' '); i >= 0 {
+		v = v[:i]
+	}
+	return strings.Trim(v, "<>")
+}
@@ -0,0 +1,227 @@
+package mail
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/mailmessage"
+	"project-manager-dashboard-go/ent/notification"
+	"project-manager-dashboard-go/ent/notificationpreference"
+	"project-manager-dashboard-go/ent/user"
+)
+
+type EntRepo struct {
+	client *ent.Client
+}
+
+func NewEntRepo(c *ent.Client) *EntRepo {
+	return &EntRepo{client: c}
+}
+
+func (r *EntRepo) Recipients(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]Recipient, error) {
+	users, err := r.client.User.
+		Query().
+		Where(user.IDIn(userIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uuid.UUID]Recipient, len(users))
+	for _, u := range users {
+		out[u.ID] = toRecipient(u)
+	}
+	return out, nil
+}
+
+func (r *EntRepo) EmailMuted(ctx context.Context, typ string, users []uuid.UUID) (map[uuid.UUID]bool, error) {
+	rows, err := r.client.NotificationPreference.
+		Query().
+		Where(
+			notificationpreference.UserIDIn(users...),
+			notificationpreference.TypeEQ(notificationpreference.Type(typ)),
+			notificationpreference.Email(false),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uuid.UUID]bool, len(rows))
+	for _, p := range rows {
+		out[p.UserID] = true
+	}
+	return out, nil
+}
+
+func (r *EntRepo) Enqueue(ctx context.Context, msgs []Message, notificationIDs []uuid.UUID, at time.Time) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	builders := make([]*ent.MailMessageCreate, 0, len(msgs))
+	for _, m := range msgs {
+		c := tx.MailMessage.
+			Create().
+			SetToEmail(m.To).
+			SetSubject(m.Subject).
+			SetTextBody(m.Text).
+			SetNextAttemptAt(at)
+		if m.HTML != "" {
+			c.SetHTMLBody(m.HTML)
+		}
+		builders = append(builders, c)
+	}
+	if err = tx.MailMessage.CreateBulk(builders...).Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(notificationIDs) > 0 {
+		_, err = tx.Notification.
+			Update().
+			Where(notification.IDIn(notificationIDs...)).
+			SetEmailedAt(at).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *EntRepo) PendingDigests(ctx context.Context, before time.Time) ([]PendingDigest, error) {
+	users, err := r.client.User.
+		Query().
+		Where(
+			user.EmailModeEQ(user.EmailModeDaily),
+			user.HasNotificationsWith(
+				notification.EmailedAtIsNil(),
+				notification.CreatedAtLT(before),
+			),
+		).
+		WithNotifications(func(nq *ent.NotificationQuery) {
+			nq.Where(
+				notification.EmailedAtIsNil(),
+				notification.CreatedAtLT(before),
+			).Order(ent.Asc(notification.FieldCreatedAt))
+		}).
+		WithNotificationPreferences(func(pq *ent.NotificationPreferenceQuery) {
+			pq.Where(notificationpreference.Email(false))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]PendingDigest, 0, len(users))
+	for _, u := range users {
+		muted := make(map[notification.Type]bool, len(u.Edges.NotificationPreferences))
+		for _, p := range u.Edges.NotificationPreferences {
+			muted[notification.Type(p.Type)] = true
+		}
+
+		d := PendingDigest{Recipient: toRecipient(u)}
+		for _, n := range u.Edges.Notifications {
+			// Отключённые типы тоже отмечаем, чтобы не выбирать их снова.
+			d.NotificationIDs = append(d.NotificationIDs, n.ID)
+			if muted[n.Type] {
+				continue
+			}
+			d.Items = append(d.Items, DigestItem{Message: n.Message, CreatedAt: n.CreatedAt})
+		}
+		if len(d.Items) == 0 {
+			if err := r.markEmailed(ctx, d.NotificationIDs, before); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		out = append(out, d)
+	}
+	return out, nil
+}
+
+func (r *EntRepo) DueMessages(ctx context.Context, now time.Time, limit int) ([]Message, error) {
+	rows, err := r.client.MailMessage.
+		Query().
+		Where(
+			mailmessage.StatusEQ(mailmessage.StatusPending),
+			mailmessage.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(mailmessage.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Message, 0, len(rows))
+	for _, m := range rows {
+		out = append(out, Message{
+			ID:       m.ID,
+			To:       m.ToEmail,
+			Subject:  m.Subject,
+			Text:     m.TextBody,
+			HTML:     m.HTMLBody,
+			Attempts: m.Attempts,
+		})
+	}
+	return out, nil
+}
+
+func (r *EntRepo) MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.client.MailMessage.
+		UpdateOneID(id).
+		SetStatus(mailmessage.StatusSent).
+		SetSentAt(at).
+		ClearLastError().
+		Exec(ctx)
+}
+
+func (r *EntRepo) MarkRetry(ctx context.Context, id uuid.UUID, lastErr string, next time.Time) error {
+	return r.client.MailMessage.
+		UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(lastErr).
+		SetNextAttemptAt(next).
+		Exec(ctx)
+}
+
+func (r *EntRepo) MarkFailed(ctx context.Context, id uuid.UUID, lastErr string) error {
+	return r.client.MailMessage.
+		UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(lastErr).
+		SetStatus(mailmessage.StatusFailed).
+		Exec(ctx)
+}
+
+func (r *EntRepo) markEmailed(ctx context.Context, ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.client.Notification.
+		Update().
+		Where(notification.IDIn(ids...)).
+		SetEmailedAt(at).
+		Save(ctx)
+	return err
+}
+
+func toRecipient(u *ent.User) Recipient {
+	return Recipient{
+		UserID:    u.ID,
+		Email:     u.Email,
+		Name:      u.Name,
+		EmailMode: string(u.EmailMode),
+	}
+}
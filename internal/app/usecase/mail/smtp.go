@@ -0,0 +1,206 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Режимы шифрования соединения с SMTP-сервером.
+const (
+	TLSNone     = "none"
+	TLSStartTLS = "starttls"
+	// TLSImplicit — TLS с первого байта (обычно порт 465).
+	TLSImplicit = "tls"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	TLS      string
+	From     string
+	Timeout  time.Duration
+}
+
+// SMTPSender отправляет письма через SMTP, по соединению на письмо.
+type SMTPSender struct {
+	cfg SMTPConfig
+	// envelopeFrom — адрес из From без отображаемого имени (для MAIL FROM).
+	envelopeFrom string
+}
+
+func NewSMTPSender(cfg SMTPConfig) (*SMTPSender, error) {
+	switch cfg.TLS {
+	case TLSNone, TLSStartTLS, TLSImplicit:
+	default:
+		return nil, fmt.Errorf("unknown SMTP TLS mode %q", cfg.TLS)
+	}
+	from, err := netmail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mail from %q: %w", cfg.From, err)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	return &SMTPSender{cfg: cfg, envelopeFrom: from.Address}, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, m Message) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: s.cfg.Host}
+
+	var conn net.Conn
+	var err error
+	if s.cfg.TLS == TLSImplicit {
+		d := &tls.Dialer{Config: tlsConfig}
+		conn, err = d.DialContext(ctx, "tcp", addr)
+	} else {
+		var d net.Dialer
+		conn, err = d.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if s.cfg.TLS == TLSStartTLS {
+		if err := c.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if s.cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	body, err := buildMIME(s.cfg.From, s.envelopeFrom, m)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Mail(s.envelopeFrom); err != nil {
+		return err
+	}
+	if err := c.Rcpt(m.To); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// buildMIME собирает письмо: multipart/alternative из текста и HTML или
+// только текст, если HTML нет.
+func buildMIME(from, envelopeFrom string, m Message) ([]byte, error) {
+	var buf bytes.Buffer
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", from)
+	header.Set("To", m.To)
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header.Set("Date", time.Now().Format(time.RFC1123Z))
+	header.Set("Message-ID", messageID(envelopeFrom))
+	header.Set("MIME-Version", "1.0")
+
+	if m.HTML == "" {
+		header.Set("Content-Type", "text/plain; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		writeHeader(&buf, header)
+		if err := writeQP(&buf, m.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	mw := multipart.NewWriter(&buf)
+	header.Set("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+
+	var out bytes.Buffer
+	writeHeader(&out, header)
+
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQP(pw, part.body); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	out.Write(buf.Bytes())
+	return out.Bytes(), nil
+}
+
+func writeHeader(buf *bytes.Buffer, h textproto.MIMEHeader) {
+	for _, k := range []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "Content-Transfer-Encoding"} {
+		if v := h.Get(k); v != "" {
+			fmt.Fprintf(buf, "%s: %s\r\n", k, v)
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+func writeQP(w io.Writer, s string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(s)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func messageID(from string) string {
+	var b [12]byte
+	_, _ = rand.Read(b[:])
+	domain := "localhost"
+	if i := strings.LastIndexByte(from, '@'); i >= 0 {
+		domain = from[i+1:]
+	}
+	return "<" + hex.EncodeToString(b[:]) + "@" + domain + ">"
+}
@@ -0,0 +1,165 @@
+package mail_test
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"strings"
+	"testing"
+
+	"project-manager-dashboard-go/internal/app/usecase/mail"
+	"project-manager-dashboard-go/internal/app/usecase/mail/mailtest"
+)
+
+func newSMTP(t *testing.T) (*mailtest.Server, *mail.SMTPSender) {
+	t.Helper()
+	srv, err := mailtest.NewServer("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = srv.Close() })
+
+	sender, err := mail.NewSMTPSender(mail.SMTPConfig{
+		Host: srv.Host(),
+		Port: srv.Port(),
+		TLS:  mail.TLSNone,
+		From: "Project Manager <noreply@pm.test>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, sender
+}
+
+// parsed — принятое письмо, разобранное до текста частей.
+type parsed struct {
+	header netmail.Header
+	parts  map[string]string // Content-Type части → декодированное тело
+}
+
+func parse(t *testing.T, data []byte) parsed {
+	t.Helper()
+	msg, err := netmail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("read message: %v", err)
+	}
+	out := parsed{header: msg.Header, parts: map[string]string{}}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("content type: %v", err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		out.parts[msg.Header.Get("Content-Type")] = readQP(t, msg.Body)
+		return out
+	}
+
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := mr.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("next part: %v", err)
+		}
+		if enc := p.Header.Get("Content-Transfer-Encoding"); enc != "quoted-printable" {
+			t.Errorf("part %s encoding = %q", p.Header.Get("Content-Type"), enc)
+		}
+		out.parts[p.Header.Get("Content-Type")] = readQP(t, p)
+	}
+	return out
+}
+
+func readQP(t *testing.T, r io.Reader) string {
+	t.Helper()
+	b, err := io.ReadAll(quotedprintable.NewReader(r))
+	if err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	return string(b)
+}
+
+func TestSMTPSenderMultipart(t *testing.T) {
+	srv, sender := newSMTP(t)
+
+	err := sender.Send(context.Background(), mail.Message{
+		To:      "anna@pm.test",
+		Subject: "Новая задача",
+		Text:    "Привет, Анна!\n",
+		HTML:    "<p>Привет, <b>Анна</b>!</p>",
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	got := srv.Messages()
+	if len(got) != 1 {
+		t.Fatalf("got %d messages, want 1", len(got))
+	}
+	if got[0].From != "noreply@pm.test" {
+		t.Errorf("envelope from = %q", got[0].From)
+	}
+	if len(got[0].To) != 1 || got[0].To[0] != "anna@pm.test" {
+		t.Errorf("envelope to = %v", got[0].To)
+	}
+
+	m := parse(t, got[0].Data)
+	if from := m.header.Get("From"); from != "Project Manager <noreply@pm.test>" {
+		t.Errorf("From = %q", from)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.header.Get("Subject"))
+	if err != nil || subject != "Новая задача" {
+		t.Errorf("Subject = %q (%v)", subject, err)
+	}
+	if id := m.header.Get("Message-ID"); !strings.HasSuffix(id, "@pm.test>") {
+		t.Errorf("Message-ID = %q", id)
+	}
+
+	want := map[string]string{
+		"text/plain; charset=utf-8": "Привет, Анна!\n",
+		"text/html; charset=utf-8":  "<p>Привет, <b>Анна</b>!</p>",
+	}
+	if len(m.parts) != len(want) {
+		t.Errorf("parts = %v, want %d", m.parts, len(want))
+	}
+	for ct, body := range want {
+		if m.parts[ct] != body {
+			t.Errorf("part %s = %q, want %q", ct, m.parts[ct], body)
+		}
+	}
+}
+
+func TestSMTPSenderTextOnly(t *testing.T) {
+	srv, sender := newSMTP(t)
+
+	long := strings.Repeat("long line ", 20) + "\n"
+	err := sender.Send(context.Background(), mail.Message{To: "bob@pm.test", Subject: "Hi", Text: long})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	m := parse(t, srv.Messages()[0].Data)
+	if body := m.parts["text/plain; charset=utf-8"]; body != long {
+		t.Errorf("body = %q, want %q", body, long)
+	}
+}
+
+func TestSMTPSenderTemporaryFailure(t *testing.T) {
+	srv, sender := newSMTP(t)
+	srv.FailNext(1)
+
+	m := mail.Message{To: "bob@pm.test", Subject: "Hi", Text: "hi"}
+	if err := sender.Send(context.Background(), m); err == nil {
+		t.Fatal("send succeeded, want 451 error")
+	}
+	if err := sender.Send(context.Background(), m); err != nil {
+		t.Fatalf("second send: %v", err)
+	}
+	if n := len(srv.Messages()); n != 1 {
+		t.Errorf("got %d messages, want 1", n)
+	}
+}
@@ -0,0 +1,94 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// Шаблоны писем. Для каждого есть текстовая (<name>.txt.tmpl) и HTML
+// (<name>.html.tmpl) версии; тема задаётся блоком "subject".
+const (
+	TemplateInvitation  = "invitation"
+	TemplateAssignment  = "assignment"
+	TemplateDueReminder = "due_reminder"
+	TemplateMention     = "mention"
+	TemplateTaskUpdate  = "task_update"
+	TemplateDigest      = "digest"
+)
+
+type templatePair struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var templates = mustLoadTemplates(
+	TemplateInvitation,
+	TemplateAssignment,
+	TemplateDueReminder,
+	TemplateMention,
+	TemplateTaskUpdate,
+	TemplateDigest,
+)
+
+// NotificationData — данные шаблона письма об одном уведомлении.
+type NotificationData struct {
+	RecipientName string
+	Message       string
+	Overdue       bool
+}
+
+// DigestData — данные шаблона дайджеста.
+type DigestData struct {
+	RecipientName string
+	Items         []DigestItem
+}
+
+type DigestItem struct {
+	Message   string
+	CreatedAt time.Time
+}
+
+func mustLoadTemplates(names ...string) map[string]templatePair {
+	out := make(map[string]templatePair, len(names))
+	for _, name := range names {
+		txt := texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/"+name+".txt.tmpl"))
+		html := htmltemplate.Must(htmltemplate.ParseFS(templateFS,
+			"templates/layout.html.tmpl",
+			"templates/"+name+".html.tmpl",
+		))
+		out[name] = templatePair{text: txt, html: html}
+	}
+	return out
+}
+
+func render(name string, data any) (Message, error) {
+	tp, ok := templates[name]
+	if !ok {
+		return Message{}, fmt.Errorf("mail template %q not found", name)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := tp.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, err
+	}
+	if err := tp.text.ExecuteTemplate(&text, name+".txt.tmpl", data); err != nil {
+		return Message{}, err
+	}
+	if err := tp.html.ExecuteTemplate(&html, "layout", data); err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package mail
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message — письмо. HTML необязателен.
+type Message struct {
+	ID      uuid.UUID
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+	// Attempts — сколько раз отправка уже не удалась.
+	Attempts int
+}
+
+// Recipient — получатель уведомлений и его настройки доставки.
+type Recipient struct {
+	UserID    uuid.UUID
+	Email     string
+	Name      string
+	EmailMode string
+}
+
+// PendingDigest — непрочитанные письмом уведомления пользователя.
+type PendingDigest struct {
+	Recipient       Recipient
+	NotificationIDs []uuid.UUID
+	Items           []DigestItem
+}
+
+// Sender доставляет письмо до почтового сервера.
+type Sender interface {
+	Send(ctx context.Context, m Message) error
+}
+
+type MailRepository interface {
+	Recipients(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]Recipient, error)
+	// EmailMuted — кто из users отключил письма для типа уведомлений typ.
+	EmailMuted(ctx context.Context, typ string, users []uuid.UUID) (map[uuid.UUID]bool, error)
+	// Enqueue ставит письма в очередь и отмечает уведомления, по которым
+	// они созданы, как отправленные письмом — одной транзакцией.
+	Enqueue(ctx context.Context, msgs []Message, notificationIDs []uuid.UUID, at time.Time) error
+	// PendingDigests — уведомления пользователей с дайджестом, созданные до
+	// before и ещё не отправленные письмом (с учётом настроек по типам).
+	PendingDigests(ctx context.Context, before time.Time) ([]PendingDigest, error)
+
+	DueMessages(ctx context.Context, now time.Time, limit int) ([]Message, error)
+	MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error
+	MarkRetry(ctx context.Context, id uuid.UUID, lastErr string, next time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, lastErr string) error
+}
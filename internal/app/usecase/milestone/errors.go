@@ -0,0 +1,15 @@
+package milestone
+
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
+
+var (
+	ErrNotFound            = apperr.NotFound("milestone_not_found", "milestone not found")
+	ErrProjectNotFound     = apperr.NotFound("project_not_found", "project not found")
+	ErrTaskNotFound        = apperr.NotFound("task_not_found", "task not found")
+	ErrForbidden           = apperr.Forbidden("forbidden", "forbidden")
+	ErrTaskProjectMismatch = apperr.Conflict("task_project_mismatch", "task belongs to another project")
+	ErrProjectArchived     = apperr.Conflict("project_archived", "project is archived")
+
+	ErrNameRequired  = apperr.Invalid("name_required", "name is required")
+	ErrInvalidStatus = apperr.Invalid("invalid_status", "invalid status")
+)
@@ -0,0 +1,229 @@
+package milestone
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type UseCase struct {
+	repo MilestoneRepository
+	now  func() time.Time
+}
+
+func NewMilestoneUsecase(repo MilestoneRepository) *UseCase {
+	return &UseCase{repo: repo, now: time.Now}
+}
+
+func (uc *UseCase) Create(ctx context.Context, projectID uuid.UUID, in CreateInput) (MilestoneDTO, error) {
+	if strings.TrimSpace(in.Name) == "" {
+		return MilestoneDTO{}, ErrNameRequired
+	}
+
+	ok, err := uc.repo.ProjectExists(ctx, projectID)
+	if err != nil {
+		return MilestoneDTO{}, err
+	}
+	if !ok {
+		return MilestoneDTO{}, ErrProjectNotFound
+	}
+	if err := uc.ensureMember(ctx, projectID, in.ActorID); err != nil {
+		return MilestoneDTO{}, err
+	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return MilestoneDTO{}, err
+	}
+
+	return uc.repo.Create(ctx, projectID, in)
+}
+
+func (uc *UseCase) GetByID(ctx context.Context, id uuid.UUID) (MilestoneDTO, error) {
+	return uc.repo.GetByID(ctx, id)
+}
+
+func (uc *UseCase) ListByProject(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[MilestoneDTO], error) {
+	p = p.Normalize()
+	return uc.repo.ListByProject(ctx, projectID, p)
+}
+
+func (uc *UseCase) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (MilestoneDTO, error) {
+	if in.Name != nil && strings.TrimSpace(*in.Name) == "" {
+		return MilestoneDTO{}, ErrNameRequired
+	}
+	if in.Status != nil {
+		switch *in.Status {
+		case "open", "closed":
+		default:
+			return MilestoneDTO{}, ErrInvalidStatus
+		}
+	}
+
+	m, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return MilestoneDTO{}, err
+	}
+	if err := uc.ensureMember(ctx, m.ProjectID, in.ActorID); err != nil {
+		return MilestoneDTO{}, err
+	}
+	if err := uc.ensureWritable(ctx, m.ProjectID); err != nil {
+		return MilestoneDTO{}, err
+	}
+
+	return uc.repo.Update(ctx, id, in)
+}
+
+func (uc *UseCase) Delete(ctx context.Context, id, actorID uuid.UUID) error {
+	m, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	role, err := uc.repo.GetMemberRole(ctx, m.ProjectID, actorID)
+	if err != nil {
+		return err
+	}
+	if role != "owner" {
+		return ErrForbidden
+	}
+	if err := uc.ensureWritable(ctx, m.ProjectID); err != nil {
+		return err
+	}
+
+	return uc.repo.DeleteMilestone(ctx, id)
+}
+
+func (uc *UseCase) AssignTask(ctx context.Context, milestoneID, taskID, actorID uuid.UUID) error {
+	m, err := uc.checkTaskAccess(ctx, milestoneID, taskID, actorID)
+	if err != nil {
+		return err
+	}
+	return uc.repo.SetTaskMilestone(ctx, taskID, &m.ID)
+}
+
+func (uc *UseCase) UnassignTask(ctx context.Context, milestoneID, taskID, actorID uuid.UUID) error {
+	if _, err := uc.checkTaskAccess(ctx, milestoneID, taskID, actorID); err != nil {
+		return err
+	}
+
+	cur, err := uc.repo.GetTaskMilestoneID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if cur == nil || *cur != milestoneID {
+		return ErrTaskNotFound
+	}
+
+	return uc.repo.SetTaskMilestone(ctx, taskID, nil)
+}
+
+func (uc *UseCase) Summary(ctx context.Context, id uuid.UUID) (SummaryDTO, error) {
+	m, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return SummaryDTO{}, err
+	}
+
+	counts, err := uc.repo.CountTasksByStatus(ctx, id)
+	if err != nil {
+		return SummaryDTO{}, err
+	}
+
+	return buildSummary(m, counts, uc.now()), nil
+}
+
+// checkTaskAccess проверяет, что задача и milestone из одного проекта,
+// actor является участником этого проекта, а проект не в архиве.
+func (uc *UseCase) checkTaskAccess(ctx context.Context, milestoneID, taskID, actorID uuid.UUID) (MilestoneDTO, error) {
+	m, err := uc.repo.GetByID(ctx, milestoneID)
+	if err != nil {
+		return MilestoneDTO{}, err
+	}
+
+	projectID, err := uc.repo.GetProjectIDByTask(ctx, taskID)
+	if err != nil {
+		return MilestoneDTO{}, err
+	}
+	if projectID != m.ProjectID {
+		return MilestoneDTO{}, ErrTaskProjectMismatch
+	}
+
+	if err := uc.ensureMember(ctx, m.ProjectID, actorID); err != nil {
+		return MilestoneDTO{}, err
+	}
+	if err := uc.ensureWritable(ctx, m.ProjectID); err != nil {
+		return MilestoneDTO{}, err
+	}
+
+	return m, nil
+}
+
+// ensureMember — создавать и менять milestones и их задачи может любой
+// участник проекта; удалять — только владелец (см. Delete).
+func (uc *UseCase) ensureMember(ctx context.Context, projectID, actorID uuid.UUID) error {
+	isMember, err := uc.repo.IsProjectMember(ctx, projectID, actorID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func (uc *UseCase) ensureWritable(ctx context.Context, projectID uuid.UUID) error {
+	archived, err := uc.repo.IsProjectArchived(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return ErrProjectArchived
+	}
+	return nil
+}
+
+// buildSummary считает прогресс milestone. Milestone считается on track,
+// если доля выполненных задач не отстаёт от доли прошедшего времени
+// между созданием milestone и его target date.
+func buildSummary(m MilestoneDTO, counts map[string]int, now time.Time) SummaryDTO {
+	byStatus := map[string]int{"todo": 0, "in_progress": 0, "done": 0}
+	total := 0
+	for status, n := range counts {
+		byStatus[status] = n
+		total += n
+	}
+
+	completion := 0
+	if total > 0 {
+		completion = byStatus["done"] * 100 / total
+	}
+
+	s := SummaryDTO{
+		Milestone:     m,
+		Total:         total,
+		TasksByStatus: byStatus,
+		Completion:    completion,
+		OnTrack:       true,
+	}
+
+	finished := m.Status == "closed" || (total > 0 && byStatus["done"] == total)
+	if finished || m.TargetDate == nil {
+		return s
+	}
+
+	if !now.Before(*m.TargetDate) {
+		s.Overdue = true
+		s.OnTrack = false
+		return s
+	}
+
+	span := m.TargetDate.Sub(m.CreatedAt)
+	if span > 0 {
+		expected := float64(now.Sub(m.CreatedAt)) / float64(span) * 100
+		s.OnTrack = float64(completion) >= expected
+	}
+
+	return s
+}
@@ -0,0 +1,345 @@
+package milestone
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	entmilestone "project-manager-dashboard-go/ent/milestone"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/projectuser"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type EntRepo struct {
+	client *ent.Client
+}
+
+func NewEntRepo(c *ent.Client) *EntRepo {
+	return &EntRepo{client: c}
+}
+
+func (r *EntRepo) Create(ctx context.Context, projectID uuid.UUID, in CreateInput) (MilestoneDTO, error) {
+	mc := r.client.Milestone.
+		Create().
+		SetProjectID(projectID).
+		SetName(in.Name)
+	if in.Description != nil {
+		mc.SetDescription(*in.Description)
+	}
+	if in.TargetDate != nil {
+		mc.SetTargetDate(*in.TargetDate)
+	}
+
+	m, err := mc.Save(ctx)
+	if err != nil {
+		return MilestoneDTO{}, err
+	}
+	return toDTO(m, projectID), nil
+}
+
+func (r *EntRepo) GetByID(ctx context.Context, id uuid.UUID) (MilestoneDTO, error) {
+	m, err := r.client.Milestone.
+		Query().
+		Where(entmilestone.IDEQ(id)).
+		WithProject().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return MilestoneDTO{}, ErrNotFound
+		}
+		return MilestoneDTO{}, err
+	}
+	if m.Edges.Project == nil {
+		return MilestoneDTO{}, ErrNotFound
+	}
+	return toDTO(m, m.Edges.Project.ID), nil
+}
+
+// milestoneKeys — milestones проекта в порядке создания. Целевая дата
+// меняется при правке и для курсора не годится.
+var milestoneKeys = page.Keyset{
+	{Field: entmilestone.FieldCreatedAt},
+	{Field: entmilestone.FieldID},
+}
+
+func (r *EntRepo) ListByProject(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[MilestoneDTO], error) {
+	c, err := milestoneKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[MilestoneDTO]{}, err
+	}
+
+	q := r.client.Milestone.
+		Query().
+		Where(entmilestone.HasProjectWith(project.IDEQ(projectID)))
+	var res page.Page[MilestoneDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[MilestoneDTO]{}, err
+	}
+
+	items, err := q.
+		Where(page.Where[predicate.Milestone](milestoneKeys, c)).
+		Order(page.Order[entmilestone.OrderOption](milestoneKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[MilestoneDTO]{}, err
+	}
+	items, res.Next, res.Prev = page.Cut(p, c, items, func(m *ent.Milestone) []any {
+		return []any{m.CreatedAt, m.ID}
+	})
+
+	out := make([]MilestoneDTO, 0, len(items))
+	for _, m := range items {
+		out = append(out, toDTO(m, projectID))
+	}
+	res.Items = out
+	return res, nil
+}
+
+func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (MilestoneDTO, error) {
+	mu := r.client.Milestone.UpdateOneID(id)
+
+	if in.Name != nil {
+		mu.SetName(*in.Name)
+	}
+	if in.Description != nil {
+		if *in.Description == "" {
+			mu.ClearDescription()
+		} else {
+			mu.SetDescription(*in.Description)
+		}
+	}
+	if in.Status != nil {
+		mu.SetStatus(entmilestone.Status(*in.Status))
+	}
+	if in.ClearTargetDate {
+		mu.ClearTargetDate()
+	} else if in.TargetDate != nil {
+		mu.SetTargetDate(*in.TargetDate)
+	}
+
+	if _, err := mu.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return MilestoneDTO{}, ErrNotFound
+		}
+		return MilestoneDTO{}, err
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+func (r *EntRepo) DeleteMilestone(ctx context.Context, id uuid.UUID) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Task.
+		Update().
+		Where(task.MilestoneIDEQ(id)).
+		ClearMilestoneID().
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Milestone.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = ErrNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *EntRepo) ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return r.client.Project.
+		Query().
+		Where(project.IDEQ(projectID)).
+		Exist(ctx)
+}
+
+func (r *EntRepo) IsProjectArchived(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return r.client.Project.
+		Query().
+		Where(project.IDEQ(projectID), project.ArchivedAtNotNil()).
+		Exist(ctx)
+}
+
+func (r *EntRepo) IsProjectMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error) {
+	return r.client.ProjectUser.
+		Query().
+		Where(
+			projectuser.HasProjectWith(project.IDEQ(projectID)),
+			projectuser.HasUserWith(user.IDEQ(userID)),
+		).
+		Exist(ctx)
+}
+
+func (r *EntRepo) GetMemberRole(ctx context.Context, projectID, userID uuid.UUID) (string, error) {
+	m, err := r.client.ProjectUser.
+		Query().
+		Where(
+			projectuser.HasProjectWith(project.IDEQ(projectID)),
+			projectuser.HasUserWith(user.IDEQ(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrForbidden
+		}
+		return "", err
+	}
+	return string(m.Role), nil
+}
+
+func (r *EntRepo) GetProjectIDByTask(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error) {
+	pt, err := r.client.ProjectTask.
+		Query().
+		Where(projecttask.HasTaskWith(task.IDEQ(taskID))).
+		WithProject().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, ErrTaskNotFound
+		}
+		return uuid.Nil, err
+	}
+	if pt.Edges.Project == nil {
+		return uuid.Nil, ErrTaskNotFound
+	}
+	return pt.Edges.Project.ID, nil
+}
+
+func (r *EntRepo) GetTaskMilestoneID(ctx context.Context, taskID uuid.UUID) (*uuid.UUID, error) {
+	t, err := r.client.Task.Get(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, err
+	}
+	return t.MilestoneID, nil
+}
+
+// SetTaskMilestone привязывает задачу к milestone (nil — отвязывает) и
+// записывает изменение в ленту активности задачи.
+func (r *EntRepo) SetTaskMilestone(ctx context.Context, taskID uuid.UUID, milestoneID *uuid.UUID) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	t, err := tx.Task.Get(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = ErrTaskNotFound
+		}
+		return err
+	}
+
+	from, err := milestoneName(ctx, tx, t.MilestoneID)
+	if err != nil {
+		return err
+	}
+	to, err := milestoneName(ctx, tx, milestoneID)
+	if err != nil {
+		return err
+	}
+
+	tu := tx.Task.UpdateOneID(taskID)
+	if milestoneID != nil {
+		tu.SetMilestoneID(*milestoneID)
+	} else {
+		tu.ClearMilestoneID()
+	}
+	if err = tu.Exec(ctx); err != nil {
+		return err
+	}
+
+	ac := tx.TaskActivity.
+		Create().
+		SetTaskID(taskID).
+		SetKind(taskactivity.KindMilestoneChanged).
+		SetNillableFromValue(from).
+		SetNillableToValue(to)
+	if actorID, ok := audit.ActorFrom(ctx); ok {
+		ac.SetActorID(actorID)
+	}
+	if err = ac.Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func milestoneName(ctx context.Context, tx *ent.Tx, id *uuid.UUID) (*string, error) {
+	if id == nil {
+		return nil, nil
+	}
+	m, err := tx.Milestone.Get(ctx, *id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &m.Name, nil
+}
+
+func (r *EntRepo) CountTasksByStatus(ctx context.Context, milestoneID uuid.UUID) (map[string]int, error) {
+	var rows []struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+
+	err := r.client.Task.
+		Query().
+		Where(task.MilestoneIDEQ(milestoneID)).
+		GroupBy(task.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]int, len(rows))
+	for _, row := range rows {
+		out[row.Status] = row.Count
+	}
+	return out, nil
+}
+
+func toDTO(m *ent.Milestone, projectID uuid.UUID) MilestoneDTO {
+	return MilestoneDTO{
+		ID:          m.ID,
+		ProjectID:   projectID,
+		Name:        m.Name,
+		Description: m.Description,
+		Status:      string(m.Status),
+		TargetDate:  m.TargetDate,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
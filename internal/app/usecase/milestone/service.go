@@ -0,0 +1,21 @@
+package milestone
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type MilestoneService interface {
+	Create(ctx context.Context, projectID uuid.UUID, in CreateInput) (MilestoneDTO, error)
+	GetByID(ctx context.Context, id uuid.UUID) (MilestoneDTO, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[MilestoneDTO], error)
+	Update(ctx context.Context, id uuid.UUID, in UpdateInput) (MilestoneDTO, error)
+	Delete(ctx context.Context, id, actorID uuid.UUID) error
+
+	AssignTask(ctx context.Context, milestoneID, taskID, actorID uuid.UUID) error
+	UnassignTask(ctx context.Context, milestoneID, taskID, actorID uuid.UUID) error
+	Summary(ctx context.Context, id uuid.UUID) (SummaryDTO, error)
+}
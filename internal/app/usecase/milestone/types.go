@@ -0,0 +1,65 @@
+package milestone
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type CreateInput struct {
+	Name        string
+	Description *string
+	TargetDate  *time.Time
+	// ActorID — автор изменения; должен быть участником проекта.
+	ActorID uuid.UUID
+}
+
+type UpdateInput struct {
+	Name        *string
+	Description *string
+	Status      *string
+	TargetDate  *time.Time
+	// ClearTargetDate снимает срок; TargetDate при этом игнорируется.
+	ClearTargetDate bool
+	ActorID         uuid.UUID
+}
+
+type MilestoneDTO struct {
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	Name        string
+	Description *string
+	Status      string
+	TargetDate  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type SummaryDTO struct {
+	Milestone MilestoneDTO
+
+	Total         int
+	TasksByStatus map[string]int
+	Completion    int // процент задач в статусе done
+	OnTrack       bool
+	Overdue       bool
+}
+
+type MilestoneRepository interface {
+	Create(ctx context.Context, projectID uuid.UUID, in CreateInput) (MilestoneDTO, error)
+	GetByID(ctx context.Context, id uuid.UUID) (MilestoneDTO, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[MilestoneDTO], error)
+	Update(ctx context.Context, id uuid.UUID, in UpdateInput) (MilestoneDTO, error)
+	DeleteMilestone(ctx context.Context, id uuid.UUID) error
+	ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error)
+	IsProjectArchived(ctx context.Context, projectID uuid.UUID) (bool, error)
+	IsProjectMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error)
+	GetMemberRole(ctx context.Context, projectID, userID uuid.UUID) (string, error)
+	GetProjectIDByTask(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error)
+	GetTaskMilestoneID(ctx context.Context, taskID uuid.UUID) (*uuid.UUID, error)
+	SetTaskMilestone(ctx context.Context, taskID uuid.UUID, milestoneID *uuid.UUID) error
+	CountTasksByStatus(ctx context.Context, milestoneID uuid.UUID) (map[string]int, error)
+}
@@ -0,0 +1,11 @@
+package notification
+
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
+
+var (
+	ErrNotFound     = apperr.NotFound("notification_not_found", "notification not found")
+	ErrUserNotFound = apperr.NotFound("user_not_found", "user not found")
+	ErrForbidden    = apperr.Forbidden("forbidden", "forbidden")
+	ErrUnknownType  = apperr.Invalid("unknown_notification_type", "unknown notification type")
+	ErrInvalidMode  = apperr.Invalid("invalid_email_mode", "invalid email mode")
+)
@@ -0,0 +1,199 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type UseCase struct {
+	repo   NotificationRepository
+	mailer Mailer
+}
+
+// NewNotificationUsecase: mailer может быть nil — тогда уведомления
+// только появляются во входящих.
+func NewNotificationUsecase(repo NotificationRepository, mailer Mailer) *UseCase {
+	return &UseCase{repo: repo, mailer: mailer}
+}
+
+// List возвращает уведомления пользователя: сначала непрочитанные,
+// внутри групп — новые первыми.
+func (uc *UseCase) List(ctx context.Context, userID uuid.UUID, f ListFilter, p page.Params) (page.Page[NotificationDTO], error) {
+	p = p.Normalize()
+	if err := uc.ensureUser(ctx, userID); err != nil {
+		return page.Page[NotificationDTO]{}, err
+	}
+	return uc.repo.List(ctx, userID, f, p)
+}
+
+func (uc *UseCase) MarkRead(ctx context.Context, id, userID uuid.UUID) (NotificationDTO, error) {
+	n, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return NotificationDTO{}, err
+	}
+	if n.ReadAt != nil {
+		return n, nil
+	}
+	now := time.Now()
+	return uc.repo.SetReadAt(ctx, id, &now)
+}
+
+func (uc *UseCase) MarkUnread(ctx context.Context, id, userID uuid.UUID) (NotificationDTO, error) {
+	n, err := uc.getOwned(ctx, id, userID)
+	if err != nil {
+		return NotificationDTO{}, err
+	}
+	if n.ReadAt == nil {
+		return n, nil
+	}
+	return uc.repo.SetReadAt(ctx, id, nil)
+}
+
+func (uc *UseCase) MarkAllRead(ctx context.Context, userID uuid.UUID) (int, error) {
+	if err := uc.ensureUser(ctx, userID); err != nil {
+		return 0, err
+	}
+	return uc.repo.MarkAllRead(ctx, userID, time.Now())
+}
+
+// Settings возвращает настройки по всем типам; несохранённые включены.
+func (uc *UseCase) Settings(ctx context.Context, userID uuid.UUID) (Settings, error) {
+	if err := uc.ensureUser(ctx, userID); err != nil {
+		return Settings{}, err
+	}
+
+	mode, err := uc.repo.EmailMode(ctx, userID)
+	if err != nil {
+		return Settings{}, err
+	}
+	saved, err := uc.repo.Preferences(ctx, userID)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	out := Settings{EmailMode: mode, Types: make([]Preference, 0, len(Types))}
+	for _, t := range Types {
+		p, ok := saved[t]
+		if !ok {
+			p = Preference{Type: t, InApp: true, Email: true}
+		}
+		out.Types = append(out.Types, p)
+	}
+	return out, nil
+}
+
+func (uc *UseCase) UpdateSettings(ctx context.Context, userID uuid.UUID, upd SettingsUpdate) (Settings, error) {
+	if upd.EmailMode != nil {
+		switch *upd.EmailMode {
+		case EmailInstant, EmailDaily, EmailOff:
+		default:
+			return Settings{}, ErrInvalidMode
+		}
+	}
+	for t := range upd.InApp {
+		if !knownType(t) {
+			return Settings{}, ErrUnknownType
+		}
+	}
+	for t := range upd.Email {
+		if !knownType(t) {
+			return Settings{}, ErrUnknownType
+		}
+	}
+	if err := uc.ensureUser(ctx, userID); err != nil {
+		return Settings{}, err
+	}
+
+	if err := uc.repo.UpdateSettings(ctx, userID, upd); err != nil {
+		return Settings{}, err
+	}
+	return uc.Settings(ctx, userID)
+}
+
+// Notify создаёт уведомления по событию. Автор события и пользователи,
+// отключившие этот тип, пропускаются.
+func (uc *UseCase) Notify(ctx context.Context, ev Event) error {
+	if !knownType(ev.Type) {
+		return ErrUnknownType
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ev.Recipients))
+	recipients := make([]uuid.UUID, 0, len(ev.Recipients))
+	for _, id := range ev.Recipients {
+		if seen[id] || (ev.ActorID != nil && *ev.ActorID == id) {
+			continue
+		}
+		seen[id] = true
+		recipients = append(recipients, id)
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	muted, err := uc.repo.MutedUsers(ctx, ev.Type, recipients)
+	if err != nil {
+		return err
+	}
+
+	items := make([]NotificationDTO, 0, len(recipients))
+	for _, id := range recipients {
+		if muted[id] {
+			continue
+		}
+		items = append(items, NotificationDTO{
+			UserID:    id,
+			Type:      ev.Type,
+			Message:   ev.Message,
+			ActorID:   ev.ActorID,
+			ProjectID: ev.ProjectID,
+			TaskID:    ev.TaskID,
+		})
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	created, err := uc.repo.CreateBulk(ctx, items)
+	if err != nil {
+		return err
+	}
+	if uc.mailer != nil {
+		return uc.mailer.Deliver(ctx, created)
+	}
+	return nil
+}
+
+func (uc *UseCase) getOwned(ctx context.Context, id, userID uuid.UUID) (NotificationDTO, error) {
+	n, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return NotificationDTO{}, err
+	}
+	if n.UserID != userID {
+		return NotificationDTO{}, ErrForbidden
+	}
+	return n, nil
+}
+
+func (uc *UseCase) ensureUser(ctx context.Context, userID uuid.UUID) error {
+	ok, err := uc.repo.UserExists(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func knownType(t string) bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,275 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/notification"
+	"project-manager-dashboard-go/ent/notificationpreference"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type EntRepo struct {
+	client *ent.Client
+}
+
+func NewEntRepo(c *ent.Client) *EntRepo {
+	return &EntRepo{client: c}
+}
+
+func (r *EntRepo) UserExists(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return r.client.User.Query().Where(user.IDEQ(userID)).Exist(ctx)
+}
+
+// notificationKeys — сначала непрочитанные, новые первыми; за ними
+// прочитанные, недавно прочитанные первыми.
+var notificationKeys = page.Keyset{
+	{Field: notification.FieldReadAt, Desc: true, Nulls: page.NullsFirst},
+	{Field: notification.FieldCreatedAt, Desc: true},
+	{Field: notification.FieldID, Desc: true},
+}
+
+func (r *EntRepo) List(ctx context.Context, userID uuid.UUID, f ListFilter, p page.Params) (page.Page[NotificationDTO], error) {
+	c, err := notificationKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[NotificationDTO]{}, err
+	}
+
+	q := r.client.Notification.
+		Query().
+		Where(notification.UserIDEQ(userID))
+	if f.UnreadOnly {
+		q.Where(notification.ReadAtIsNil())
+	}
+
+	var res page.Page[NotificationDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[NotificationDTO]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.Notification](notificationKeys, c)).
+		Order(page.Order[notification.OrderOption](notificationKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[NotificationDTO]{}, err
+	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(n *ent.Notification) []any {
+		return []any{n.ReadAt, n.CreatedAt, n.ID}
+	})
+
+	out := make([]NotificationDTO, 0, len(rows))
+	for _, n := range rows {
+		out = append(out, toDTO(n))
+	}
+	res.Items = out
+	return res, nil
+}
+
+func (r *EntRepo) Get(ctx context.Context, id uuid.UUID) (NotificationDTO, error) {
+	n, err := r.client.Notification.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return NotificationDTO{}, ErrNotFound
+		}
+		return NotificationDTO{}, err
+	}
+	return toDTO(n), nil
+}
+
+func (r *EntRepo) SetReadAt(ctx context.Context, id uuid.UUID, readAt *time.Time) (NotificationDTO, error) {
+	u := r.client.Notification.UpdateOneID(id)
+	if readAt != nil {
+		u.SetReadAt(*readAt)
+	} else {
+		u.ClearReadAt()
+	}
+
+	n, err := u.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return NotificationDTO{}, ErrNotFound
+		}
+		return NotificationDTO{}, err
+	}
+	return toDTO(n), nil
+}
+
+func (r *EntRepo) MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error) {
+	return r.client.Notification.
+		Update().
+		Where(
+			notification.UserIDEQ(userID),
+			notification.ReadAtIsNil(),
+		).
+		SetReadAt(at).
+		Save(ctx)
+}
+
+func (r *EntRepo) CreateBulk(ctx context.Context, items []NotificationDTO) ([]NotificationDTO, error) {
+	builders := make([]*ent.NotificationCreate, 0, len(items))
+	for _, it := range items {
+		builders = append(builders, r.client.Notification.
+			Create().
+			SetUserID(it.UserID).
+			SetType(notification.Type(it.Type)).
+			SetMessage(it.Message).
+			SetNillableActorID(it.ActorID).
+			SetNillableProjectID(it.ProjectID).
+			SetNillableTaskID(it.TaskID))
+	}
+
+	rows, err := r.client.Notification.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]NotificationDTO, 0, len(rows))
+	for _, n := range rows {
+		out = append(out, toDTO(n))
+	}
+	return out, nil
+}
+
+func (r *EntRepo) Preferences(ctx context.Context, userID uuid.UUID) (map[string]Preference, error) {
+	rows, err := r.client.NotificationPreference.
+		Query().
+		Where(notificationpreference.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]Preference, len(rows))
+	for _, p := range rows {
+		out[string(p.Type)] = Preference{Type: string(p.Type), InApp: p.InApp, Email: p.Email}
+	}
+	return out, nil
+}
+
+func (r *EntRepo) EmailMode(ctx context.Context, userID uuid.UUID) (string, error) {
+	u, err := r.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrUserNotFound
+		}
+		return "", err
+	}
+	return string(u.EmailMode), nil
+}
+
+func (r *EntRepo) UpdateSettings(ctx context.Context, userID uuid.UUID, upd SettingsUpdate) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if upd.EmailMode != nil {
+		err = tx.User.
+			UpdateOneID(userID).
+			SetEmailMode(user.EmailMode(*upd.EmailMode)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	types := make(map[string]bool, len(upd.InApp)+len(upd.Email))
+	for t := range upd.InApp {
+		types[t] = true
+	}
+	for t := range upd.Email {
+		types[t] = true
+	}
+
+	for t := range types {
+		var p *ent.NotificationPreference
+		p, err = tx.NotificationPreference.
+			Query().
+			Where(
+				notificationpreference.UserIDEQ(userID),
+				notificationpreference.TypeEQ(notificationpreference.Type(t)),
+			).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if p == nil {
+			c := tx.NotificationPreference.
+				Create().
+				SetUserID(userID).
+				SetType(notificationpreference.Type(t))
+			if v, ok := upd.InApp[t]; ok {
+				c.SetInApp(v)
+			}
+			if v, ok := upd.Email[t]; ok {
+				c.SetEmail(v)
+			}
+			if err = c.Exec(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+
+		u := tx.NotificationPreference.UpdateOne(p)
+		if v, ok := upd.InApp[t]; ok {
+			u.SetInApp(v)
+		}
+		if v, ok := upd.Email[t]; ok {
+			u.SetEmail(v)
+		}
+		if err = u.Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *EntRepo) MutedUsers(ctx context.Context, typ string, users []uuid.UUID) (map[uuid.UUID]bool, error) {
+	rows, err := r.client.NotificationPreference.
+		Query().
+		Where(
+			notificationpreference.UserIDIn(users...),
+			notificationpreference.TypeEQ(notificationpreference.Type(typ)),
+			notificationpreference.InApp(false),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[uuid.UUID]bool, len(rows))
+	for _, p := range rows {
+		out[p.UserID] = true
+	}
+	return out, nil
+}
+
+func toDTO(n *ent.Notification) NotificationDTO {
+	return NotificationDTO{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Type:      string(n.Type),
+		Message:   n.Message,
+		ActorID:   n.ActorID,
+		ProjectID: n.ProjectID,
+		TaskID:    n.TaskID,
+		ReadAt:    n.ReadAt,
+		EmailedAt: n.EmailedAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
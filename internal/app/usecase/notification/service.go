@@ -0,0 +1,18 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type NotificationService interface {
+	List(ctx context.Context, userID uuid.UUID, f ListFilter, p page.Params) (page.Page[NotificationDTO], error)
+	MarkRead(ctx context.Context, id, userID uuid.UUID) (NotificationDTO, error)
+	MarkUnread(ctx context.Context, id, userID uuid.UUID) (NotificationDTO, error)
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int, error)
+	Settings(ctx context.Context, userID uuid.UUID) (Settings, error)
+	UpdateSettings(ctx context.Context, userID uuid.UUID, upd SettingsUpdate) (Settings, error)
+}
@@ -0,0 +1,104 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+// Типы уведомлений.
+const (
+	TypeTaskAssigned   = "task_assigned"
+	TypeProjectInvited = "project_invited"
+	TypeTaskChanged    = "task_changed"
+	TypeTaskDueSoon    = "task_due_soon"
+	TypeTaskOverdue    = "task_overdue"
+	TypeTaskMentioned  = "task_mentioned"
+)
+
+// Режимы доставки уведомлений письмом.
+const (
+	EmailInstant = "instant"
+	EmailDaily   = "daily"
+	EmailOff     = "off"
+)
+
+// Types — все типы в порядке вывода настроек.
+var Types = []string{
+	TypeTaskAssigned,
+	TypeProjectInvited,
+	TypeTaskChanged,
+	TypeTaskDueSoon,
+	TypeTaskOverdue,
+	TypeTaskMentioned,
+}
+
+type NotificationDTO struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Type      string
+	Message   string
+	ActorID   *uuid.UUID
+	ProjectID *uuid.UUID
+	TaskID    *uuid.UUID
+	ReadAt    *time.Time
+	EmailedAt *time.Time
+	CreatedAt time.Time
+}
+
+// Event — событие, о котором нужно уведомить получателей. Автор события
+// уведомление о собственном действии не получает.
+type Event struct {
+	Type       string
+	Recipients []uuid.UUID
+	ActorID    *uuid.UUID
+	ProjectID  *uuid.UUID
+	TaskID     *uuid.UUID
+	Message    string
+}
+
+type Preference struct {
+	Type  string
+	InApp bool
+	Email bool
+}
+
+// Settings — настройки уведомлений пользователя.
+type Settings struct {
+	EmailMode string
+	Types     []Preference
+}
+
+// SettingsUpdate меняет только переданные поля и типы.
+type SettingsUpdate struct {
+	EmailMode *string
+	InApp     map[string]bool
+	Email     map[string]bool
+}
+
+// Mailer отправляет письма по созданным уведомлениям.
+type Mailer interface {
+	Deliver(ctx context.Context, items []NotificationDTO) error
+}
+
+type ListFilter struct {
+	UnreadOnly bool
+}
+
+type NotificationRepository interface {
+	UserExists(ctx context.Context, userID uuid.UUID) (bool, error)
+	List(ctx context.Context, userID uuid.UUID, f ListFilter, p page.Params) (page.Page[NotificationDTO], error)
+	Get(ctx context.Context, id uuid.UUID) (NotificationDTO, error)
+	SetReadAt(ctx context.Context, id uuid.UUID, readAt *time.Time) (NotificationDTO, error)
+	MarkAllRead(ctx context.Context, userID uuid.UUID, at time.Time) (int, error)
+	CreateBulk(ctx context.Context, items []NotificationDTO) ([]NotificationDTO, error)
+	// Preferences возвращает только сохранённые настройки по типам.
+	Preferences(ctx context.Context, userID uuid.UUID) (map[string]Preference, error)
+	EmailMode(ctx context.Context, userID uuid.UUID) (string, error)
+	UpdateSettings(ctx context.Context, userID uuid.UUID, upd SettingsUpdate) error
+	// MutedUsers — кто из users отключил уведомления типа typ.
+	MutedUsers(ctx context.Context, typ string, users []uuid.UUID) (map[uuid.UUID]bool, error)
+}
@@ -0,0 +1,87 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const relayBatch = 100
+
+type sinkEntry struct {
+	name string
+	sink Sink
+}
+
+type UseCase struct {
+	repo       OutboxRepository
+	sinks      []sinkEntry
+	gapTimeout time.Duration
+}
+
+// NewOutboxUsecase: gapTimeout — сколько релей ждёт событие с пропущенным
+// номером, прежде чем счесть его транзакцию откатившейся.
+func NewOutboxUsecase(repo OutboxRepository, gapTimeout time.Duration) *UseCase {
+	return &UseCase{repo: repo, gapTimeout: gapTimeout}
+}
+
+// Register добавляет получателя. Вызывается до запуска релея.
+func (uc *UseCase) Register(name string, s Sink) {
+	uc.sinks = append(uc.sinks, sinkEntry{name: name, sink: s})
+}
+
+// Relay публикует неотправленные события по порядку. На первой ошибке
+// проход останавливается, чтобы следующие события не обогнали упавшее:
+// оно будет отправлено всем получателям заново на следующем проходе.
+//
+// Seq выдаётся при вставке, а транзакции фиксируются в любом порядке:
+// пока транзакция с меньшим номером не зафиксирована, на его месте дыра.
+// Релей публикует только непрерывное начало очереди и ждёт у дыры, пока
+// она не заполнится. Дыра, которой больше gapTimeout, считается откатом;
+// если та транзакция всё же зафиксируется позже, её событие опубликуется
+// вне порядка Seq, но не потеряется: порядок публикации задаёт
+// PublishedSeq, а получатели отбрасывают повторы по ID.
+func (uc *UseCase) Relay(ctx context.Context) (int, error) {
+	events, err := uc.repo.Unpublished(ctx, relayBatch)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+	highest, err := uc.repo.HighestPublished(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, ev := range events {
+		// Номер события после дыры выдан позже номера в дыре, поэтому
+		// возраст события — нижняя оценка возраста незавершённой транзакции.
+		if ev.Seq > highest+1 && time.Since(ev.CreatedAt) < uc.gapTimeout {
+			break
+		}
+
+		if ev.PublishedSeq == 0 {
+			if ev.PublishedSeq, err = uc.repo.AssignPublishedSeq(ctx, ev.Seq); err != nil {
+				return published, err
+			}
+		}
+		for _, s := range uc.sinks {
+			if err := s.sink.Handle(ctx, ev); err != nil {
+				err = fmt.Errorf("sink %s: event %d (%s): %w", s.name, ev.Seq, ev.Type, err)
+				if markErr := uc.repo.MarkFailed(ctx, ev.Seq, err.Error()); markErr != nil {
+					return published, markErr
+				}
+				return published, err
+			}
+		}
+
+		if err := uc.repo.MarkPublished(ctx, ev.Seq, time.Now()); err != nil {
+			return published, err
+		}
+		highest = max(highest, ev.Seq)
+		published++
+	}
+	return published, nil
+}
@@ -0,0 +1,121 @@
+package outbox
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// memRepo — outbox в памяти: events — зафиксированные события.
+type memRepo struct {
+	events map[int64]*Event
+	done   map[int64]bool
+	nextPS int64
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{events: map[int64]*Event{}, done: map[int64]bool{}}
+}
+
+// commit — транзакция с номером seq зафиксирована age назад.
+func (r *memRepo) commit(seq int64, age time.Duration) {
+	r.events[seq] = &Event{Seq: seq, ID: uuid.New(), CreatedAt: time.Now().Add(-age)}
+}
+
+func (r *memRepo) Unpublished(_ context.Context, limit int) ([]Event, error) {
+	var out []Event
+	for _, ev := range r.events {
+		if !r.done[ev.Seq] {
+			out = append(out, *ev)
+		}
+	}
+	slices.SortFunc(out, func(a, b Event) int { return int(a.Seq - b.Seq) })
+	return out[:min(len(out), limit)], nil
+}
+
+func (r *memRepo) HighestPublished(context.Context) (int64, error) {
+	var h int64
+	for _, ev := range r.events {
+		if ev.PublishedSeq != 0 {
+			h = max(h, ev.Seq)
+		}
+	}
+	return h, nil
+}
+
+func (r *memRepo) AssignPublishedSeq(_ context.Context, seq int64) (int64, error) {
+	r.nextPS++
+	r.events[seq].PublishedSeq = r.nextPS
+	return r.nextPS, nil
+}
+
+func (r *memRepo) MarkPublished(_ context.Context, seq int64, _ time.Time) error {
+	r.done[seq] = true
+	return nil
+}
+
+func (r *memRepo) MarkFailed(context.Context, int64, string) error { return nil }
+
+// recorder запоминает, в каком порядке пришли события.
+type recorder struct{ got [][2]int64 }
+
+func (s *recorder) Handle(_ context.Context, ev Event) error {
+	s.got = append(s.got, [2]int64{ev.Seq, ev.PublishedSeq})
+	return nil
+}
+
+func TestRelayWaitsForGap(t *testing.T) {
+	repo := newMemRepo()
+	sink := &recorder{}
+	uc := NewOutboxUsecase(repo, time.Minute)
+	uc.Register("rec", sink)
+	ctx := context.Background()
+
+	// Транзакция с номером 2 ещё не зафиксирована, 3 уже видна.
+	repo.commit(1, 0)
+	repo.commit(3, 0)
+	if n, err := uc.Relay(ctx); err != nil || n != 1 {
+		t.Fatalf("Relay = %d, %v, want 1, nil", n, err)
+	}
+
+	repo.commit(2, 0)
+	if n, err := uc.Relay(ctx); err != nil || n != 2 {
+		t.Fatalf("Relay = %d, %v, want 2, nil", n, err)
+	}
+
+	want := [][2]int64{{1, 1}, {2, 2}, {3, 3}}
+	if !slices.Equal(sink.got, want) {
+		t.Errorf("published %v, want %v", sink.got, want)
+	}
+}
+
+func TestRelaySkipsStaleGap(t *testing.T) {
+	repo := newMemRepo()
+	sink := &recorder{}
+	uc := NewOutboxUsecase(repo, time.Minute)
+	uc.Register("rec", sink)
+	ctx := context.Background()
+
+	// Номер 2 пропущен дольше таймаута: транзакция считается откатившейся.
+	repo.commit(1, 2*time.Minute)
+	repo.commit(3, 2*time.Minute)
+	if n, err := uc.Relay(ctx); err != nil || n != 2 {
+		t.Fatalf("Relay = %d, %v, want 2, nil", n, err)
+	}
+
+	// Транзакция всё же зафиксировалась: событие не теряется и получает
+	// следующий номер публикации.
+	repo.commit(2, 0)
+	repo.commit(4, 0)
+	if n, err := uc.Relay(ctx); err != nil || n != 2 {
+		t.Fatalf("Relay = %d, %v, want 2, nil", n, err)
+	}
+
+	want := [][2]int64{{1, 1}, {3, 2}, {2, 3}, {4, 4}}
+	if !slices.Equal(sink.got, want) {
+		t.Errorf("published %v, want %v", sink.got, want)
+	}
+}
@@ -0,0 +1,35 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+)
+
+// Record пишет событие в outbox. c — клиент транзакции, в которой
+// сохраняется само изменение: событие появится только вместе с ним.
+func Record(ctx context.Context, c *ent.Client, d Draft) error {
+	payload, err := json.Marshal(d.Data)
+	if err != nil {
+		return err
+	}
+
+	actorID := d.ActorID
+	if actorID == nil {
+		if id, ok := audit.ActorFrom(ctx); ok {
+			actorID = &id
+		}
+	}
+
+	return c.OutboxEvent.
+		Create().
+		SetType(d.Type).
+		SetAggregateType(d.AggregateType).
+		SetAggregateID(d.AggregateID).
+		SetNillableProjectID(d.ProjectID).
+		SetNillableActorID(actorID).
+		SetPayload(string(payload)).
+		Exec(ctx)
+}
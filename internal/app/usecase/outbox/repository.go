@@ -0,0 +1,206 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/outboxevent"
+)
+
+type EntRepo struct {
+	client *ent.Client
+}
+
+func NewEntRepo(c *ent.Client) *EntRepo {
+	return &EntRepo{client: c}
+}
+
+func (r *EntRepo) Unpublished(ctx context.Context, limit int) ([]Event, error) {
+	rows, err := r.client.OutboxEvent.
+		Query().
+		Where(outboxevent.PublishedAtIsNil()).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toEvents(rows), nil
+}
+
+// Since возвращает события проекта с номером публикации больше afterSeq.
+// Ещё не получившие номер события сюда не попадают: они придут через шину.
+func (r *EntRepo) Since(ctx context.Context, projectID uuid.UUID, afterSeq int64, limit int) ([]Event, error) {
+	rows, err := r.client.OutboxEvent.
+		Query().
+		Where(
+			outboxevent.ProjectIDEQ(projectID),
+			outboxevent.PublishedSeqGT(afterSeq),
+		).
+		Order(ent.Asc(outboxevent.FieldPublishedSeq)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toEvents(rows), nil
+}
+
+// Range возвращает события всех проектов с номерами публикации в
+// (after, upTo].
+func (r *EntRepo) Range(ctx context.Context, after, upTo int64, limit int) ([]Event, error) {
+	rows, err := r.client.OutboxEvent.
+		Query().
+		Where(
+			outboxevent.PublishedSeqGT(after),
+			outboxevent.PublishedSeqLTE(upTo),
+		).
+		Order(ent.Asc(outboxevent.FieldPublishedSeq)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toEvents(rows), nil
+}
+
+// LastPublished — последний выданный номер публикации, 0 — если их нет.
+func (r *EntRepo) LastPublished(ctx context.Context) (int64, error) {
+	e, err := r.client.OutboxEvent.
+		Query().
+		Where(outboxevent.PublishedSeqNotNil()).
+		Order(ent.Desc(outboxevent.FieldPublishedSeq)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return *e.PublishedSeq, nil
+}
+
+func (r *EntRepo) HighestPublished(ctx context.Context) (int64, error) {
+	e, err := r.client.OutboxEvent.
+		Query().
+		Where(outboxevent.PublishedSeqNotNil()).
+		Order(ent.Desc(outboxevent.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return e.ID, nil
+}
+
+// AssignPublishedSeq вызывается только релеем под блокировкой, поэтому
+// следующий номер — просто максимум плюс один; уникальный индекс
+// защищает от повтора, если блокировка всё же нарушится.
+func (r *EntRepo) AssignPublishedSeq(ctx context.Context, seq int64) (int64, error) {
+	var next int64 = 1
+	last, err := r.client.OutboxEvent.
+		Query().
+		Where(outboxevent.PublishedSeqNotNil()).
+		Order(ent.Desc(outboxevent.FieldPublishedSeq)).
+		First(ctx)
+	switch {
+	case err == nil:
+		next = *last.PublishedSeq + 1
+	case !ent.IsNotFound(err):
+		return 0, err
+	}
+
+	err = r.client.OutboxEvent.
+		UpdateOneID(seq).
+		SetPublishedSeq(next).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return next, nil
+}
+
+// Exists — есть ли ещё событие с номером публикации seq.
+func (r *EntRepo) Exists(ctx context.Context, seq int64) (bool, error) {
+	return r.client.OutboxEvent.
+		Query().
+		Where(outboxevent.PublishedSeqEQ(seq)).
+		Exist(ctx)
+}
+
+func (r *EntRepo) MarkPublished(ctx context.Context, seq int64, at time.Time) error {
+	return r.client.OutboxEvent.
+		UpdateOneID(seq).
+		SetPublishedAt(at).
+		AddAttempts(1).
+		ClearLastError().
+		Exec(ctx)
+}
+
+func (r *EntRepo) MarkFailed(ctx context.Context, seq int64, lastErr string) error {
+	return r.client.OutboxEvent.
+		UpdateOneID(seq).
+		AddAttempts(1).
+		SetLastError(lastErr).
+		Exec(ctx)
+}
+
+// PurgeDeleted удаляет опубликованные события старше before. Строки, по
+// которым HighestPublished и LastPublished находят свои максимумы, остаются:
+// без них после перезапуска релей ждал бы мнимую дыру до таймаута, а
+// номера публикации пошли бы заново с единицы.
+func (r *EntRepo) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	var keep []int64
+	for _, field := range []string{outboxevent.FieldID, outboxevent.FieldPublishedSeq} {
+		e, err := r.client.OutboxEvent.
+			Query().
+			Where(outboxevent.PublishedSeqNotNil()).
+			Order(ent.Desc(field)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				break
+			}
+			return 0, err
+		}
+		keep = append(keep, e.ID)
+	}
+
+	return r.client.OutboxEvent.
+		Delete().
+		Where(
+			outboxevent.PublishedAtLT(before),
+			outboxevent.IDNotIn(keep...),
+		).
+		Exec(ctx)
+}
+
+func toEvents(rows []*ent.OutboxEvent) []Event {
+	out := make([]Event, 0, len(rows))
+	for _, e := range rows {
+		var published int64
+		if e.PublishedSeq != nil {
+			published = *e.PublishedSeq
+		}
+		out = append(out, Event{
+			Seq:           e.ID,
+			PublishedSeq:  published,
+			ID:            e.EventID,
+			Type:          e.Type,
+			AggregateType: e.AggregateType,
+			AggregateID:   e.AggregateID,
+			ProjectID:     e.ProjectID,
+			ActorID:       e.ActorID,
+			Payload:       json.RawMessage(e.Payload),
+			CreatedAt:     e.CreatedAt,
+			Attempts:      e.Attempts,
+		})
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+
+	"project-manager-dashboard-go/ent/enttest"
+)
+
+func TestPurgeKeepsHighWaterMarks(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+	ctx := context.Background()
+	repo := NewEntRepo(client)
+
+	if n, err := repo.PurgeDeleted(ctx, time.Now()); err != nil || n != 0 {
+		t.Fatalf("purge of empty outbox: n=%d err=%v", n, err)
+	}
+
+	// Транзакции зафиксировались не по порядку: id 3 опубликовано первым.
+	old := time.Now().Add(-time.Hour)
+	for id, seq := range map[int64]int64{3: 1, 1: 2, 2: 3} {
+		client.OutboxEvent.Create().
+			SetID(id).
+			SetType(TypeTaskDeleted).
+			SetAggregateType(AggregateTask).
+			SetAggregateID(uuid.New()).
+			SetPayload("{}").
+			SetPublishedSeq(seq).
+			SetPublishedAt(old).
+			SaveX(ctx)
+	}
+
+	n, err := repo.PurgeDeleted(ctx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("purged %d rows, want 1", n)
+	}
+
+	highest, err := repo.HighestPublished(ctx)
+	if err != nil || highest != 3 {
+		t.Fatalf("HighestPublished = %d, %v; want 3", highest, err)
+	}
+	last, err := repo.LastPublished(ctx)
+	if err != nil || last != 3 {
+		t.Fatalf("LastPublished = %d, %v; want 3", last, err)
+	}
+}
@@ -0,0 +1,53 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// Bus — шина событий внутри процесса. Подписчики вызываются синхронно
+// из релея, поэтому не должны блокироваться.
+type Bus struct {
+	mu   sync.RWMutex
+	next int
+	subs map[int]func(Event)
+}
+
+func NewBus() *Bus {
+	return &Bus{subs: make(map[int]func(Event))}
+}
+
+// Subscribe возвращает функцию отписки.
+func (b *Bus) Subscribe(fn func(Event)) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.next
+	b.next++
+	b.subs[id] = fn
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs, id)
+	}
+}
+
+func (b *Bus) Handle(_ context.Context, ev Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, fn := range b.subs {
+		fn(ev)
+	}
+	return nil
+}
+
+// LogSink пишет события в лог.
+type LogSink struct{}
+
+func (LogSink) Handle(_ context.Context, ev Event) error {
+	log.Printf("outbox: #%d %s %s %s/%s %s", ev.Seq, ev.ID, ev.Type, ev.AggregateType, ev.AggregateID, ev.Payload)
+	return nil
+}
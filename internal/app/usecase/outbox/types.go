@@ -0,0 +1,127 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Типы событий.
+const (
+	TypeTaskCreated        = "task.created"
+	TypeTaskUpdated        = "task.updated"
+	TypeTaskDeleted        = "task.deleted"
+	TypeTaskMoved          = "task.moved"
+	TypeTaskAssigned       = "task.assigned"
+	TypeTaskUnassigned     = "task.unassigned"
+	TypeProjectCreated     = "project.created"
+	TypeProjectMemberAdded = "project.member_added"
+	TypeProjectDeleted     = "project.deleted"
+)
+
+// Типы агрегатов.
+const (
+	AggregateTask    = "task"
+	AggregateProject = "project"
+)
+
+// Draft — событие, которое репозиторий пишет в своей транзакции.
+// ActorID == nil — автор берётся из контекста (audit.ActorFrom).
+type Draft struct {
+	Type          string
+	AggregateType string
+	AggregateID   uuid.UUID
+	ProjectID     *uuid.UUID
+	ActorID       *uuid.UUID
+	Data          any
+}
+
+// Event — сохранённое событие. ID одинаков при повторной публикации:
+// получатели отбрасывают по нему дубликаты. Seq — номер вставки,
+// PublishedSeq — номер в порядке публикации (0 — ещё не присвоен).
+type Event struct {
+	Seq           int64
+	PublishedSeq  int64
+	ID            uuid.UUID
+	Type          string
+	AggregateType string
+	AggregateID   uuid.UUID
+	ProjectID     *uuid.UUID
+	ActorID       *uuid.UUID
+	Payload       json.RawMessage
+	CreatedAt     time.Time
+	Attempts      int
+}
+
+// Sink получает опубликованные события. Доставка «как минимум один раз»:
+// после сбоя событие придёт снова, в том числе тем, кто его уже получил.
+// События приходят в порядке PublishedSeq; он совпадает с порядком Seq,
+// кроме событий, опоздавших дольше gapTimeout (см. UseCase.Relay).
+type Sink interface {
+	Handle(ctx context.Context, ev Event) error
+}
+
+type OutboxRepository interface {
+	Unpublished(ctx context.Context, limit int) ([]Event, error)
+	// HighestPublished — наибольший Seq среди событий с номером публикации.
+	HighestPublished(ctx context.Context) (int64, error)
+	// AssignPublishedSeq присваивает событию следующий номер публикации.
+	AssignPublishedSeq(ctx context.Context, seq int64) (int64, error)
+	MarkPublished(ctx context.Context, seq int64, at time.Time) error
+	MarkFailed(ctx context.Context, seq int64, lastErr string) error
+}
+
+type TaskData struct {
+	ID          uuid.UUID   `json:"id"`
+	ProjectID   uuid.UUID   `json:"projectId"`
+	Title       string      `json:"title"`
+	Description string      `json:"description"`
+	Status      string      `json:"status"`
+	Position    int         `json:"position"`
+	MilestoneID *uuid.UUID  `json:"milestoneId,omitempty"`
+	DueDate     *time.Time  `json:"dueDate,omitempty"`
+	Assignees   []uuid.UUID `json:"assignees"`
+	Version     int         `json:"version"`
+	CreatedAt   time.Time   `json:"createdAt"`
+}
+
+// TaskEventData — data событий task.created, task.updated, task.deleted.
+type TaskEventData struct {
+	Task TaskData `json:"task"`
+}
+
+// MoveEventData — data события task.moved: позиция задачи на доске
+// изменилась, позиции соседних задач сдвинулись на одну.
+type MoveEventData struct {
+	Task TaskData `json:"task"`
+	From int      `json:"from"`
+	To   int      `json:"to"`
+}
+
+// AssignmentEventData — data событий task.assigned и task.unassigned.
+type AssignmentEventData struct {
+	Task   TaskData  `json:"task"`
+	UserID uuid.UUID `json:"userId"`
+	Role   *string   `json:"role,omitempty"`
+}
+
+type ProjectData struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	Version     int       `json:"version"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// ProjectEventData — data событий project.created и project.deleted.
+type ProjectEventData struct {
+	Project ProjectData `json:"project"`
+}
+
+// MemberEventData — data события project.member_added.
+type MemberEventData struct {
+	UserID uuid.UUID `json:"userId"`
+	Role   string    `json:"role"`
+}
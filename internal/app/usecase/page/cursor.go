@@ -0,0 +1,119 @@
+package page
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor — значения ключей сортировки крайнего элемента страницы и
+// направление, в котором от него читать.
+type Cursor struct {
+	Back   bool
+	Values []any
+}
+
+type wireCursor struct {
+	Back   bool      `json:"b,omitempty"`
+	Values []*string `json:"k"`
+}
+
+// Encode упаковывает курсор в строку для URL. Поддерживаются значения
+// time.Time, uuid.UUID, int, string и указатели на них; nil — NULL.
+func (c Cursor) Encode() string {
+	wc := wireCursor{Back: c.Back, Values: make([]*string, len(c.Values))}
+	for i, v := range c.Values {
+		wc.Values[i] = encodeValue(v)
+	}
+	raw, _ := json.Marshal(wc)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decode разбирает строку из Encode. Пустая строка — курсора нет.
+func Decode(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var wc wireCursor
+	if err := json.Unmarshal(raw, &wc); err != nil || len(wc.Values) == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	c := &Cursor{Back: wc.Back, Values: make([]any, len(wc.Values))}
+	for i, v := range wc.Values {
+		if c.Values[i], err = decodeValue(v); err != nil {
+			return nil, ErrInvalidCursor
+		}
+	}
+	return c, nil
+}
+
+// Значение хранится строкой с префиксом типа, чтобы в запрос ушло
+// значение того же типа, что и колонка.
+func encodeValue(v any) *string {
+	var s string
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return encodeValue(*v)
+	case *uuid.UUID:
+		if v == nil {
+			return nil
+		}
+		return encodeValue(*v)
+	case *int:
+		if v == nil {
+			return nil
+		}
+		return encodeValue(*v)
+	case *string:
+		if v == nil {
+			return nil
+		}
+		return encodeValue(*v)
+	case time.Time:
+		s = "t" + v.UTC().Format(time.RFC3339Nano)
+	case uuid.UUID:
+		s = "u" + v.String()
+	case int:
+		s = "i" + strconv.Itoa(v)
+	case string:
+		s = "s" + v
+	default:
+		panic(fmt.Sprintf("page: unsupported cursor value %T", v))
+	}
+	return &s
+}
+
+func decodeValue(s *string) (any, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if *s == "" {
+		return nil, ErrInvalidCursor
+	}
+	v := (*s)[1:]
+	switch (*s)[0] {
+	case 't':
+		return time.Parse(time.RFC3339Nano, v)
+	case 'u':
+		return uuid.Parse(v)
+	case 'i':
+		return strconv.Atoi(v)
+	case 's':
+		return v, nil
+	}
+	return nil, ErrInvalidCursor
+}
@@ -0,0 +1,5 @@
+package page
+
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
+
+var ErrInvalidCursor = apperr.Invalid("invalid_cursor", "invalid cursor")
@@ -0,0 +1,195 @@
+package page
+
+import (
+	"database/sql/driver"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Nulls — может ли ключ быть NULL и где NULL стоит в сортировке.
+type Nulls int
+
+const (
+	NotNull Nulls = iota
+	NullsFirst
+	NullsLast
+)
+
+// Key — колонка сортировки.
+type Key struct {
+	Field string
+	Desc  bool
+	Nulls Nulls
+}
+
+// Keyset — порядок списка. Последний ключ должен быть уникальным (обычно
+// id), иначе страницы могут терять или повторять строки с равными ключами.
+type Keyset []Key
+
+// Decode разбирает курсор и проверяет, что он выдан для этого порядка.
+func (ks Keyset) Decode(s string) (*Cursor, error) {
+	c, err := Decode(s)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	if len(c.Values) != len(ks) {
+		return nil, ErrInvalidCursor
+	}
+	for i, k := range ks {
+		if c.Values[i] == nil && k.Nulls == NotNull {
+			return nil, ErrInvalidCursor
+		}
+	}
+	return c, nil
+}
+
+// Where — условие «строка дальше курсора в направлении чтения». Без
+// курсора ничего не фильтрует.
+func Where[P ~func(*sql.Selector)](ks Keyset, c *Cursor) P {
+	return func(s *sql.Selector) {
+		if c == nil {
+			return
+		}
+		ors := make([]*sql.Predicate, 0, len(ks))
+		for i, k := range ks {
+			ands := make([]*sql.Predicate, 0, i+1)
+			for j := range i {
+				ands = append(ands, equal(s.C(ks[j].Field), c.Values[j]))
+			}
+			ands = append(ands, after(s.C(k.Field), k, c.Values[i], c.Back))
+			ors = append(ors, sql.And(ands...))
+		}
+		s.Where(sql.Or(ors...))
+	}
+}
+
+// Order — сортировка запроса. При чтении назад порядок обратный, а
+// Cut возвращает строки в исходном порядке.
+func Order[O ~func(*sql.Selector)](ks Keyset, c *Cursor) []O {
+	back := c != nil && c.Back
+	out := make([]O, 0, len(ks))
+	for _, k := range ks {
+		desc, nullsFirst := k.Desc, k.Nulls == NullsFirst
+		if back {
+			desc, nullsFirst = !desc, !nullsFirst
+		}
+		var opts []sql.OrderTermOption
+		if desc {
+			opts = append(opts, sql.OrderDesc())
+		}
+		if k.Nulls != NotNull {
+			if nullsFirst {
+				opts = append(opts, sql.OrderNullsFirst())
+			} else {
+				opts = append(opts, sql.OrderNullsLast())
+			}
+		}
+		out = append(out, sql.OrderByField(k.Field, opts...).ToFunc())
+	}
+	return out
+}
+
+// Top ограничивает подгружаемую связь: у каждого родителя из parents
+// остаётся не больше n первых строк в порядке ks. fk — колонка связи с
+// родителем. Чтобы узнать, есть ли продолжение, передают n = limit+1 и
+// обрезают строки каждого родителя через Cut.
+func Top[P ~func(*sql.Selector)](ks Keyset, fk string, parents []uuid.UUID, n int) P {
+	return func(s *sql.Selector) {
+		t := sql.Table(s.TableName()).As("top_src")
+		orders := make([]string, 0, len(ks))
+		for _, k := range ks {
+			orders = append(orders, orderExpr(t.C(k.Field), k))
+		}
+		ids := make([]driver.Value, 0, len(parents))
+		for _, id := range parents {
+			ids = append(ids, id)
+		}
+
+		ranked := sql.Select(t.C("id")).
+			AppendSelectExprAs(sql.RowNumber().PartitionBy(t.C(fk)).OrderBy(orders...), "rn").
+			From(t).
+			Where(sql.InValues(t.C(fk), ids...)).
+			As("top_ranked")
+		s.Where(sql.In(s.C("id"),
+			sql.Select(ranked.C("id")).From(ranked).Where(sql.LTE(ranked.C("rn"), n)),
+		))
+	}
+}
+
+// Cut обрезает строки, прочитанные с лимитом p.Limit+1, до страницы и
+// строит курсоры соседних страниц. key возвращает значения ключей строки
+// в порядке Keyset.
+func Cut[E any](p Params, c *Cursor, rows []E, key func(E) []any) ([]E, string, string) {
+	back := c != nil && c.Back
+	more := len(rows) > p.Limit
+	if more {
+		rows = rows[:p.Limit]
+	}
+	if back {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, "", ""
+	}
+
+	// Страница, на которую пришли назад, всегда имеет следующую, а
+	// пришли вперёд — предыдущую (кроме самой первой).
+	hasNext, hasPrev := more, c != nil || p.Offset > 0
+	if back {
+		hasNext, hasPrev = true, more
+	}
+
+	var next, prev string
+	if hasNext {
+		next = Cursor{Values: key(rows[len(rows)-1])}.Encode()
+	}
+	if hasPrev {
+		prev = Cursor{Back: true, Values: key(rows[0])}.Encode()
+	}
+	return rows, next, prev
+}
+
+// after — значение колонки строго дальше v в направлении чтения.
+func after(col string, k Key, v any, back bool) *sql.Predicate {
+	desc, nullsFirst := k.Desc, k.Nulls == NullsFirst
+	if back {
+		desc, nullsFirst = !desc, !nullsFirst
+	}
+	if v == nil {
+		if nullsFirst {
+			return sql.NotNull(col)
+		}
+		return sql.False()
+	}
+
+	p := sql.GT(col, v)
+	if desc {
+		p = sql.LT(col, v)
+	}
+	if k.Nulls != NotNull && !nullsFirst {
+		return sql.Or(p, sql.IsNull(col))
+	}
+	return p
+}
+
+func orderExpr(col string, k Key) string {
+	if k.Desc {
+		col += " DESC"
+	}
+	switch k.Nulls {
+	case NullsFirst:
+		col += " NULLS FIRST"
+	case NullsLast:
+		col += " NULLS LAST"
+	}
+	return col
+}
+
+func equal(col string, v any) *sql.Predicate {
+	if v == nil {
+		return sql.IsNull(col)
+	}
+	return sql.EQ(col, v)
+}
@@ -0,0 +1,52 @@
+package page
+
+import "context"
+
+// Размер страницы по умолчанию и максимальный.
+const (
+	DefaultLimit = 50
+	MaxLimit     = 100
+)
+
+// Params — запрос страницы. Cursor — непрозрачный курсор из Page.Next или
+// Page.Prev; без него страница отсчитывается от начала со сдвигом Offset
+// (оставлен для старых клиентов). Total — посчитать все элементы списка.
+type Params struct {
+	Limit  int
+	Offset int
+	Cursor string
+	Total  bool
+}
+
+// Normalize приводит размер страницы и сдвиг к допустимым значениям.
+func (p Params) Normalize() Params {
+	if p.Limit <= 0 || p.Limit > MaxLimit {
+		p.Limit = DefaultLimit
+	}
+	if p.Offset < 0 || p.Cursor != "" {
+		p.Offset = 0
+	}
+	return p
+}
+
+// Page — страница списка. Next и Prev пусты, если дальше или раньше
+// элементов нет; Total заполняется, только если его запросили.
+type Page[T any] struct {
+	Items []T
+	Next  string
+	Prev  string
+	Total *int
+}
+
+// Total считает элементы списка, если их запросили. count — обычно
+// Count запроса с фильтрами, но без курсора.
+func Total(ctx context.Context, p Params, count func(context.Context) (int, error)) (*int, error) {
+	if !p.Total {
+		return nil, nil
+	}
+	n, err := count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
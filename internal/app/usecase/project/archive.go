@@ -0,0 +1,37 @@
+package project
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Archive переводит проект в архив: он остаётся доступным на чтение,
+// но любые изменения проекта и его задач отклоняются с ErrArchived.
+func (uc *UseCase) Archive(ctx context.Context, projectID, actorID uuid.UUID) (ProjectDTO, error) {
+	if err := uc.requireOwner(ctx, projectID, actorID); err != nil {
+		return ProjectDTO{}, err
+	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return ProjectDTO{}, err
+	}
+	return uc.repo.SetArchived(ctx, projectID, true)
+}
+
+func (uc *UseCase) Unarchive(ctx context.Context, projectID, actorID uuid.UUID) (ProjectDTO, error) {
+	if err := uc.requireOwner(ctx, projectID, actorID); err != nil {
+		return ProjectDTO{}, err
+	}
+	return uc.repo.SetArchived(ctx, projectID, false)
+}
+
+func (uc *UseCase) ensureWritable(ctx context.Context, projectID uuid.UUID) error {
+	archived, err := uc.repo.IsArchived(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return ErrArchived
+	}
+	return nil
+}
@@ -1,10 +1,16 @@
 package project
 
-import "errors"
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
 
 var (
-	ErrNotFound      = errors.New("project not found")
-	ErrUserNotFound  = errors.New("user not found")
-	ErrForbidden     = errors.New("forbidden")
-	ErrAlreadyMember = errors.New("already member")
+	ErrNotFound        = apperr.NotFound("project_not_found", "project not found")
+	ErrUserNotFound    = apperr.NotFound("user_not_found", "user not found")
+	ErrForbidden       = apperr.Forbidden("forbidden", "forbidden")
+	ErrAlreadyMember   = apperr.Conflict("already_member", "already member")
+	ErrArchived        = apperr.Conflict("project_archived", "project is archived")
+	ErrVersionConflict = apperr.Precondition("version_mismatch", "project version conflict")
+
+	ErrTemplateNotFound = apperr.NotFound("template_not_found", "template not found")
+
+	ErrNameRequired = apperr.Invalid("name_required", "name is required")
 )
@@ -0,0 +1,49 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/notification"
+)
+
+// Notifier доставляет уведомления пользователям.
+type Notifier interface {
+	Notify(ctx context.Context, ev notification.Event) error
+}
+
+// notifyInvited вызывается после сохранения участника, поэтому ошибки
+// только логируются.
+func (uc *UseCase) notifyInvited(ctx context.Context, projectID, inviterID, userID uuid.UUID) {
+	if uc.notifier == nil {
+		return
+	}
+
+	p, err := uc.repo.GetByID(ctx, projectID, Include{Members: true})
+	if err != nil {
+		log.Printf("notify project %s: %v", projectID, err)
+		return
+	}
+
+	inviter := "Someone"
+	for _, m := range p.Members {
+		if m.UserID == inviterID && m.Name != "" {
+			inviter = m.Name
+			break
+		}
+	}
+
+	err = uc.notifier.Notify(ctx, notification.Event{
+		Type:       notification.TypeProjectInvited,
+		Recipients: []uuid.UUID{userID},
+		ActorID:    &inviterID,
+		ProjectID:  &projectID,
+		Message:    fmt.Sprintf("%s invited you to project %q", inviter, p.Name),
+	})
+	if err != nil {
+		log.Printf("notify %s: %v", notification.TypeProjectInvited, err)
+	}
+}
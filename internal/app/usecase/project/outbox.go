@@ -0,0 +1,39 @@
+package project
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+)
+
+// recordProjectEvent пишет в outbox событие проекта. c — клиент
+// транзакции, в которой проект изменяется.
+func recordProjectEvent(ctx context.Context, c *ent.Client, typ string, p *ent.Project, actorID *uuid.UUID) error {
+	return outbox.Record(ctx, c, outbox.Draft{
+		Type:          typ,
+		AggregateType: outbox.AggregateProject,
+		AggregateID:   p.ID,
+		ProjectID:     &p.ID,
+		ActorID:       actorID,
+		Data: outbox.ProjectEventData{Project: outbox.ProjectData{
+			ID:          p.ID,
+			Name:        p.Name,
+			Description: p.Description,
+			Version:     p.Version,
+			CreatedAt:   p.CreatedAt,
+		}},
+	})
+}
+
+func recordMemberAdded(ctx context.Context, c *ent.Client, projectID, userID uuid.UUID, role string) error {
+	return outbox.Record(ctx, c, outbox.Draft{
+		Type:          outbox.TypeProjectMemberAdded,
+		AggregateType: outbox.AggregateProject,
+		AggregateID:   projectID,
+		ProjectID:     &projectID,
+		Data:          outbox.MemberEventData{UserID: userID, Role: role},
+	})
+}
@@ -2,46 +2,75 @@ package project
 
 import (
 	"context"
-	"errors"
 	"strings"
 
 	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 type UseCase struct {
-	repo ProjectRepository
+	repo     ProjectRepository
+	notifier Notifier
 }
 
-func NewProjectUsecase(repo ProjectRepository) *UseCase {
-	return &UseCase{repo: repo}
+func NewProjectUsecase(repo ProjectRepository, notifier Notifier) *UseCase {
+	return &UseCase{repo: repo, notifier: notifier}
 }
 
 func (uc *UseCase) Create(ctx context.Context, in CreateInput) (ProjectDTO, error) {
 	if strings.TrimSpace(in.Name) == "" {
-		return ProjectDTO{}, errors.New("name is required")
+		return ProjectDTO{}, ErrNameRequired
 	}
 	return uc.repo.Create(ctx, in)
 }
 
-func (uc *UseCase) GetByID(ctx context.Context, id uuid.UUID) (ProjectDTO, error) {
-	return uc.repo.GetByID(ctx, id)
+func (uc *UseCase) GetByID(ctx context.Context, id uuid.UUID, inc Include) (ProjectDTO, error) {
+	return uc.repo.GetByID(ctx, id, inc.normalize())
 }
 
 func (uc *UseCase) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (ProjectDTO, error) {
 	if in.Name != nil && strings.TrimSpace(*in.Name) == "" {
-		return ProjectDTO{}, errors.New("name cannot be empty")
+		return ProjectDTO{}, ErrNameRequired
+	}
+	if err := uc.ensureWritable(ctx, id); err != nil {
+		return ProjectDTO{}, err
 	}
 	return uc.repo.Update(ctx, id, in)
 }
 
-func (uc *UseCase) List(ctx context.Context, limit, offset int) ([]ProjectDTO, error) {
-	if limit <= 0 || limit > 100 {
-		limit = 50
+func (uc *UseCase) List(ctx context.Context, f ListFilter, inc Include, p page.Params) (page.Page[ProjectDTO], error) {
+	p = p.Normalize()
+	return uc.repo.List(ctx, f, inc.normalize(), p)
+}
+
+// ListMembers — участники проекта в порядке вступления.
+func (uc *UseCase) ListMembers(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[ProjectMemberDTO], error) {
+	p = p.Normalize()
+	ok, err := uc.repo.ProjectExists(ctx, projectID)
+	if err != nil {
+		return page.Page[ProjectMemberDTO]{}, err
+	}
+	if !ok {
+		return page.Page[ProjectMemberDTO]{}, ErrNotFound
+	}
+	return uc.repo.ListMembers(ctx, projectID, p)
+}
+
+// normalize: задачи с исполнителями подразумевают задачи; лимит
+// коллекций — как у страниц, без ограничения — только для внутренних
+// вызовов (Limit < 0).
+func (inc Include) normalize() Include {
+	if inc.TaskAssignees {
+		inc.Tasks = true
 	}
-	if offset < 0 {
-		offset = 0
+	switch {
+	case inc.Limit < 0:
+		inc.Limit = 0
+	case inc.Limit == 0 || inc.Limit > page.MaxLimit:
+		inc.Limit = page.DefaultLimit
 	}
-	return uc.repo.List(ctx, limit, offset)
+	return inc
 }
 
 func (uc *UseCase) Invite(ctx context.Context, projectID, inviterID, userID uuid.UUID) error {
@@ -52,6 +81,9 @@ func (uc *UseCase) Invite(ctx context.Context, projectID, inviterID, userID uuid
 	if !ok {
 		return ErrNotFound
 	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return err
+	}
 
 	ok, err = uc.repo.UserExists(ctx, userID)
 	if err != nil {
@@ -77,10 +109,15 @@ func (uc *UseCase) Invite(ctx context.Context, projectID, inviterID, userID uuid
 		return ErrAlreadyMember
 	}
 
-	return uc.repo.AddMember(ctx, projectID, userID, "member")
+	if err := uc.repo.AddMember(ctx, projectID, userID, "member"); err != nil {
+		return err
+	}
+
+	uc.notifyInvited(ctx, projectID, inviterID, userID)
+	return nil
 }
 
-func (uc *UseCase) Delete(ctx context.Context, projectID, actorID uuid.UUID) error {
+func (uc *UseCase) Delete(ctx context.Context, projectID, actorID uuid.UUID, expectedVersion *int) error {
 	ok, err := uc.repo.ProjectExists(ctx, projectID)
 	if err != nil {
 		return err
@@ -96,6 +133,9 @@ func (uc *UseCase) Delete(ctx context.Context, projectID, actorID uuid.UUID) err
 	if role != "owner" {
 		return ErrForbidden
 	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return err
+	}
 
-	return uc.repo.DeleteProject(ctx, projectID)
+	return uc.repo.DeleteProject(ctx, projectID, expectedVersion)
 }
@@ -2,15 +2,18 @@ package project
 
 import (
 	"context"
-	"entgo.io/ent/dialect/sql"
 	"project-manager-dashboard-go/ent/project"
 	"project-manager-dashboard-go/ent/projecttask"
 	"project-manager-dashboard-go/ent/projectuser"
-	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
 	"project-manager-dashboard-go/ent/user"
+	"time"
 
 	"github.com/google/uuid"
 	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 type EntRepo struct {
@@ -52,6 +55,11 @@ func (r *EntRepo) Create(ctx context.Context, in CreateInput) (ProjectDTO, error
 		return ProjectDTO{}, err
 	}
 
+	err = recordProjectEvent(ctx, tx.Client(), outbox.TypeProjectCreated, p, &in.OwnerID)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+
 	if err = tx.Commit(); err != nil {
 		return ProjectDTO{}, err
 	}
@@ -61,98 +69,253 @@ func (r *EntRepo) Create(ctx context.Context, in CreateInput) (ProjectDTO, error
 		Name:        p.Name,
 		Description: p.Description,
 		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Version:     p.Version,
 	}, nil
 }
 
-func (r *EntRepo) GetByID(ctx context.Context, id uuid.UUID) (ProjectDTO, error) {
-	p, err := r.client.Project.Get(ctx, id)
+func (r *EntRepo) GetByID(ctx context.Context, id uuid.UUID, inc Include) (ProjectDTO, error) {
+	q := r.client.Project.Query().Where(project.IDEQ(id))
+	withIncludes(q, []uuid.UUID{id}, inc)
+
+	p, err := q.Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return ProjectDTO{}, ErrNotFound
 		}
 		return ProjectDTO{}, err
 	}
+	return toProjectDTO(p, inc), nil
+}
+
+// memberKeys — участники проекта в порядке вступления.
+var memberKeys = page.Keyset{
+	{Field: projectuser.FieldCreatedAt},
+	{Field: projectuser.FieldID},
+}
+
+// taskKeys совпадает с порядком списка задач проекта, чтобы курсор
+// TasksNext подходил к GET /projects/{id}/tasks.
+var taskKeys = page.Keyset{
+	{Field: projecttask.FieldPosition},
+	{Field: projecttask.FieldID},
+}
+
+// withIncludes добавляет в запрос проектов eager loading связей из inc.
+// ids — проекты, которые вернёт запрос: по ним коллекции режутся до
+// inc.Limit+1 строк на проект прямо в SQL.
+func withIncludes(q *ent.ProjectQuery, ids []uuid.UUID, inc Include) {
+	if inc.Members {
+		q.WithMemberships(func(mq *ent.ProjectUserQuery) {
+			mq.WithUser().Order(page.Order[projectuser.OrderOption](memberKeys, nil)...)
+			if inc.Limit > 0 {
+				mq.Where(page.Top[predicate.ProjectUser](memberKeys, project.MembershipsColumn, ids, inc.Limit+1))
+			}
+		})
+	}
+	if inc.Tasks {
+		q.WithProjectTasks(func(pq *ent.ProjectTaskQuery) {
+			pq.WithTask(func(tq *ent.TaskQuery) {
+				if inc.TaskAssignees {
+					tq.WithAssignees(func(aq *ent.TaskAssigneeQuery) {
+						aq.WithUser().Order(ent.Asc(taskassignee.FieldCreatedAt))
+					})
+				}
+			}).Order(page.Order[projecttask.OrderOption](taskKeys, nil)...)
+			if inc.Limit > 0 {
+				pq.Where(page.Top[predicate.ProjectTask](taskKeys, project.ProjectTasksColumn, ids, inc.Limit+1))
+			}
+		})
+	}
+}
+
+func toProjectDTO(p *ent.Project, inc Include) ProjectDTO {
+	out := ProjectDTO{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Version:     p.Version,
+		ArchivedAt:  p.ArchivedAt,
+	}
+
+	if inc.Members {
+		memberships := p.Edges.Memberships
+		if inc.Limit > 0 {
+			memberships, out.MembersNext, _ = page.Cut(page.Params{Limit: inc.Limit}, nil, memberships,
+				func(m *ent.ProjectUser) []any { return []any{m.CreatedAt, m.ID} })
+		}
+		out.Members = make([]ProjectMemberDTO, 0, len(memberships))
+		for _, m := range memberships {
+			if m.Edges.User == nil {
+				continue
+			}
+			out.Members = append(out.Members, toMemberDTO(m))
+		}
+	}
+
+	if inc.Tasks {
+		projectTasks := p.Edges.ProjectTasks
+		if inc.Limit > 0 {
+			projectTasks, out.TasksNext, _ = page.Cut(page.Params{Limit: inc.Limit}, nil, projectTasks,
+				func(pt *ent.ProjectTask) []any { return []any{pt.Position, pt.ID} })
+		}
+		out.Tasks = make([]ProjectTaskDTO, 0, len(projectTasks))
+		for _, pt := range projectTasks {
+			t := pt.Edges.Task
+			if t == nil {
+				continue
+			}
+			item := ProjectTaskDTO{
+				ID:          t.ID,
+				Title:       t.Title,
+				Description: t.Description,
+				Status:      string(t.Status),
+				CreatedAt:   t.CreatedAt,
+				Position:    pt.Position,
+			}
+			if inc.TaskAssignees {
+				item.Assignees = make([]TaskAssigneeDTO, 0, len(t.Edges.Assignees))
+				for _, a := range t.Edges.Assignees {
+					u := a.Edges.User
+					if u == nil {
+						continue
+					}
+					var role *string
+					if a.Role != nil {
+						r := string(*a.Role)
+						role = &r
+					}
+					item.Assignees = append(item.Assignees, TaskAssigneeDTO{
+						UserID: u.ID,
+						Name:   u.Name,
+						Email:  u.Email,
+						Role:   role,
+					})
+				}
+			}
+			out.Tasks = append(out.Tasks, item)
+		}
+	}
+	return out
+}
+
+func toMemberDTO(m *ent.ProjectUser) ProjectMemberDTO {
+	u := m.Edges.User
+	return ProjectMemberDTO{
+		UserID: u.ID,
+		Name:   u.Name,
+		Email:  u.Email,
+		Role:   string(m.Role),
+	}
+}
+
+func (r *EntRepo) ListMembers(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[ProjectMemberDTO], error) {
+	c, err := memberKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[ProjectMemberDTO]{}, err
+	}
+
+	q := r.client.ProjectUser.
+		Query().
+		Where(projectuser.HasProjectWith(project.IDEQ(projectID)))
+	var res page.Page[ProjectMemberDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[ProjectMemberDTO]{}, err
+	}
 
-	memberships, err := p.QueryMemberships().
+	rows, err := q.
+		Where(page.Where[predicate.ProjectUser](memberKeys, c)).
 		WithUser().
+		Order(page.Order[projectuser.OrderOption](memberKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
 		All(ctx)
 	if err != nil {
-		return ProjectDTO{}, err
+		return page.Page[ProjectMemberDTO]{}, err
 	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(m *ent.ProjectUser) []any {
+		return []any{m.CreatedAt, m.ID}
+	})
 
-	members := make([]ProjectMemberDTO, 0, len(memberships))
-	for _, m := range memberships {
-		u := m.Edges.User
-		if u == nil {
+	out := make([]ProjectMemberDTO, 0, len(rows))
+	for _, m := range rows {
+		if m.Edges.User == nil {
 			continue
 		}
-		members = append(members, ProjectMemberDTO{
-			UserID: u.ID,
-			Name:   u.Name,
-			Email:  u.Email,
-			Role:   string(m.Role),
-		})
+		out = append(out, toMemberDTO(m))
 	}
+	res.Items = out
+	return res, nil
+}
 
-	projectTasks, err := p.QueryProjectTasks().
-		WithTask().
-		Order(ent.Asc(projecttask.FieldPosition), ent.Asc(projecttask.FieldCreatedAt)).
-		All(ctx)
+// projectKeys — проекты, новые первыми.
+var projectKeys = page.Keyset{
+	{Field: project.FieldCreatedAt, Desc: true},
+	{Field: project.FieldID, Desc: true},
+}
+
+func (r *EntRepo) List(ctx context.Context, f ListFilter, inc Include, p page.Params) (page.Page[ProjectDTO], error) {
+	c, err := projectKeys.Decode(p.Cursor)
 	if err != nil {
-		return ProjectDTO{}, err
+		return page.Page[ProjectDTO]{}, err
 	}
 
-	tasks := make([]ProjectTaskDTO, 0, len(projectTasks))
-	for _, pt := range projectTasks {
-		t := pt.Edges.Task
-		if t == nil {
-			continue
+	q := r.client.Project.Query()
+	if f.Archived != nil {
+		if *f.Archived {
+			q = q.Where(project.ArchivedAtNotNil())
+		} else {
+			q = q.Where(project.ArchivedAtIsNil())
 		}
-		tasks = append(tasks, ProjectTaskDTO{
-			ID:          t.ID,
-			Title:       t.Title,
-			Description: t.Description,
-			Status:      string(t.Status),
-			CreatedAt:   t.CreatedAt,
-			Position:    pt.Position,
-		})
 	}
 
-	return ProjectDTO{
-		ID:          p.ID,
-		Name:        p.Name,
-		Description: p.Description,
-		CreatedAt:   p.CreatedAt,
-		Members:     members,
-		Tasks:       tasks,
-	}, nil
-}
+	var res page.Page[ProjectDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[ProjectDTO]{}, err
+	}
 
-func (r *EntRepo) List(ctx context.Context, limit, offset int) ([]ProjectDTO, error) {
-	items, err := r.client.Project.
-		Query().
-		Order(project.ByCreatedAt(sql.OrderDesc())).
-		Limit(limit).
-		Offset(offset).
+	items, err := q.
+		Where(page.Where[predicate.Project](projectKeys, c)).
+		Order(page.Order[project.OrderOption](projectKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
 		All(ctx)
 	if err != nil {
-		return nil, err
+		return page.Page[ProjectDTO]{}, err
+	}
+	items, res.Next, res.Prev = page.Cut(p, c, items, func(p *ent.Project) []any {
+		return []any{p.CreatedAt, p.ID}
+	})
+
+	// Связи грузятся вторым запросом по id страницы: ограничение
+	// коллекций на проект требует знать родителей заранее.
+	if inc.Members || inc.Tasks {
+		ids := make([]uuid.UUID, 0, len(items))
+		for _, p := range items {
+			ids = append(ids, p.ID)
+		}
+		iq := r.client.Project.Query().Where(project.IDIn(ids...))
+		withIncludes(iq, ids, inc)
+		if items, err = iq.Order(page.Order[project.OrderOption](projectKeys, nil)...).All(ctx); err != nil {
+			return page.Page[ProjectDTO]{}, err
+		}
 	}
 
 	out := make([]ProjectDTO, 0, len(items))
 	for _, p := range items {
-		out = append(out, ProjectDTO{
-			ID:          p.ID,
-			Name:        p.Name,
-			Description: p.Description,
-			CreatedAt:   p.CreatedAt,
-		})
+		out = append(out, toProjectDTO(p, inc))
 	}
-	return out, nil
+	res.Items = out
+	return res, nil
 }
 
 func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (ProjectDTO, error) {
 	pu := r.client.Project.UpdateOneID(id)
+	if in.ExpectedVersion != nil {
+		pu.Where(project.VersionEQ(*in.ExpectedVersion))
+	}
 
 	if in.Name != nil {
 		pu.SetName(*in.Name)
@@ -168,7 +331,7 @@ func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (Pro
 	p, err := pu.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return ProjectDTO{}, ErrNotFound
+			return ProjectDTO{}, r.notFoundOrConflict(ctx, id, in.ExpectedVersion)
 		}
 		return ProjectDTO{}, err
 	}
@@ -178,9 +341,27 @@ func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (Pro
 		Name:        p.Name,
 		Description: p.Description,
 		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Version:     p.Version,
 	}, nil
 }
 
+// notFoundOrConflict различает причины, по которым условное обновление
+// не нашло строку: проекта нет или у него другая версия.
+func (r *EntRepo) notFoundOrConflict(ctx context.Context, id uuid.UUID, expectedVersion *int) error {
+	if expectedVersion == nil {
+		return ErrNotFound
+	}
+	exists, err := r.client.Project.Query().Where(project.IDEQ(id)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrVersionConflict
+	}
+	return ErrNotFound
+}
+
 func (r *EntRepo) ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error) {
 	return r.client.Project.
 		Query().
@@ -205,18 +386,34 @@ func (r *EntRepo) IsMember(ctx context.Context, projectID, userID uuid.UUID) (bo
 		Exist(ctx)
 }
 
-func (r *EntRepo) AddMember(ctx context.Context, projectID, userID uuid.UUID, role string) error {
-	_, err := r.client.ProjectUser.
+func (r *EntRepo) AddMember(ctx context.Context, projectID, userID uuid.UUID, role string) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ProjectUser.
 		Create().
 		SetProjectID(projectID).
 		SetUserID(userID).
 		SetRole(projectuser.Role(role)).
 		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			err = ErrAlreadyMember
+		}
+		return err
+	}
 
-	if err != nil && ent.IsConstraintError(err) {
-		return ErrAlreadyMember
+	if err = recordMemberAdded(ctx, tx.Client(), projectID, userID, role); err != nil {
+		return err
 	}
-	return err
+	return tx.Commit()
 }
 
 func (r *EntRepo) GetMemberRole(ctx context.Context, projectID, userID uuid.UUID) (string, error) {
@@ -235,7 +432,10 @@ func (r *EntRepo) GetMemberRole(ctx context.Context, projectID, userID uuid.UUID
 	}
 	return string(m.Role), nil
 }
-func (r *EntRepo) DeleteProject(ctx context.Context, projectID uuid.UUID) (err error) {
+
+// DeleteProject перемещает проект в корзину. Окончательно проект
+// удаляется через PurgeDeleted после истечения срока хранения.
+func (r *EntRepo) DeleteProject(ctx context.Context, projectID uuid.UUID, expectedVersion *int) (err error) {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return err
@@ -246,48 +446,59 @@ func (r *EntRepo) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		}
 	}()
 
-	taskIDs, err := tx.ProjectTask.
-		Query().
-		Where(projecttask.HasProjectWith(project.IDEQ(projectID))).
-		QueryTask().
-		IDs(ctx)
-	if err != nil {
-		return err
+	pu := tx.Project.
+		UpdateOneID(projectID).
+		Where(project.DeletedAtIsNil()).
+		SetDeletedAt(time.Now())
+	if expectedVersion != nil {
+		pu.Where(project.VersionEQ(*expectedVersion))
 	}
 
-	_, err = tx.ProjectTask.
-		Delete().
-		Where(projecttask.HasProjectWith(project.IDEQ(projectID))).
-		Exec(ctx)
+	p, err := pu.Save(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			_ = tx.Rollback()
+			return r.notFoundOrConflict(ctx, projectID, expectedVersion)
+		}
 		return err
 	}
 
-	if len(taskIDs) > 0 {
-		_, err = tx.Task.
-			Delete().
-			Where(task.IDIn(taskIDs...)).
-			Exec(ctx)
-		if err != nil {
-			return err
-		}
+	if err = recordProjectEvent(ctx, tx.Client(), outbox.TypeProjectDeleted, p, nil); err != nil {
+		return err
 	}
+	return tx.Commit()
+}
 
-	_, err = tx.ProjectUser.
-		Delete().
-		Where(projectuser.HasProjectWith(project.IDEQ(projectID))).
-		Exec(ctx)
-	if err != nil {
-		return err
+func (r *EntRepo) IsArchived(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return r.client.Project.
+		Query().
+		Where(project.IDEQ(projectID), project.ArchivedAtNotNil()).
+		Exist(ctx)
+}
+
+func (r *EntRepo) SetArchived(ctx context.Context, projectID uuid.UUID, archived bool) (ProjectDTO, error) {
+	pu := r.client.Project.UpdateOneID(projectID)
+	if archived {
+		pu.SetArchivedAt(time.Now())
+	} else {
+		pu.ClearArchivedAt()
 	}
 
-	_, err = tx.Project.
-		Delete().
-		Where(project.IDEQ(projectID)).
-		Exec(ctx)
+	p, err := pu.Save(ctx)
 	if err != nil {
-		return err
+		if ent.IsNotFound(err) {
+			return ProjectDTO{}, ErrNotFound
+		}
+		return ProjectDTO{}, err
 	}
 
-	return tx.Commit()
+	return ProjectDTO{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Version:     p.Version,
+		ArchivedAt:  p.ArchivedAt,
+	}, nil
 }
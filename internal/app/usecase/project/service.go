@@ -4,14 +4,29 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 type ProjectService interface {
 	Create(ctx context.Context, in CreateInput) (ProjectDTO, error)
-	GetByID(ctx context.Context, id uuid.UUID) (ProjectDTO, error)
-	List(ctx context.Context, limit, offset int) ([]ProjectDTO, error)
+	GetByID(ctx context.Context, id uuid.UUID, inc Include) (ProjectDTO, error)
+	List(ctx context.Context, f ListFilter, inc Include, p page.Params) (page.Page[ProjectDTO], error)
+	ListMembers(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[ProjectMemberDTO], error)
 	Update(ctx context.Context, id uuid.UUID, in UpdateInput) (ProjectDTO, error)
-	Delete(ctx context.Context, projectID, actorID uuid.UUID) error
+	Delete(ctx context.Context, projectID, actorID uuid.UUID, expectedVersion *int) error
+	Restore(ctx context.Context, projectID, actorID uuid.UUID) (ProjectDTO, error)
+	ListTrashed(ctx context.Context, ownerID uuid.UUID, p page.Params) (page.Page[ProjectDTO], error)
 
 	Invite(ctx context.Context, projectID, inviterID, userID uuid.UUID) error
+
+	Archive(ctx context.Context, projectID, actorID uuid.UUID) (ProjectDTO, error)
+	Unarchive(ctx context.Context, projectID, actorID uuid.UUID) (ProjectDTO, error)
+
+	SaveAsTemplate(ctx context.Context, projectID uuid.UUID, in SaveTemplateInput) (TemplateDTO, error)
+	GetTemplate(ctx context.Context, id uuid.UUID) (TemplateDTO, error)
+	ListTemplates(ctx context.Context, p page.Params) (page.Page[TemplateDTO], error)
+	DeleteTemplate(ctx context.Context, id, actorID uuid.UUID) error
+	CreateFromTemplate(ctx context.Context, templateID uuid.UUID, in FromTemplateInput) (ProjectDTO, error)
+	Clone(ctx context.Context, projectID uuid.UUID, in CloneInput) (ProjectDTO, error)
 }
@@ -0,0 +1,138 @@
+package project
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+func (uc *UseCase) SaveAsTemplate(ctx context.Context, projectID uuid.UUID, in SaveTemplateInput) (TemplateDTO, error) {
+	if strings.TrimSpace(in.Name) == "" {
+		return TemplateDTO{}, ErrNameRequired
+	}
+
+	if err := uc.requireOwner(ctx, projectID, in.ActorID); err != nil {
+		return TemplateDTO{}, err
+	}
+
+	bp, err := uc.repo.GetBlueprint(ctx, projectID, in.IncludeMembers)
+	if err != nil {
+		return TemplateDTO{}, err
+	}
+
+	return uc.repo.SaveTemplate(ctx, in, bp)
+}
+
+func (uc *UseCase) GetTemplate(ctx context.Context, id uuid.UUID) (TemplateDTO, error) {
+	return uc.repo.GetTemplate(ctx, id)
+}
+
+func (uc *UseCase) ListTemplates(ctx context.Context, p page.Params) (page.Page[TemplateDTO], error) {
+	p = p.Normalize()
+	return uc.repo.ListTemplates(ctx, p)
+}
+
+func (uc *UseCase) DeleteTemplate(ctx context.Context, id, actorID uuid.UUID) error {
+	t, err := uc.repo.GetTemplate(ctx, id)
+	if err != nil {
+		return err
+	}
+	if t.CreatedBy != actorID {
+		return ErrForbidden
+	}
+	return uc.repo.DeleteTemplate(ctx, id)
+}
+
+func (uc *UseCase) CreateFromTemplate(ctx context.Context, templateID uuid.UUID, in FromTemplateInput) (ProjectDTO, error) {
+	if strings.TrimSpace(in.Name) == "" {
+		return ProjectDTO{}, ErrNameRequired
+	}
+
+	ok, err := uc.repo.UserExists(ctx, in.OwnerID)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+	if !ok {
+		return ProjectDTO{}, ErrUserNotFound
+	}
+
+	t, err := uc.repo.GetTemplate(ctx, templateID)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+
+	return uc.repo.CreateFromBlueprint(ctx, CreateInput{
+		Name:        in.Name,
+		Description: in.Description,
+		OwnerID:     in.OwnerID,
+	}, Blueprint{Tasks: t.Tasks, Members: t.Members}, startDateOrNow(in.StartDate))
+}
+
+func (uc *UseCase) Clone(ctx context.Context, projectID uuid.UUID, in CloneInput) (ProjectDTO, error) {
+	if in.Name != nil && strings.TrimSpace(*in.Name) == "" {
+		return ProjectDTO{}, ErrNameRequired
+	}
+
+	src, err := uc.repo.GetByID(ctx, projectID, Include{})
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+
+	isMember, err := uc.repo.IsMember(ctx, projectID, in.ActorID)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+	if !isMember {
+		return ProjectDTO{}, ErrForbidden
+	}
+
+	bp, err := uc.repo.GetBlueprint(ctx, projectID, in.IncludeMembers)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+
+	name := src.Name + " (copy)"
+	if in.Name != nil {
+		name = *in.Name
+	}
+	description := src.Description
+	if in.Description != nil {
+		description = in.Description
+	}
+
+	return uc.repo.CreateFromBlueprint(ctx, CreateInput{
+		Name:        name,
+		Description: description,
+		OwnerID:     in.ActorID,
+	}, bp, startDateOrNow(in.StartDate))
+}
+
+func (uc *UseCase) requireOwner(ctx context.Context, projectID, actorID uuid.UUID) error {
+	ok, err := uc.repo.ProjectExists(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotFound
+	}
+
+	role, err := uc.repo.GetMemberRole(ctx, projectID, actorID)
+	if err != nil {
+		return err
+	}
+	if role != "owner" {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func startDateOrNow(d *time.Time) time.Time {
+	if d != nil {
+		return *d
+	}
+	return time.Now()
+}
@@ -0,0 +1,392 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/projecttemplate"
+	"project-manager-dashboard-go/ent/projectuser"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/templatemember"
+	"project-manager-dashboard-go/ent/templatetask"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+const day = 24 * time.Hour
+
+func (r *EntRepo) GetBlueprint(ctx context.Context, projectID uuid.UUID, withMembers bool) (Blueprint, error) {
+	p, err := r.client.Project.Get(ctx, projectID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return Blueprint{}, ErrNotFound
+		}
+		return Blueprint{}, err
+	}
+
+	projectTasks, err := p.QueryProjectTasks().
+		WithTask().
+		Order(ent.Asc(projecttask.FieldPosition), ent.Asc(projecttask.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return Blueprint{}, err
+	}
+
+	// Сроки задач сохраняются как смещение в днях от даты создания проекта.
+	start := p.CreatedAt.Truncate(day)
+
+	bp := Blueprint{Tasks: make([]TemplateTaskDTO, 0, len(projectTasks))}
+	for i, pt := range projectTasks {
+		t := pt.Edges.Task
+		if t == nil {
+			continue
+		}
+
+		var offset *int
+		if !t.DueDate.IsZero() {
+			days := int(t.DueDate.Truncate(day).Sub(start) / day)
+			offset = &days
+		}
+
+		bp.Tasks = append(bp.Tasks, TemplateTaskDTO{
+			Title:         t.Title,
+			Description:   t.Description,
+			Priority:      string(t.Priority),
+			Position:      i,
+			DueOffsetDays: offset,
+		})
+	}
+
+	if !withMembers {
+		return bp, nil
+	}
+
+	memberships, err := p.QueryMemberships().
+		WithUser().
+		Order(ent.Asc(projectuser.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return Blueprint{}, err
+	}
+
+	bp.Members = make([]TemplateMemberDTO, 0, len(memberships))
+	for _, m := range memberships {
+		if m.Edges.User == nil {
+			continue
+		}
+		bp.Members = append(bp.Members, TemplateMemberDTO{
+			UserID: m.Edges.User.ID,
+			Role:   string(m.Role),
+		})
+	}
+
+	return bp, nil
+}
+
+func (r *EntRepo) SaveTemplate(ctx context.Context, in SaveTemplateInput, bp Blueprint) (TemplateDTO, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return TemplateDTO{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	tc := tx.ProjectTemplate.
+		Create().
+		SetName(in.Name).
+		SetCreatedBy(in.ActorID)
+	if in.Description != nil {
+		tc.SetDescription(*in.Description)
+	}
+
+	t, err := tc.Save(ctx)
+	if err != nil {
+		return TemplateDTO{}, err
+	}
+
+	if len(bp.Tasks) > 0 {
+		builders := make([]*ent.TemplateTaskCreate, 0, len(bp.Tasks))
+		for _, tt := range bp.Tasks {
+			builders = append(builders, tx.TemplateTask.
+				Create().
+				SetTemplateID(t.ID).
+				SetTitle(tt.Title).
+				SetDescription(tt.Description).
+				SetPriority(templatetask.Priority(tt.Priority)).
+				SetPosition(tt.Position).
+				SetNillableDueOffsetDays(tt.DueOffsetDays))
+		}
+		if _, err = tx.TemplateTask.CreateBulk(builders...).Save(ctx); err != nil {
+			return TemplateDTO{}, err
+		}
+	}
+
+	if len(bp.Members) > 0 {
+		builders := make([]*ent.TemplateMemberCreate, 0, len(bp.Members))
+		for _, m := range bp.Members {
+			builders = append(builders, tx.TemplateMember.
+				Create().
+				SetTemplateID(t.ID).
+				SetUserID(m.UserID).
+				SetRole(templatemember.Role(m.Role)))
+		}
+		if _, err = tx.TemplateMember.CreateBulk(builders...).Save(ctx); err != nil {
+			return TemplateDTO{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return TemplateDTO{}, err
+	}
+
+	return TemplateDTO{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedBy:   t.CreatedBy,
+		CreatedAt:   t.CreatedAt,
+		Tasks:       bp.Tasks,
+		Members:     bp.Members,
+	}, nil
+}
+
+func (r *EntRepo) GetTemplate(ctx context.Context, id uuid.UUID) (TemplateDTO, error) {
+	t, err := r.client.ProjectTemplate.
+		Query().
+		Where(projecttemplate.IDEQ(id)).
+		WithTasks(func(q *ent.TemplateTaskQuery) {
+			q.Order(ent.Asc(templatetask.FieldPosition))
+		}).
+		WithMembers(func(q *ent.TemplateMemberQuery) {
+			q.WithUser()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return TemplateDTO{}, ErrTemplateNotFound
+		}
+		return TemplateDTO{}, err
+	}
+
+	out := toTemplateDTO(t)
+
+	out.Tasks = make([]TemplateTaskDTO, 0, len(t.Edges.Tasks))
+	for _, tt := range t.Edges.Tasks {
+		out.Tasks = append(out.Tasks, TemplateTaskDTO{
+			Title:         tt.Title,
+			Description:   tt.Description,
+			Priority:      string(tt.Priority),
+			Position:      tt.Position,
+			DueOffsetDays: tt.DueOffsetDays,
+		})
+	}
+
+	out.Members = make([]TemplateMemberDTO, 0, len(t.Edges.Members))
+	for _, m := range t.Edges.Members {
+		if m.Edges.User == nil {
+			continue
+		}
+		out.Members = append(out.Members, TemplateMemberDTO{
+			UserID: m.Edges.User.ID,
+			Role:   string(m.Role),
+		})
+	}
+
+	return out, nil
+}
+
+// templateKeys — шаблоны, новые первыми.
+var templateKeys = page.Keyset{
+	{Field: projecttemplate.FieldCreatedAt, Desc: true},
+	{Field: projecttemplate.FieldID, Desc: true},
+}
+
+func (r *EntRepo) ListTemplates(ctx context.Context, p page.Params) (page.Page[TemplateDTO], error) {
+	c, err := templateKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[TemplateDTO]{}, err
+	}
+
+	q := r.client.ProjectTemplate.Query()
+	var res page.Page[TemplateDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[TemplateDTO]{}, err
+	}
+
+	items, err := q.
+		Where(page.Where[predicate.ProjectTemplate](templateKeys, c)).
+		Order(page.Order[projecttemplate.OrderOption](templateKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[TemplateDTO]{}, err
+	}
+	items, res.Next, res.Prev = page.Cut(p, c, items, func(t *ent.ProjectTemplate) []any {
+		return []any{t.CreatedAt, t.ID}
+	})
+
+	out := make([]TemplateDTO, 0, len(items))
+	for _, t := range items {
+		out = append(out, toTemplateDTO(t))
+	}
+	res.Items = out
+	return res, nil
+}
+
+func (r *EntRepo) DeleteTemplate(ctx context.Context, id uuid.UUID) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.TemplateTask.
+		Delete().
+		Where(templatetask.HasTemplateWith(projecttemplate.IDEQ(id))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.TemplateMember.
+		Delete().
+		Where(templatemember.HasTemplateWith(projecttemplate.IDEQ(id))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.ProjectTemplate.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = ErrTemplateNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *EntRepo) CreateFromBlueprint(ctx context.Context, in CreateInput, bp Blueprint, startDate time.Time) (ProjectDTO, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	pc := tx.Project.Create().SetName(in.Name)
+	if in.Description != nil {
+		pc.SetDescription(*in.Description)
+	}
+
+	p, err := pc.Save(ctx)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+
+	_, err = tx.ProjectUser.
+		Create().
+		SetProjectID(p.ID).
+		SetUserID(in.OwnerID).
+		SetRole("owner").
+		Save(ctx)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+
+	for _, m := range bp.Members {
+		if m.UserID == in.OwnerID {
+			continue
+		}
+		_, err = tx.ProjectUser.
+			Create().
+			SetProjectID(p.ID).
+			SetUserID(m.UserID).
+			SetRole(projectuser.Role(m.Role)).
+			Save(ctx)
+		if err != nil {
+			return ProjectDTO{}, err
+		}
+	}
+
+	tasks := make([]ProjectTaskDTO, 0, len(bp.Tasks))
+	for i, tt := range bp.Tasks {
+		tc := tx.Task.
+			Create().
+			SetTitle(tt.Title).
+			SetDescription(tt.Description).
+			SetPriority(task.Priority(tt.Priority))
+		if tt.DueOffsetDays != nil {
+			tc.SetDueDate(startDate.AddDate(0, 0, *tt.DueOffsetDays))
+		}
+
+		var t *ent.Task
+		t, err = tc.Save(ctx)
+		if err != nil {
+			return ProjectDTO{}, err
+		}
+
+		_, err = tx.ProjectTask.
+			Create().
+			SetProjectID(p.ID).
+			SetTaskID(t.ID).
+			SetPosition(i).
+			Save(ctx)
+		if err != nil {
+			return ProjectDTO{}, err
+		}
+
+		tasks = append(tasks, ProjectTaskDTO{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      string(t.Status),
+			CreatedAt:   t.CreatedAt,
+			Position:    i,
+		})
+	}
+
+	err = recordProjectEvent(ctx, tx.Client(), outbox.TypeProjectCreated, p, &in.OwnerID)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return ProjectDTO{}, err
+	}
+
+	return ProjectDTO{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		Tasks:       tasks,
+	}, nil
+}
+
+func toTemplateDTO(t *ent.ProjectTemplate) TemplateDTO {
+	return TemplateDTO{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedBy:   t.CreatedBy,
+		CreatedAt:   t.CreatedAt,
+	}
+}
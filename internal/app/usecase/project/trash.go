@@ -0,0 +1,35 @@
+package project
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+func (uc *UseCase) ListTrashed(ctx context.Context, ownerID uuid.UUID, p page.Params) (page.Page[ProjectDTO], error) {
+	p = p.Normalize()
+	return uc.repo.ListTrashed(ctx, ownerID, p)
+}
+
+// Restore возвращает проект из корзины. Доступно только owner проекта.
+func (uc *UseCase) Restore(ctx context.Context, projectID, actorID uuid.UUID) (ProjectDTO, error) {
+	ok, err := uc.repo.TrashedProjectExists(ctx, projectID)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+	if !ok {
+		return ProjectDTO{}, ErrNotFound
+	}
+
+	role, err := uc.repo.GetMemberRole(ctx, projectID, actorID)
+	if err != nil {
+		return ProjectDTO{}, err
+	}
+	if role != "owner" {
+		return ProjectDTO{}, ErrForbidden
+	}
+
+	return uc.repo.RestoreProject(ctx, projectID)
+}
@@ -0,0 +1,280 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/milestone"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/projectuser"
+	"project-manager-dashboard-go/ent/schema"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/ent/webhook"
+	"project-manager-dashboard-go/ent/webhookdelivery"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+func (r *EntRepo) TrashedProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return r.client.Project.
+		Query().
+		Where(project.IDEQ(projectID), project.DeletedAtNotNil()).
+		Exist(schema.SkipSoftDelete(ctx))
+}
+
+// trashKeys — корзина владельца, недавно удалённые первыми.
+var trashKeys = page.Keyset{
+	{Field: project.FieldDeletedAt, Desc: true},
+	{Field: project.FieldID, Desc: true},
+}
+
+// ListTrashed возвращает удалённые проекты, в которых пользователь — owner.
+func (r *EntRepo) ListTrashed(ctx context.Context, ownerID uuid.UUID, p page.Params) (page.Page[ProjectDTO], error) {
+	c, err := trashKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[ProjectDTO]{}, err
+	}
+
+	ctx = schema.SkipSoftDelete(ctx)
+	q := r.client.Project.
+		Query().
+		Where(
+			project.DeletedAtNotNil(),
+			project.HasMembershipsWith(
+				projectuser.HasUserWith(user.IDEQ(ownerID)),
+				projectuser.RoleEQ(projectuser.RoleOwner),
+			),
+		)
+	var res page.Page[ProjectDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[ProjectDTO]{}, err
+	}
+
+	items, err := q.
+		Where(page.Where[predicate.Project](trashKeys, c)).
+		Order(page.Order[project.OrderOption](trashKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[ProjectDTO]{}, err
+	}
+	items, res.Next, res.Prev = page.Cut(p, c, items, func(pr *ent.Project) []any {
+		return []any{pr.DeletedAt, pr.ID}
+	})
+
+	out := make([]ProjectDTO, 0, len(items))
+	for _, pr := range items {
+		out = append(out, ProjectDTO{
+			ID:          pr.ID,
+			Name:        pr.Name,
+			Description: pr.Description,
+			CreatedAt:   pr.CreatedAt,
+			DeletedAt:   pr.DeletedAt,
+		})
+	}
+	res.Items = out
+	return res, nil
+}
+
+func (r *EntRepo) RestoreProject(ctx context.Context, projectID uuid.UUID) (ProjectDTO, error) {
+	p, err := r.client.Project.
+		UpdateOneID(projectID).
+		Where(project.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Save(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ProjectDTO{}, ErrNotFound
+		}
+		return ProjectDTO{}, err
+	}
+
+	return ProjectDTO{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Version:     p.Version,
+	}, nil
+}
+
+// PurgeDeleted окончательно удаляет проекты, которые лежат в корзине
+// дольше, чем до момента before.
+func (r *EntRepo) PurgeDeleted(ctx context.Context, before time.Time) (n int, err error) {
+	ctx = schema.SkipSoftDelete(ctx)
+
+	ids, err := r.client.Project.
+		Query().
+		Where(project.DeletedAtLT(before)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		if err = r.purgeOne(ctx, id); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (r *EntRepo) purgeOne(ctx context.Context, projectID uuid.UUID) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = purgeProject(ctx, tx, projectID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// purgeProject окончательно удаляет проект вместе с задачами (включая
+// задачи из корзины), milestones, вебхуками и участниками. ctx должен быть получен
+// через schema.SkipSoftDelete.
+func purgeProject(ctx context.Context, tx *ent.Tx, projectID uuid.UUID) error {
+	taskIDs, err := tx.ProjectTask.
+		Query().
+		Where(projecttask.HasProjectWith(project.IDEQ(projectID))).
+		QueryTask().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	trashedIDs, err := tx.Task.
+		Query().
+		Where(task.TrashedProjectIDEQ(projectID)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	taskIDs = append(taskIDs, trashedIDs...)
+
+	_, err = tx.ProjectTask.
+		Delete().
+		Where(projecttask.HasProjectWith(project.IDEQ(projectID))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(taskIDs) > 0 {
+		_, err = tx.Task.
+			Update().
+			Where(task.IDIn(taskIDs...)).
+			ClearMilestoneID().
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Milestone.
+		Delete().
+		Where(milestone.HasProjectWith(project.IDEQ(projectID))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(taskIDs) > 0 {
+		_, err = tx.TaskAssignee.
+			Delete().
+			Where(taskassignee.HasTaskWith(task.IDIn(taskIDs...))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TaskAssignmentEvent.
+			Delete().
+			Where(taskassignmentevent.HasTaskWith(task.IDIn(taskIDs...))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TaskWatcher.
+			Delete().
+			Where(taskwatcher.HasTaskWith(task.IDIn(taskIDs...))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TaskActivity.
+			Delete().
+			Where(taskactivity.HasTaskWith(task.IDIn(taskIDs...))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TaskReminder.
+			Delete().
+			Where(taskreminder.HasTaskWith(task.IDIn(taskIDs...))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Task.
+			Delete().
+			Where(task.IDIn(taskIDs...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.WebhookDelivery.
+		Delete().
+		Where(webhookdelivery.HasWebhookWith(webhook.ProjectIDEQ(projectID))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Webhook.
+		Delete().
+		Where(webhook.ProjectIDEQ(projectID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ProjectUser.
+		Delete().
+		Where(projectuser.HasProjectWith(project.IDEQ(projectID))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Project.
+		Delete().
+		Where(project.IDEQ(projectID)).
+		Exec(ctx)
+	return err
+}
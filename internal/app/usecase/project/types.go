@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 type CreateInput struct {
@@ -16,6 +18,14 @@ type CreateInput struct {
 type UpdateInput struct {
 	Name        *string
 	Description *string
+	// ExpectedVersion — версия из If-Match; если задана и не совпадает
+	// с текущей, обновление отклоняется с ErrVersionConflict.
+	ExpectedVersion *int
+}
+
+// ListFilter — фильтр списка проектов. Archived == nil — все проекты.
+type ListFilter struct {
+	Archived *bool
 }
 
 type ProjectMemberDTO struct {
@@ -32,6 +42,16 @@ type ProjectTaskDTO struct {
 	Status      string
 	CreatedAt   time.Time
 	Position    int
+
+	// Assignees заполняется только при Include.TaskAssignees.
+	Assignees []TaskAssigneeDTO
+}
+
+type TaskAssigneeDTO struct {
+	UserID uuid.UUID
+	Name   string
+	Email  string
+	Role   *string
 }
 
 type ProjectDTO struct {
@@ -39,20 +59,107 @@ type ProjectDTO struct {
 	Name        string
 	Description *string
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Version     int
+	ArchivedAt  *time.Time
+	DeletedAt   *time.Time
 
-	Members []ProjectMemberDTO
-	Tasks   []ProjectTaskDTO
+	// Members и Tasks заполняются по Include. MembersNext и TasksNext —
+	// курсоры продолжения для ListMembers и списка задач проекта, если
+	// коллекция не поместилась в Include.Limit.
+	Members     []ProjectMemberDTO
+	Tasks       []ProjectTaskDTO
+	MembersNext string
+	TasksNext   string
+}
+
+// Include — связи, которые загружаются вместе с проектом. Limit
+// ограничивает каждую коллекцию у каждого проекта; 0 — без ограничения.
+type Include struct {
+	Members       bool
+	Tasks         bool
+	TaskAssignees bool
+	Limit         int
+}
+
+type TemplateTaskDTO struct {
+	Title         string
+	Description   string
+	Priority      string
+	Position      int
+	DueOffsetDays *int
+}
+
+type TemplateMemberDTO struct {
+	UserID uuid.UUID
+	Role   string
+}
+
+type TemplateDTO struct {
+	ID          uuid.UUID
+	Name        string
+	Description *string
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+
+	Tasks   []TemplateTaskDTO
+	Members []TemplateMemberDTO
+}
+
+type SaveTemplateInput struct {
+	Name           string
+	Description    *string
+	ActorID        uuid.UUID
+	IncludeMembers bool
+}
+
+type FromTemplateInput struct {
+	Name        string
+	Description *string
+	OwnerID     uuid.UUID
+	// StartDate — дата, от которой пересчитываются сроки задач.
+	// Если не задана, используется текущая дата.
+	StartDate *time.Time
+}
+
+type CloneInput struct {
+	Name           *string
+	Description    *string
+	ActorID        uuid.UUID
+	StartDate      *time.Time
+	IncludeMembers bool
+}
+
+// Blueprint — содержимое проекта, из которого создаётся новый проект:
+// задачи в исходном порядке и, опционально, роли участников.
+type Blueprint struct {
+	Tasks   []TemplateTaskDTO
+	Members []TemplateMemberDTO
 }
 
 type ProjectRepository interface {
 	Create(ctx context.Context, in CreateInput) (ProjectDTO, error)
-	GetByID(ctx context.Context, id uuid.UUID) (ProjectDTO, error)
-	List(ctx context.Context, limit, offset int) ([]ProjectDTO, error)
+	GetByID(ctx context.Context, id uuid.UUID, inc Include) (ProjectDTO, error)
+	List(ctx context.Context, f ListFilter, inc Include, p page.Params) (page.Page[ProjectDTO], error)
+	ListMembers(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[ProjectMemberDTO], error)
 	Update(ctx context.Context, id uuid.UUID, in UpdateInput) (ProjectDTO, error)
 	ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error)
 	UserExists(ctx context.Context, userID uuid.UUID) (bool, error)
 	IsMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error)
 	AddMember(ctx context.Context, projectID, userID uuid.UUID, role string) error
 	GetMemberRole(ctx context.Context, projectID, userID uuid.UUID) (string, error)
-	DeleteProject(ctx context.Context, projectID uuid.UUID) error
+	DeleteProject(ctx context.Context, projectID uuid.UUID, expectedVersion *int) error
+	IsArchived(ctx context.Context, projectID uuid.UUID) (bool, error)
+	SetArchived(ctx context.Context, projectID uuid.UUID, archived bool) (ProjectDTO, error)
+
+	TrashedProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error)
+	ListTrashed(ctx context.Context, ownerID uuid.UUID, p page.Params) (page.Page[ProjectDTO], error)
+	RestoreProject(ctx context.Context, projectID uuid.UUID) (ProjectDTO, error)
+
+	GetBlueprint(ctx context.Context, projectID uuid.UUID, withMembers bool) (Blueprint, error)
+	SaveTemplate(ctx context.Context, in SaveTemplateInput, bp Blueprint) (TemplateDTO, error)
+	GetTemplate(ctx context.Context, id uuid.UUID) (TemplateDTO, error)
+	ListTemplates(ctx context.Context, p page.Params) (page.Page[TemplateDTO], error)
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+	CreateFromBlueprint(ctx context.Context, in CreateInput, bp Blueprint, startDate time.Time) (ProjectDTO, error)
 }
@@ -0,0 +1,130 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+// Activity возвращает ленту событий задачи (новые сверху) с готовыми
+// к показу сообщениями; для правок описания прикладывается построчный diff.
+func (uc *UseCase) Activity(ctx context.Context, taskID uuid.UUID, p page.Params) (page.Page[ActivityDTO], error) {
+	p = p.Normalize()
+
+	if _, err := uc.repo.GetByID(ctx, taskID, Include{}); err != nil {
+		return page.Page[ActivityDTO]{}, err
+	}
+
+	res, err := uc.repo.ListActivity(ctx, taskID, p)
+	if err != nil {
+		return page.Page[ActivityDTO]{}, err
+	}
+
+	names, err := uc.repo.UserNames(ctx, activityUserIDs(res.Items))
+	if err != nil {
+		return page.Page[ActivityDTO]{}, err
+	}
+
+	for i := range res.Items {
+		renderActivity(&res.Items[i], names)
+	}
+	return res, nil
+}
+
+func activityUserIDs(items []ActivityDTO) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{})
+	var ids []uuid.UUID
+	add := func(id uuid.UUID) {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+
+	for _, a := range items {
+		if a.ActorID != nil {
+			add(*a.ActorID)
+		}
+		if a.Kind == "assigned" || a.Kind == "unassigned" {
+			for _, v := range []*string{a.From, a.To} {
+				if v == nil {
+					continue
+				}
+				if id, err := uuid.Parse(*v); err == nil {
+					add(id)
+				}
+			}
+		}
+	}
+	return ids
+}
+
+func renderActivity(a *ActivityDTO, names map[uuid.UUID]string) {
+	actor := "Someone"
+	if a.ActorID != nil {
+		if n, ok := names[*a.ActorID]; ok {
+			actor = n
+			a.ActorName = &n
+		}
+	}
+
+	userName := func(v *string) string {
+		if v == nil {
+			return "someone"
+		}
+		if id, err := uuid.Parse(*v); err == nil {
+			if n, ok := names[id]; ok {
+				return n
+			}
+		}
+		return *v
+	}
+
+	switch a.Kind {
+	case "created":
+		a.Message = fmt.Sprintf("%s created the task", actor)
+	case "title_changed":
+		a.Message = fmt.Sprintf("%s renamed the task from %q to %q", actor, deref(a.From), deref(a.To))
+	case "description_changed":
+		switch {
+		case a.From == nil:
+			a.Message = fmt.Sprintf("%s added a description", actor)
+		case a.To == nil:
+			a.Message = fmt.Sprintf("%s removed the description", actor)
+		default:
+			a.Message = fmt.Sprintf("%s edited the description", actor)
+		}
+		a.Diff = diffLines(deref(a.From), deref(a.To))
+	case "status_changed":
+		a.Message = fmt.Sprintf("%s changed status from %s to %s", actor, deref(a.From), deref(a.To))
+	case "moved":
+		a.Message = fmt.Sprintf("%s moved the task from position %s to %s", actor, deref(a.From), deref(a.To))
+	case "assigned":
+		a.Message = fmt.Sprintf("%s assigned %s", actor, userName(a.To))
+	case "unassigned":
+		a.Message = fmt.Sprintf("%s unassigned %s", actor, userName(a.From))
+	case "milestone_changed":
+		switch {
+		case a.To == nil:
+			a.Message = fmt.Sprintf("%s removed the task from milestone %q", actor, deref(a.From))
+		default:
+			a.Message = fmt.Sprintf("%s moved the task to milestone %q", actor, deref(a.To))
+		}
+	case "deleted":
+		a.Message = fmt.Sprintf("%s moved the task to trash", actor)
+	case "restored":
+		a.Message = fmt.Sprintf("%s restored the task from trash", actor)
+	default:
+		a.Message = fmt.Sprintf("%s changed the task", actor)
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,89 @@
+package task
+
+import "strings"
+
+// maxDiffCells ограничивает таблицу LCS для изменённой середины текста
+// (после отбрасывания общих начала и конца). Сверх лимита середина
+// отдаётся как «всё удалено / всё добавлено».
+const maxDiffCells = 10_000
+
+// diffLines строит построчный diff двух текстов по наибольшей общей
+// подпоследовательности.
+func diffLines(from, to string) []DiffLine {
+	a := splitLines(from)
+	b := splitLines(to)
+	out := make([]DiffLine, 0, max(len(a), len(b)))
+
+	// Обычная правка меняет несколько строк подряд: общие начало и конец
+	// не попадают в таблицу.
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		out = append(out, DiffLine{Op: DiffEqual, Text: a[pre]})
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+
+	out = diffMiddle(out, a[pre:len(a)-suf], b[pre:len(b)-suf])
+	for _, l := range a[len(a)-suf:] {
+		out = append(out, DiffLine{Op: DiffEqual, Text: l})
+	}
+	return out
+}
+
+func diffMiddle(out []DiffLine, a, b []string) []DiffLine {
+	if len(a)*len(b) > maxDiffCells {
+		for _, l := range a {
+			out = append(out, DiffLine{Op: DiffRemoved, Text: l})
+		}
+		for _, l := range b {
+			out = append(out, DiffLine{Op: DiffAdded, Text: l})
+		}
+		return out
+	}
+
+	// lcs[i*w+j] — длина LCS для a[i:] и b[j:].
+	w := len(b) + 1
+	lcs := make([]int32, (len(a)+1)*w)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else {
+				lcs[i*w+j] = max(lcs[(i+1)*w+j], lcs[i*w+j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, DiffLine{Op: DiffEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[(i+1)*w+j] >= lcs[i*w+j+1]:
+			out = append(out, DiffLine{Op: DiffRemoved, Text: a[i]})
+			i++
+		default:
+			out = append(out, DiffLine{Op: DiffAdded, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, DiffLine{Op: DiffRemoved, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		out = append(out, DiffLine{Op: DiffAdded, Text: b[j]})
+	}
+	return out
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
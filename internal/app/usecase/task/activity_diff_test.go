@@ -0,0 +1,86 @@
+package task
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	eq := func(s string) DiffLine { return DiffLine{Op: DiffEqual, Text: s} }
+	add := func(s string) DiffLine { return DiffLine{Op: DiffAdded, Text: s} }
+	del := func(s string) DiffLine { return DiffLine{Op: DiffRemoved, Text: s} }
+
+	tests := []struct {
+		name     string
+		from, to string
+		want     []DiffLine
+	}{
+		{name: "both empty", want: []DiffLine{}},
+		{name: "added", to: "a\nb", want: []DiffLine{add("a"), add("b")}},
+		{name: "removed", from: "a\nb", want: []DiffLine{del("a"), del("b")}},
+		{name: "unchanged", from: "a\nb", to: "a\nb", want: []DiffLine{eq("a"), eq("b")}},
+		{
+			name: "line changed in the middle",
+			from: "a\nb\nc", to: "a\nx\nc",
+			want: []DiffLine{eq("a"), del("b"), add("x"), eq("c")},
+		},
+		{
+			name: "line inserted",
+			from: "a\nc", to: "a\nb\nc",
+			want: []DiffLine{eq("a"), add("b"), eq("c")},
+		},
+		{
+			name: "lines reordered",
+			from: "a\nb\nc\nd", to: "a\nc\nb\nd",
+			want: []DiffLine{eq("a"), del("b"), eq("c"), add("b"), eq("d")},
+		},
+		{
+			name: "windows line endings",
+			from: "a\r\nb", to: "a\nb\nc",
+			want: []DiffLine{eq("a"), eq("b"), add("c")},
+		},
+		{
+			name: "repeated lines",
+			from: "x\nx", to: "x",
+			want: []DiffLine{eq("x"), del("x")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffLines(tt.from, tt.to)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("diffLines(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffLinesOverLimit(t *testing.T) {
+	var from, to []string
+	for i := range 200 {
+		from = append(from, fmt.Sprintf("old %d", i))
+		to = append(to, fmt.Sprintf("new %d", i))
+	}
+	from = append([]string{"head"}, append(from, "tail")...)
+	to = append([]string{"head"}, append(to, "tail")...)
+
+	got := diffLines(strings.Join(from, "\n"), strings.Join(to, "\n"))
+	if len(got) != 402 {
+		t.Fatalf("len = %d, want 402", len(got))
+	}
+	if got[0] != (DiffLine{Op: DiffEqual, Text: "head"}) || got[401] != (DiffLine{Op: DiffEqual, Text: "tail"}) {
+		t.Errorf("common head and tail lost: %v ... %v", got[0], got[401])
+	}
+	for _, l := range got[1:201] {
+		if l.Op != DiffRemoved {
+			t.Fatalf("middle of old text: got %v, want removed", l)
+		}
+	}
+	for _, l := range got[201:401] {
+		if l.Op != DiffAdded {
+			t.Fatalf("middle of new text: got %v, want added", l)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package task
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+// activityKeys — лента задачи, новые первыми.
+var activityKeys = page.Keyset{
+	{Field: taskactivity.FieldCreatedAt, Desc: true},
+	{Field: taskactivity.FieldID, Desc: true},
+}
+
+func (r *EntRepo) ListActivity(ctx context.Context, taskID uuid.UUID, p page.Params) (page.Page[ActivityDTO], error) {
+	c, err := activityKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[ActivityDTO]{}, err
+	}
+
+	q := r.client.TaskActivity.
+		Query().
+		Where(taskactivity.HasTaskWith(task.IDEQ(taskID)))
+	var res page.Page[ActivityDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[ActivityDTO]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.TaskActivity](activityKeys, c)).
+		Order(page.Order[taskactivity.OrderOption](activityKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[ActivityDTO]{}, err
+	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(a *ent.TaskActivity) []any {
+		return []any{a.CreatedAt, a.ID}
+	})
+
+	out := make([]ActivityDTO, 0, len(rows))
+	for _, a := range rows {
+		out = append(out, ActivityDTO{
+			ID:        a.ID,
+			Kind:      string(a.Kind),
+			ActorID:   a.ActorID,
+			From:      a.FromValue,
+			To:        a.ToValue,
+			CreatedAt: a.CreatedAt,
+		})
+	}
+	res.Items = out
+	return res, nil
+}
+
+func (r *EntRepo) UserNames(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error) {
+	out := make(map[uuid.UUID]string, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	users, err := r.client.User.
+		Query().
+		Where(user.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		out[u.ID] = u.Name
+	}
+	return out, nil
+}
+
+// recordActivity добавляет событие в ленту задачи. Если actor не передан
+// явно, он берётся из контекста запроса.
+func recordActivity(
+	ctx context.Context,
+	c *ent.Client,
+	taskID uuid.UUID,
+	actorID *uuid.UUID,
+	kind taskactivity.Kind,
+	from, to *string,
+) error {
+	if actorID == nil {
+		if id, ok := audit.ActorFrom(ctx); ok {
+			actorID = &id
+		}
+	}
+
+	return c.TaskActivity.
+		Create().
+		SetTaskID(taskID).
+		SetKind(kind).
+		SetNillableActorID(actorID).
+		SetNillableFromValue(from).
+		SetNillableToValue(to).
+		Exec(ctx)
+}
+
+// recordTaskChanges пишет в ленту изменения полей задачи после Update.
+func recordTaskChanges(ctx context.Context, c *ent.Client, old, cur *ent.Task) error {
+	if old.Title != cur.Title {
+		err := recordActivity(ctx, c, cur.ID, nil, taskactivity.KindTitleChanged, strPtr(old.Title), strPtr(cur.Title))
+		if err != nil {
+			return err
+		}
+	}
+	if old.Description != cur.Description {
+		var from, to *string
+		if old.Description != "" {
+			from = strPtr(old.Description)
+		}
+		if cur.Description != "" {
+			to = strPtr(cur.Description)
+		}
+		if err := recordActivity(ctx, c, cur.ID, nil, taskactivity.KindDescriptionChanged, from, to); err != nil {
+			return err
+		}
+	}
+	if old.Status != cur.Status {
+		err := recordActivity(ctx, c, cur.ID, nil, taskactivity.KindStatusChanged,
+			strPtr(string(old.Status)), strPtr(string(cur.Status)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func strPtr(s string) *string { return &s }
+
+func intStr(n int) *string { return strPtr(strconv.Itoa(n)) }
@@ -1,8 +1,23 @@
 package task
 
-import "errors"
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
 
 var (
-	ErrNotFound  = errors.New("task not found")
-	ErrForbidden = errors.New("forbidden")
+	ErrNotFound         = apperr.NotFound("task_not_found", "task not found")
+	ErrForbidden        = apperr.Forbidden("forbidden", "forbidden")
+	ErrUserNotFound     = apperr.NotFound("user_not_found", "user not found")
+	ErrNotProjectMember = apperr.Forbidden("not_project_member", "user not in project")
+	ErrAlreadyAssigned  = apperr.Conflict("already_assigned", "already assigned")
+	ErrNotAssigned      = apperr.NotFound("not_assigned", "not assigned")
+	ErrInvalidRole      = apperr.Invalid("invalid_role", "invalid assignee role")
+	ErrAlreadyWatching  = apperr.Conflict("already_watching", "already watching")
+	ErrNotWatching      = apperr.NotFound("not_watching", "not watching")
+	ErrProjectNotFound  = apperr.NotFound("project_not_found", "project not found")
+	ErrProjectDeleted   = apperr.Conflict("project_deleted", "project is deleted, restore it first")
+	ErrProjectArchived  = apperr.Conflict("project_archived", "project is archived")
+	ErrVersionConflict  = apperr.Precondition("version_mismatch", "task version conflict")
+
+	ErrTitleRequired   = apperr.Invalid("title_required", "title is required")
+	ErrInvalidStatus   = apperr.Invalid("invalid_status", "invalid status")
+	ErrInvalidPosition = apperr.Invalid("invalid_position", "position must be >= 0")
 )
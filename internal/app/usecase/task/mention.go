@@ -0,0 +1,59 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/notification"
+)
+
+// mentionRe находит упоминания вида @user@example.com.
+var mentionRe = regexp.MustCompile(`(?:^|[^\w.@])@([\w.+-]+@[\w-]+(?:\.[\w-]+)+)`)
+
+// mentionedEmails возвращает адреса, упомянутые в text и отсутствовавшие в
+// previous: при правке описания уведомляются только новые упоминания.
+func mentionedEmails(previous, text string) []string {
+	seen := make(map[string]bool)
+	for _, m := range mentionRe.FindAllStringSubmatch(previous, -1) {
+		seen[strings.ToLower(m[1])] = true
+	}
+
+	var out []string
+	for _, m := range mentionRe.FindAllStringSubmatch(text, -1) {
+		email := strings.ToLower(strings.TrimRight(m[1], "."))
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+		out = append(out, email)
+	}
+	return out
+}
+
+// notifyMentions уведомляет участников проекта, упомянутых в описании задачи.
+func (uc *UseCase) notifyMentions(ctx context.Context, projectID uuid.UUID, t TaskDTO, previous string, actorID *uuid.UUID) {
+	emails := mentionedEmails(previous, t.Description)
+	if len(emails) == 0 {
+		return
+	}
+
+	ids, err := uc.repo.ProjectMemberIDsByEmail(ctx, projectID, emails)
+	if err != nil {
+		log.Printf("notify mentions in task %s: %v", t.ID, err)
+		return
+	}
+
+	uc.notify(ctx, notification.Event{
+		Type:       notification.TypeTaskMentioned,
+		Recipients: ids,
+		ActorID:    actorID,
+		ProjectID:  &projectID,
+		TaskID:     &t.ID,
+		Message:    fmt.Sprintf("%s mentioned you in %q", uc.userName(ctx, actorID), t.Title),
+	})
+}
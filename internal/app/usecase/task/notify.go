@@ -0,0 +1,107 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/notification"
+)
+
+// Notifier доставляет уведомления пользователям.
+type Notifier interface {
+	Notify(ctx context.Context, ev notification.Event) error
+}
+
+// notifyWatchers сообщает наблюдателям задачи об изменении. Вызывается после
+// сохранения, поэтому ошибки только логируются. exclude — кто получает
+// отдельное уведомление об этом же событии.
+func (uc *UseCase) notifyWatchers(ctx context.Context, t TaskDTO, actorID *uuid.UUID, message string, exclude ...uuid.UUID) {
+	skip := make(map[uuid.UUID]bool, len(exclude))
+	for _, id := range exclude {
+		skip[id] = true
+	}
+
+	recipients := make([]uuid.UUID, 0, len(t.Watchers))
+	for _, w := range t.Watchers {
+		if !skip[w.UserID] {
+			recipients = append(recipients, w.UserID)
+		}
+	}
+
+	uc.notify(ctx, notification.Event{
+		Type:       notification.TypeTaskChanged,
+		Recipients: recipients,
+		ActorID:    actorID,
+		ProjectID:  &t.ProjectID,
+		TaskID:     &t.ID,
+		Message:    message,
+	})
+}
+
+// notifyTaskChanged перечитывает задачу и уведомляет наблюдателей;
+// format получает имя автора и название задачи.
+func (uc *UseCase) notifyTaskChanged(ctx context.Context, taskID uuid.UUID, actorID *uuid.UUID, format string) {
+	t, err := uc.repo.GetByID(ctx, taskID, withEdges)
+	if err != nil {
+		log.Printf("notify task %s: %v", taskID, err)
+		return
+	}
+	uc.notifyWatchers(ctx, t, actorID, fmt.Sprintf(format, uc.userName(ctx, actorID), t.Title))
+}
+
+func (uc *UseCase) notifyAssignment(ctx context.Context, taskID, actorID, userID uuid.UUID, assigned bool) {
+	t, err := uc.repo.GetByID(ctx, taskID, withEdges)
+	if err != nil {
+		log.Printf("notify task %s: %v", taskID, err)
+		return
+	}
+
+	actor := uc.userName(ctx, &actorID)
+	target := uc.userName(ctx, &userID)
+
+	if assigned {
+		uc.notify(ctx, notification.Event{
+			Type:       notification.TypeTaskAssigned,
+			Recipients: []uuid.UUID{userID},
+			ActorID:    &actorID,
+			ProjectID:  &t.ProjectID,
+			TaskID:     &t.ID,
+			Message:    fmt.Sprintf("%s assigned you to %q", actor, t.Title),
+		})
+		uc.notifyWatchers(ctx, t, &actorID, fmt.Sprintf("%s assigned %s to %q", actor, target, t.Title), userID)
+		return
+	}
+	uc.notifyWatchers(ctx, t, &actorID, fmt.Sprintf("%s unassigned %s from %q", actor, target, t.Title))
+}
+
+func (uc *UseCase) notify(ctx context.Context, ev notification.Event) {
+	if uc.notifier == nil {
+		return
+	}
+	if err := uc.notifier.Notify(ctx, ev); err != nil {
+		log.Printf("notify %s: %v", ev.Type, err)
+	}
+}
+
+// actorFromContext — автор изменения для операций, где он не передаётся явно.
+func actorFromContext(ctx context.Context) *uuid.UUID {
+	if id, ok := audit.ActorFrom(ctx); ok {
+		return &id
+	}
+	return nil
+}
+
+func (uc *UseCase) userName(ctx context.Context, id *uuid.UUID) string {
+	if id == nil {
+		return "Someone"
+	}
+	names, err := uc.repo.UserNames(ctx, []uuid.UUID{*id})
+	if err != nil || names[*id] == "" {
+		return "Someone"
+	}
+	return names[*id]
+}
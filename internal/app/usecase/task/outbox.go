@@ -0,0 +1,80 @@
+package task
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+)
+
+// recordTaskEvent пишет в outbox событие с текущим состоянием задачи.
+// c — клиент транзакции, в которой задача изменяется.
+func recordTaskEvent(ctx context.Context, c *ent.Client, typ string, taskID uuid.UUID, actorID *uuid.UUID) error {
+	t, err := getTask(ctx, c, taskID, withEdges)
+	if err != nil {
+		return err
+	}
+	return outbox.Record(ctx, c, outbox.Draft{
+		Type:          typ,
+		AggregateType: outbox.AggregateTask,
+		AggregateID:   t.ID,
+		ProjectID:     &t.ProjectID,
+		ActorID:       actorID,
+		Data:          outbox.TaskEventData{Task: toTaskData(t)},
+	})
+}
+
+func recordMoveEvent(ctx context.Context, c *ent.Client, taskID uuid.UUID, from, to int) error {
+	t, err := getTask(ctx, c, taskID, withEdges)
+	if err != nil {
+		return err
+	}
+	return outbox.Record(ctx, c, outbox.Draft{
+		Type:          outbox.TypeTaskMoved,
+		AggregateType: outbox.AggregateTask,
+		AggregateID:   t.ID,
+		ProjectID:     &t.ProjectID,
+		Data:          outbox.MoveEventData{Task: toTaskData(t), From: from, To: to},
+	})
+}
+
+func recordAssignmentEvent(ctx context.Context, c *ent.Client, typ string, taskID, actorID, userID uuid.UUID, role *string) error {
+	t, err := getTask(ctx, c, taskID, withEdges)
+	if err != nil {
+		return err
+	}
+	return outbox.Record(ctx, c, outbox.Draft{
+		Type:          typ,
+		AggregateType: outbox.AggregateTask,
+		AggregateID:   t.ID,
+		ProjectID:     &t.ProjectID,
+		ActorID:       &actorID,
+		Data: outbox.AssignmentEventData{
+			Task:   toTaskData(t),
+			UserID: userID,
+			Role:   role,
+		},
+	})
+}
+
+func toTaskData(t TaskDTO) outbox.TaskData {
+	assignees := make([]uuid.UUID, 0, len(t.Assignees))
+	for _, a := range t.Assignees {
+		assignees = append(assignees, a.UserID)
+	}
+	return outbox.TaskData{
+		ID:          t.ID,
+		ProjectID:   t.ProjectID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      t.Status,
+		Position:    t.Position,
+		MilestoneID: t.MilestoneID,
+		DueDate:     t.DueDate,
+		Assignees:   assignees,
+		Version:     t.Version,
+		CreatedAt:   t.CreatedAt,
+	}
+}
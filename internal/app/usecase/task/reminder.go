@@ -0,0 +1,107 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/notification"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+// reminderBatch — сколько задач обрабатывается за один проход по порогу.
+const reminderBatch = 500
+
+func (uc *UseCase) ListOverdueByProject(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[TaskDTO], error) {
+	p = p.Normalize()
+
+	ok, err := uc.repo.ProjectExists(ctx, projectID)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+	if !ok {
+		return page.Page[TaskDTO]{}, ErrProjectNotFound
+	}
+
+	return uc.repo.ListOverdue(ctx, OverdueFilter{ProjectID: &projectID}, time.Now(), p)
+}
+
+// ListOverdueByUser — просроченные задачи, где пользователь среди исполнителей.
+func (uc *UseCase) ListOverdueByUser(ctx context.Context, userID uuid.UUID, p page.Params) (page.Page[TaskDTO], error) {
+	p = p.Normalize()
+
+	ok, err := uc.repo.UserExists(ctx, userID)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+	if !ok {
+		return page.Page[TaskDTO]{}, ErrUserNotFound
+	}
+
+	return uc.repo.ListOverdue(ctx, OverdueFilter{AssigneeID: &userID}, time.Now(), p)
+}
+
+// SendReminders отправляет напоминания по задачам, срок которых наступает
+// в течение dueSoon или уже прошёл. По каждому порогу задача получает одно
+// напоминание; возвращает число отправленных.
+func (uc *UseCase) SendReminders(ctx context.Context, now time.Time, dueSoon time.Duration) (int, error) {
+	sent := 0
+
+	overdue, err := uc.repo.ListPendingReminders(ctx, ReminderOverdue, time.Time{}, now, reminderBatch)
+	if err != nil {
+		return sent, err
+	}
+	// Задача, которая уже просрочена, не получает ещё и «скоро срок».
+	soon, err := uc.repo.ListPendingReminders(ctx, ReminderDueSoon, now, now.Add(dueSoon), reminderBatch)
+	if err != nil {
+		return sent, err
+	}
+
+	for _, rem := range append(overdue, soon...) {
+		created, err := uc.repo.CreateReminder(ctx, rem)
+		if err != nil {
+			return sent, err
+		}
+		if !created {
+			continue
+		}
+		sent++
+		log.Printf("reminder %s: task %s (project %s) due %s",
+			rem.Kind, rem.TaskID, rem.ProjectID, rem.DueDate.Format(time.RFC3339))
+		uc.notifyReminder(ctx, rem)
+	}
+	return sent, nil
+}
+
+// notifyReminder уведомляет исполнителей и наблюдателей задачи о сроке.
+func (uc *UseCase) notifyReminder(ctx context.Context, rem ReminderDTO) {
+	t, err := uc.repo.GetByID(ctx, rem.TaskID, withEdges)
+	if err != nil {
+		log.Printf("notify task %s: %v", rem.TaskID, err)
+		return
+	}
+
+	recipients := make([]uuid.UUID, 0, len(t.Assignees)+len(t.Watchers))
+	for _, a := range t.Assignees {
+		recipients = append(recipients, a.UserID)
+	}
+	for _, w := range t.Watchers {
+		recipients = append(recipients, w.UserID)
+	}
+
+	ev := notification.Event{
+		Type:       notification.TypeTaskDueSoon,
+		Recipients: recipients,
+		ProjectID:  &rem.ProjectID,
+		TaskID:     &rem.TaskID,
+		Message:    fmt.Sprintf("%q is due %s", t.Title, rem.DueDate.UTC().Format("2006-01-02 15:04 MST")),
+	}
+	if rem.Kind == ReminderOverdue {
+		ev.Type = notification.TypeTaskOverdue
+		ev.Message = fmt.Sprintf("%q is overdue since %s", t.Title, rem.DueDate.UTC().Format("2006-01-02 15:04 MST"))
+	}
+	uc.notify(ctx, ev)
+}
@@ -0,0 +1,183 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+func (r *EntRepo) ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return r.client.Project.Query().Where(project.IDEQ(projectID)).Exist(ctx)
+}
+
+// overdueKeys — просроченные задачи, самые старые сроки первыми.
+var overdueKeys = page.Keyset{
+	{Field: task.FieldDueDate},
+	{Field: task.FieldID},
+}
+
+// ListOverdue возвращает незавершённые задачи со сроком раньше now,
+// самые просроченные — первыми.
+func (r *EntRepo) ListOverdue(ctx context.Context, f OverdueFilter, now time.Time, p page.Params) (page.Page[TaskDTO], error) {
+	c, err := overdueKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+
+	projectPreds := []predicate.Project{project.DeletedAtIsNil()}
+	if f.ProjectID != nil {
+		projectPreds = append(projectPreds, project.IDEQ(*f.ProjectID))
+	}
+
+	q := r.client.Task.
+		Query().
+		Where(
+			task.DueDateNotNil(),
+			task.DueDateLT(now),
+			task.StatusNEQ(task.StatusDone),
+			task.HasProjectTasksWith(projecttask.HasProjectWith(projectPreds...)),
+		)
+	if f.AssigneeID != nil {
+		q.Where(task.HasAssigneesWith(taskassignee.HasUserWith(user.IDEQ(*f.AssigneeID))))
+	}
+
+	var res page.Page[TaskDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.Task](overdueKeys, c)).
+		WithProjectTasks(func(pq *ent.ProjectTaskQuery) {
+			pq.WithProject()
+		}).
+		WithAssignees(func(aq *ent.TaskAssigneeQuery) {
+			aq.WithUser().Order(ent.Asc(taskassignee.FieldCreatedAt))
+		}).
+		Order(page.Order[task.OrderOption](overdueKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(t *ent.Task) []any {
+		return []any{t.DueDate, t.ID}
+	})
+
+	out := make([]TaskDTO, 0, len(rows))
+	for _, t := range rows {
+		item := TaskDTO{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      string(t.Status),
+			CreatedAt:   t.CreatedAt,
+			Version:     t.Version,
+			MilestoneID: t.MilestoneID,
+			DueDate:     dueDate(t),
+
+			Assignees: toAssigneeDTOs(t.Edges.Assignees),
+		}
+		if len(t.Edges.ProjectTasks) > 0 {
+			pt := t.Edges.ProjectTasks[0]
+			item.Position = pt.Position
+			if pt.Edges.Project != nil {
+				item.ProjectID = pt.Edges.Project.ID
+			}
+		}
+		out = append(out, item)
+	}
+	res.Items = out
+	return res, nil
+}
+
+// ListPendingReminders возвращает незавершённые задачи со сроком в
+// интервале (from, to], по которым напоминание kind ещё не отправлялось.
+// Нулевой from означает «без нижней границы».
+func (r *EntRepo) ListPendingReminders(ctx context.Context, kind string, from, to time.Time, limit int) ([]ReminderDTO, error) {
+	q := r.client.Task.
+		Query().
+		Where(
+			task.DueDateNotNil(),
+			task.DueDateLTE(to),
+			task.StatusNEQ(task.StatusDone),
+			task.HasProjectTasksWith(projecttask.HasProjectWith(project.DeletedAtIsNil())),
+			task.Not(task.HasRemindersWith(taskreminder.KindEQ(taskreminder.Kind(kind)))),
+		)
+	if !from.IsZero() {
+		q.Where(task.DueDateGT(from))
+	}
+
+	rows, err := q.
+		WithProjectTasks(func(pq *ent.ProjectTaskQuery) {
+			pq.WithProject()
+		}).
+		Order(ent.Asc(task.FieldDueDate)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]ReminderDTO, 0, len(rows))
+	for _, t := range rows {
+		if len(t.Edges.ProjectTasks) == 0 || t.Edges.ProjectTasks[0].Edges.Project == nil {
+			continue
+		}
+		out = append(out, ReminderDTO{
+			TaskID:    t.ID,
+			ProjectID: t.Edges.ProjectTasks[0].Edges.Project.ID,
+			Title:     t.Title,
+			Kind:      kind,
+			DueDate:   t.DueDate,
+		})
+	}
+	return out, nil
+}
+
+// CreateReminder фиксирует напоминание. false — его уже записала другая
+// реплика (уникальный индекс по задаче и порогу).
+func (r *EntRepo) CreateReminder(ctx context.Context, rem ReminderDTO) (bool, error) {
+	err := r.client.TaskReminder.
+		Create().
+		SetTaskID(rem.TaskID).
+		SetKind(taskreminder.Kind(rem.Kind)).
+		SetDueDate(rem.DueDate).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// resetReminders удаляет отправленные напоминания при смене срока задачи.
+func resetReminders(ctx context.Context, tx *ent.Tx, taskID uuid.UUID) error {
+	_, err := tx.TaskReminder.
+		Delete().
+		Where(taskreminder.HasTaskWith(task.IDEQ(taskID))).
+		Exec(ctx)
+	return err
+}
+
+func dueDate(t *ent.Task) *time.Time {
+	if t.DueDate.IsZero() {
+		return nil
+	}
+	d := t.DueDate
+	return &d
+}
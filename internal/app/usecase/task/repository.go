@@ -3,35 +3,73 @@ package task
 import (
 	"context"
 	"errors"
+	"project-manager-dashboard-go/ent/predicate"
 	"project-manager-dashboard-go/ent/projectuser"
 	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
 	"project-manager-dashboard-go/ent/user"
+	"time"
 
 	"github.com/google/uuid"
 	"project-manager-dashboard-go/ent"
 	"project-manager-dashboard-go/ent/project"
 	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/schema"
 	enttask "project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 type EntRepo struct{ client *ent.Client }
 
 func NewEntRepo(c *ent.Client) *EntRepo { return &EntRepo{client: c} }
 
-func (r *EntRepo) ListByProject(ctx context.Context, projectID uuid.UUID, limit, offset int) ([]TaskDTO, error) {
-	rows, err := r.client.ProjectTask.
+// projectTaskKeys — задачи проекта в порядке доски. Задача, которую
+// переместили между запросами страниц, может попасть на страницу дважды
+// или не попасть совсем; id делает порядок задач с равной позицией
+// однозначным.
+var projectTaskKeys = page.Keyset{
+	{Field: projecttask.FieldPosition},
+	{Field: projecttask.FieldID},
+}
+
+func (r *EntRepo) ListByProject(ctx context.Context, projectID uuid.UUID, f ListFilter, inc Include, p page.Params) (page.Page[TaskDTO], error) {
+	c, err := projectTaskKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+
+	q := r.client.ProjectTask.
 		Query().
-		Where(projecttask.HasProjectWith(project.IDEQ(projectID))).
+		Where(projecttask.HasProjectWith(project.IDEQ(projectID), project.DeletedAtIsNil()))
+	if f.AssigneeID != nil {
+		q.Where(projecttask.HasTaskWith(
+			task.HasAssigneesWith(taskassignee.HasUserWith(user.IDEQ(*f.AssigneeID))),
+		))
+	}
+
+	var res page.Page[TaskDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.ProjectTask](projectTaskKeys, c)).
 		WithTask(func(tq *ent.TaskQuery) {
-			tq.WithAssignee()
+			withIncludes(tq, inc)
 		}).
-		Order(ent.Asc(projecttask.FieldPosition), ent.Asc(projecttask.FieldCreatedAt)).
-		Limit(limit).
-		Offset(offset).
+		Order(page.Order[projecttask.OrderOption](projectTaskKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
 		All(ctx)
 	if err != nil {
-		return nil, err
+		return page.Page[TaskDTO]{}, err
 	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(pt *ent.ProjectTask) []any {
+		return []any{pt.Position, pt.ID}
+	})
 
 	out := make([]TaskDTO, 0, len(rows))
 	for _, row := range rows {
@@ -40,28 +78,27 @@ func (r *EntRepo) ListByProject(ctx context.Context, projectID uuid.UUID, limit,
 			continue
 		}
 
-		var assignee *TaskAssigneeDTO
-		if u := t.Edges.Assignee; u != nil {
-			assignee = &TaskAssigneeDTO{
-				UserID: u.ID,
-				Name:   u.Name,
-				Email:  u.Email,
-			}
-		}
-
-		out = append(out, TaskDTO{
+		item := TaskDTO{
 			ID:          t.ID,
 			Title:       t.Title,
 			Description: t.Description,
 			Status:      string(t.Status),
 			CreatedAt:   t.CreatedAt,
+			Version:     t.Version,
+			DueDate:     dueDate(t),
 			Position:    row.Position,
+			MilestoneID: t.MilestoneID,
 
-			Assignee: assignee,
-		})
+			Assignees: toAssigneeDTOs(t.Edges.Assignees),
+		}
+		if inc.Watchers {
+			item.Watchers = toWatcherDTOs(t.Edges.Watchers)
+		}
+		out = append(out, item)
 	}
 
-	return out, nil
+	res.Items = out
+	return res, nil
 }
 
 func (r *EntRepo) CreateInProject(ctx context.Context, projectID uuid.UUID, in CreateInput) (TaskDTO, error) {
@@ -78,6 +115,9 @@ func (r *EntRepo) CreateInProject(ctx context.Context, projectID uuid.UUID, in C
 	if in.Status != "" {
 		tc.SetStatus(task.Status(in.Status))
 	}
+	if in.DueDate != nil {
+		tc.SetDueDate(*in.DueDate)
+	}
 
 	t, err := tc.Save(ctx)
 	if err != nil {
@@ -109,6 +149,19 @@ func (r *EntRepo) CreateInProject(ctx context.Context, projectID uuid.UUID, in C
 		return TaskDTO{}, err
 	}
 
+	if in.CreatorID != nil {
+		if err := ensureWatcher(ctx, tx, t.ID, *in.CreatorID); err != nil {
+			return TaskDTO{}, err
+		}
+	}
+
+	if err := recordActivity(ctx, tx.Client(), t.ID, in.CreatorID, taskactivity.KindCreated, nil, nil); err != nil {
+		return TaskDTO{}, err
+	}
+	if err := recordTaskEvent(ctx, tx.Client(), outbox.TypeTaskCreated, t.ID, in.CreatorID); err != nil {
+		return TaskDTO{}, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return TaskDTO{}, err
 	}
@@ -119,7 +172,10 @@ func (r *EntRepo) CreateInProject(ctx context.Context, projectID uuid.UUID, in C
 		Description: t.Description,
 		Status:      string(t.Status),
 		CreatedAt:   t.CreatedAt,
+		Version:     t.Version,
+		DueDate:     dueDate(t),
 		Position:    pt.Position, // если добавил поле
+		ProjectID:   projectID,
 	}, nil
 }
 
@@ -130,7 +186,18 @@ func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (Tas
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	old, err := tx.Task.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return TaskDTO{}, ErrNotFound
+		}
+		return TaskDTO{}, err
+	}
+
 	u := tx.Task.UpdateOneID(id)
+	if in.ExpectedVersion != nil {
+		u.Where(task.VersionEQ(*in.ExpectedVersion))
+	}
 
 	if in.Title != nil {
 		u.SetTitle(*in.Title)
@@ -145,15 +212,33 @@ func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (Tas
 	if in.Status != nil {
 		u.SetStatus(task.Status(*in.Status))
 	}
+	if in.ClearDueDate {
+		u.ClearDueDate()
+	} else if in.DueDate != nil {
+		u.SetDueDate(*in.DueDate)
+	}
 
 	t, err := u.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return TaskDTO{}, errors.New("task not found")
+			// Строку мы только что прочитали, значит не совпала версия.
+			if in.ExpectedVersion != nil {
+				return TaskDTO{}, ErrVersionConflict
+			}
+			return TaskDTO{}, ErrNotFound
 		}
 		return TaskDTO{}, err
 	}
 
+	if err := recordTaskChanges(ctx, tx.Client(), old, t); err != nil {
+		return TaskDTO{}, err
+	}
+	if !old.DueDate.Equal(t.DueDate) {
+		if err := resetReminders(ctx, tx, id); err != nil {
+			return TaskDTO{}, err
+		}
+	}
+
 	pt, err := tx.ProjectTask.
 		Query().
 		Where(projecttask.HasTaskWith(enttask.IDEQ(id))).
@@ -161,11 +246,11 @@ func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (Tas
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return TaskDTO{}, errors.New("task not found")
+			return TaskDTO{}, ErrNotFound
 		}
 		var nse *ent.NotSingularError
 		if errors.As(err, &nse) {
-			return TaskDTO{}, errors.New("task not found")
+			return TaskDTO{}, ErrNotFound
 		}
 		return TaskDTO{}, err
 	}
@@ -177,7 +262,7 @@ func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (Tas
 		}
 
 		if pt.Edges.Project == nil {
-			return TaskDTO{}, errors.New("task not found")
+			return TaskDTO{}, ErrNotFound
 		}
 		projectID := pt.Edges.Project.ID
 		curPos := pt.Position
@@ -243,9 +328,21 @@ func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (Tas
 			if err != nil {
 				return TaskDTO{}, err
 			}
+
+			err = recordActivity(ctx, tx.Client(), id, nil, taskactivity.KindMoved, intStr(curPos), intStr(target))
+			if err != nil {
+				return TaskDTO{}, err
+			}
+			if err := recordMoveEvent(ctx, tx.Client(), id, curPos, target); err != nil {
+				return TaskDTO{}, err
+			}
 		}
 	}
 
+	if err := recordTaskEvent(ctx, tx.Client(), outbox.TypeTaskUpdated, id, nil); err != nil {
+		return TaskDTO{}, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return TaskDTO{}, err
 	}
@@ -256,6 +353,8 @@ func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (Tas
 		Description: t.Description,
 		Status:      string(t.Status),
 		CreatedAt:   t.CreatedAt,
+		Version:     t.Version,
+		DueDate:     dueDate(t),
 		Position:    pt.Position,
 	}, nil
 }
@@ -309,46 +408,268 @@ func (r *EntRepo) GetMemberRole(ctx context.Context, projectID, userID uuid.UUID
 	return string(m.Role), nil
 }
 
-func (r *EntRepo) GetAssignee(ctx context.Context, taskID uuid.UUID) (*TaskAssigneeDTO, error) {
-	t, err := r.client.Task.
+// ProjectMemberIDsByEmail возвращает участников проекта с указанными адресами
+// (без учёта регистра).
+func (r *EntRepo) ProjectMemberIDsByEmail(ctx context.Context, projectID uuid.UUID, emails []string) ([]uuid.UUID, error) {
+	preds := make([]predicate.User, 0, len(emails))
+	for _, e := range emails {
+		preds = append(preds, user.EmailEqualFold(e))
+	}
+
+	return r.client.User.
 		Query().
-		Where(task.IDEQ(taskID)).
-		WithAssignee().
-		Only(ctx)
+		Where(
+			user.Or(preds...),
+			user.HasMembershipsWith(projectuser.HasProjectWith(project.IDEQ(projectID))),
+		).
+		IDs(ctx)
+}
+
+func (r *EntRepo) IsProjectArchived(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return r.client.Project.
+		Query().
+		Where(project.IDEQ(projectID), project.ArchivedAtNotNil()).
+		Exist(ctx)
+}
+
+func (r *EntRepo) IsAssigned(ctx context.Context, taskID, userID uuid.UUID) (bool, error) {
+	return r.client.TaskAssignee.
+		Query().
+		Where(
+			taskassignee.HasTaskWith(task.IDEQ(taskID)),
+			taskassignee.HasUserWith(user.IDEQ(userID)),
+		).
+		Exist(ctx)
+}
+
+func (r *EntRepo) ListAssignees(ctx context.Context, taskID uuid.UUID) ([]TaskAssigneeDTO, error) {
+	rows, err := r.client.TaskAssignee.
+		Query().
+		Where(taskassignee.HasTaskWith(task.IDEQ(taskID))).
+		WithUser().
+		Order(ent.Asc(taskassignee.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, nil
-		}
 		return nil, err
 	}
+	return toAssigneeDTOs(rows), nil
+}
+
+func (r *EntRepo) AddAssignee(ctx context.Context, taskID, actorID, userID uuid.UUID, role *string) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
 
-	u := t.Edges.Assignee
-	if u == nil {
-		return nil, nil
+	c := tx.TaskAssignee.
+		Create().
+		SetTaskID(taskID).
+		SetUserID(userID)
+	if role != nil {
+		c.SetRole(taskassignee.Role(*role))
 	}
 
-	return &TaskAssigneeDTO{
-		UserID: u.ID,
-		Name:   u.Name,
-		Email:  u.Email,
-	}, nil
+	if _, err = c.Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			err = ErrAlreadyAssigned
+		}
+		return err
+	}
+
+	if err = ensureWatcher(ctx, tx, taskID, userID); err != nil {
+		return err
+	}
+
+	_, err = tx.TaskAssignmentEvent.
+		Create().
+		SetTaskID(taskID).
+		SetAction(taskassignmentevent.ActionAssigned).
+		SetActorID(actorID).
+		SetToUserID(userID).
+		SetNillableRole(role).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = recordActivity(ctx, tx.Client(), taskID, &actorID, taskactivity.KindAssigned, nil, strPtr(userID.String()))
+	if err != nil {
+		return err
+	}
+
+	err = recordAssignmentEvent(ctx, tx.Client(), outbox.TypeTaskAssigned, taskID, actorID, userID, role)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (r *EntRepo) SetAssignee(ctx context.Context, taskID, userID uuid.UUID) error {
-	return r.client.Task.
-		UpdateOneID(taskID).
-		SetAssigneeID(userID).
+func (r *EntRepo) RemoveAssignee(ctx context.Context, taskID, actorID, userID uuid.UUID) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	n, err := tx.TaskAssignee.
+		Delete().
+		Where(
+			taskassignee.HasTaskWith(task.IDEQ(taskID)),
+			taskassignee.HasUserWith(user.IDEQ(userID)),
+		).
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		err = ErrNotAssigned
+		return err
+	}
+
+	_, err = tx.TaskAssignmentEvent.
+		Create().
+		SetTaskID(taskID).
+		SetAction(taskassignmentevent.ActionUnassigned).
+		SetActorID(actorID).
+		SetFromUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = recordActivity(ctx, tx.Client(), taskID, &actorID, taskactivity.KindUnassigned, strPtr(userID.String()), nil)
+	if err != nil {
+		return err
+	}
+
+	err = recordAssignmentEvent(ctx, tx.Client(), outbox.TypeTaskUnassigned, taskID, actorID, userID, nil)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (r *EntRepo) ClearAssignee(ctx context.Context, taskID uuid.UUID) error {
-	return r.client.Task.
-		UpdateOneID(taskID).
-		ClearAssigneeID().
+func (r *EntRepo) ClearAssignees(ctx context.Context, taskID, actorID uuid.UUID) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	userIDs, err := tx.TaskAssignee.
+		Query().
+		Where(taskassignee.HasTaskWith(task.IDEQ(taskID))).
+		QueryUser().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(userIDs) == 0 {
+		return tx.Commit()
+	}
+
+	_, err = tx.TaskAssignee.
+		Delete().
+		Where(taskassignee.HasTaskWith(task.IDEQ(taskID))).
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	events := make([]*ent.TaskAssignmentEventCreate, 0, len(userIDs))
+	for _, id := range userIDs {
+		events = append(events, tx.TaskAssignmentEvent.
+			Create().
+			SetTaskID(taskID).
+			SetAction(taskassignmentevent.ActionUnassigned).
+			SetActorID(actorID).
+			SetFromUserID(id))
+	}
+	if _, err = tx.TaskAssignmentEvent.CreateBulk(events...).Save(ctx); err != nil {
+		return err
+	}
+
+	for _, id := range userIDs {
+		err = recordActivity(ctx, tx.Client(), taskID, &actorID, taskactivity.KindUnassigned, strPtr(id.String()), nil)
+		if err != nil {
+			return err
+		}
+		err = recordAssignmentEvent(ctx, tx.Client(), outbox.TypeTaskUnassigned, taskID, actorID, id, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// assignmentEventKeys — история назначений, новые первыми.
+var assignmentEventKeys = page.Keyset{
+	{Field: taskassignmentevent.FieldCreatedAt, Desc: true},
+	{Field: taskassignmentevent.FieldID, Desc: true},
 }
 
-func (r *EntRepo) DeleteTask(ctx context.Context, taskID uuid.UUID) (err error) {
+func (r *EntRepo) ListAssignmentHistory(ctx context.Context, taskID uuid.UUID, p page.Params) (page.Page[AssignmentEventDTO], error) {
+	c, err := assignmentEventKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[AssignmentEventDTO]{}, err
+	}
+
+	q := r.client.TaskAssignmentEvent.
+		Query().
+		Where(taskassignmentevent.HasTaskWith(task.IDEQ(taskID)))
+	var res page.Page[AssignmentEventDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[AssignmentEventDTO]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.TaskAssignmentEvent](assignmentEventKeys, c)).
+		Order(page.Order[taskassignmentevent.OrderOption](assignmentEventKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[AssignmentEventDTO]{}, err
+	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(e *ent.TaskAssignmentEvent) []any {
+		return []any{e.CreatedAt, e.ID}
+	})
+
+	out := make([]AssignmentEventDTO, 0, len(rows))
+	for _, e := range rows {
+		out = append(out, AssignmentEventDTO{
+			ID:         e.ID,
+			Action:     string(e.Action),
+			ActorID:    e.ActorID,
+			FromUserID: e.FromUserID,
+			ToUserID:   e.ToUserID,
+			Role:       e.Role,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+	res.Items = out
+	return res, nil
+}
+
+// DeleteTask перемещает задачу в корзину: убирает её с доски проекта,
+// сдвигая позиции следующих задач, и запоминает проект и позицию для восстановления.
+func (r *EntRepo) DeleteTask(ctx context.Context, taskID uuid.UUID, expectedVersion *int) (err error) {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return err
@@ -359,19 +680,90 @@ func (r *EntRepo) DeleteTask(ctx context.Context, taskID uuid.UUID) (err error)
 		}
 	}()
 
-	_, err = tx.ProjectTask.
-		Delete().
+	pt, err := tx.ProjectTask.
+		Query().
 		Where(projecttask.HasTaskWith(enttask.IDEQ(taskID))).
-		Exec(ctx)
+		WithProject().
+		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			err = ErrNotFound
+		}
 		return err
 	}
+	if pt.Edges.Project == nil {
+		err = ErrNotFound
+		return err
+	}
+	projectID := pt.Edges.Project.ID
 
-	err = tx.Task.DeleteOneID(taskID).Exec(ctx)
+	// Версию сверяем до записи в outbox: откат после вставки события
+	// оставил бы дыру в seq, и издатель ждал бы её до таймаута.
+	tu := tx.Task.
+		UpdateOneID(taskID).
+		SetDeletedAt(time.Now()).
+		SetTrashedProjectID(projectID).
+		SetTrashedPosition(pt.Position)
+	if expectedVersion != nil {
+		tu.Where(enttask.VersionEQ(*expectedVersion))
+	}
+	if err = tu.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) && expectedVersion != nil {
+			err = ErrVersionConflict
+		}
+		return err
+	}
+
+	// Событие пишем, пока задача ещё на доске; она уже в корзине,
+	// поэтому читаем её в обход мягкого удаления.
+	if err = recordTaskEvent(schema.SkipSoftDelete(ctx), tx.Client(), outbox.TypeTaskDeleted, taskID, nil); err != nil {
+		return err
+	}
+
+	err = tx.ProjectTask.DeleteOneID(pt.ID).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ProjectTask.
+		Update().
+		Where(
+			projecttask.HasProjectWith(project.IDEQ(projectID)),
+			projecttask.PositionGT(pt.Position),
+		).
+		AddPosition(-1).
+		Save(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = tx.Commit()
-	return err
+	if err = recordActivity(ctx, tx.Client(), taskID, nil, taskactivity.KindDeleted, nil, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func toAssigneeDTOs(rows []*ent.TaskAssignee) []TaskAssigneeDTO {
+	out := make([]TaskAssigneeDTO, 0, len(rows))
+	for _, a := range rows {
+		u := a.Edges.User
+		if u == nil {
+			continue
+		}
+
+		var role *string
+		if a.Role != nil {
+			r := string(*a.Role)
+			role = &r
+		}
+
+		out = append(out, TaskAssigneeDTO{
+			UserID: u.ID,
+			Name:   u.Name,
+			Email:  u.Email,
+			Role:   role,
+		})
+	}
+	return out
 }
@@ -4,12 +4,30 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 type TaskService interface {
-	ListByProject(ctx context.Context, projectID uuid.UUID, limit, offset int) ([]TaskDTO, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID, f ListFilter, inc Include, p page.Params) (page.Page[TaskDTO], error)
 	Update(ctx context.Context, id uuid.UUID, in UpdateInput) (TaskDTO, error)
-	Assign(ctx context.Context, taskID, actorID, userID uuid.UUID) error
-	Delete(ctx context.Context, taskID, actorID uuid.UUID) error
+	Assign(ctx context.Context, taskID, actorID, userID uuid.UUID, role *string) error
+	Unassign(ctx context.Context, taskID, actorID, userID uuid.UUID) error
+	UnassignAll(ctx context.Context, taskID, actorID uuid.UUID) error
+	AssignmentHistory(ctx context.Context, taskID uuid.UUID, p page.Params) (page.Page[AssignmentEventDTO], error)
+	Delete(ctx context.Context, taskID, actorID uuid.UUID, expectedVersion *int) error
 	CreateInProject(ctx context.Context, projectID uuid.UUID, in CreateInput) (TaskDTO, error)
+
+	GetByID(ctx context.Context, taskID uuid.UUID, inc Include) (TaskDTO, error)
+	Watch(ctx context.Context, taskID, userID uuid.UUID) error
+	Unwatch(ctx context.Context, taskID, userID uuid.UUID) error
+	ListWatched(ctx context.Context, userID uuid.UUID, p page.Params) (page.Page[TaskDTO], error)
+
+	ListTrash(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[TaskDTO], error)
+	Restore(ctx context.Context, taskID, actorID uuid.UUID) (TaskDTO, error)
+
+	Activity(ctx context.Context, taskID uuid.UUID, p page.Params) (page.Page[ActivityDTO], error)
+
+	ListOverdueByProject(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[TaskDTO], error)
+	ListOverdueByUser(ctx context.Context, userID uuid.UUID, p page.Params) (page.Page[TaskDTO], error)
 }
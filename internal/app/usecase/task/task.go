@@ -2,66 +2,158 @@ package task
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
-type UseCase struct{ repo TasksRepository }
+type UseCase struct {
+	repo     TasksRepository
+	notifier Notifier
+}
 
-func NewTasksUseCase(repo TasksRepository) *UseCase { return &UseCase{repo: repo} }
+func NewTasksUseCase(repo TasksRepository, notifier Notifier) *UseCase {
+	return &UseCase{repo: repo, notifier: notifier}
+}
 
-func (uc *UseCase) ListByProject(ctx context.Context, projectID uuid.UUID, limit, offset int) ([]TaskDTO, error) {
-	if limit <= 0 || limit > 100 {
-		limit = 50
-	}
-	if offset < 0 {
-		offset = 0
-	}
-	return uc.repo.ListByProject(ctx, projectID, limit, offset)
+func (uc *UseCase) ListByProject(ctx context.Context, projectID uuid.UUID, f ListFilter, inc Include, p page.Params) (page.Page[TaskDTO], error) {
+	p = p.Normalize()
+	return uc.repo.ListByProject(ctx, projectID, f, inc, p)
 }
 
 func (uc *UseCase) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (TaskDTO, error) {
 	if in.Title != nil && strings.TrimSpace(*in.Title) == "" {
-		return TaskDTO{}, errors.New("title cannot be empty")
+		return TaskDTO{}, ErrTitleRequired
 	}
-	if in.Status != nil {
-		switch *in.Status {
-		case "todo", "in_progress", "done":
-		default:
-			return TaskDTO{}, errors.New("invalid status")
-		}
+	if in.Status != nil && !validStatus(*in.Status) {
+		return TaskDTO{}, ErrInvalidStatus
 	}
 	if in.Position != nil && *in.Position < 0 {
-		return TaskDTO{}, errors.New("position must be >= 0")
+		return TaskDTO{}, ErrInvalidPosition
+	}
+
+	projectID, err := uc.repo.GetProjectIDByTask(ctx, id)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return TaskDTO{}, err
+	}
+
+	var previousDescription string
+	if in.Description != nil {
+		old, err := uc.repo.GetByID(ctx, id, withEdges)
+		if err != nil {
+			return TaskDTO{}, err
+		}
+		previousDescription = old.Description
 	}
 
-	return uc.repo.Update(ctx, id, in)
+	updated, err := uc.repo.Update(ctx, id, in)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+
+	actorID := actorFromContext(ctx)
+	uc.notifyTaskChanged(ctx, id, actorID, "%s updated %q")
+	if in.Description != nil {
+		uc.notifyMentions(ctx, projectID, updated, previousDescription, actorID)
+	}
+	return updated, nil
 }
 
 func (uc *UseCase) CreateInProject(ctx context.Context, projectID uuid.UUID, in CreateInput) (TaskDTO, error) {
 	if strings.TrimSpace(in.Title) == "" {
-		return TaskDTO{}, errors.New("title is required")
+		return TaskDTO{}, ErrTitleRequired
+	}
+	if in.Status != "" && !validStatus(in.Status) {
+		return TaskDTO{}, ErrInvalidStatus
 	}
-	return uc.repo.CreateInProject(ctx, projectID, in)
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return TaskDTO{}, err
+	}
+	if in.CreatorID != nil {
+		isMember, err := uc.repo.IsProjectMember(ctx, projectID, *in.CreatorID)
+		if err != nil {
+			return TaskDTO{}, err
+		}
+		if !isMember {
+			return TaskDTO{}, ErrForbidden
+		}
+	}
+
+	created, err := uc.repo.CreateInProject(ctx, projectID, in)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+
+	actorID := in.CreatorID
+	if actorID == nil {
+		actorID = actorFromContext(ctx)
+	}
+	uc.notifyMentions(ctx, projectID, created, "", actorID)
+	return created, nil
 }
 
-func (uc *UseCase) Assign(ctx context.Context, taskID, actorID, userID uuid.UUID) error {
-	projectID, err := uc.repo.GetProjectIDByTask(ctx, taskID)
+func (uc *UseCase) Assign(ctx context.Context, taskID, actorID, userID uuid.UUID, role *string) error {
+	if role != nil {
+		switch *role {
+		case "owner", "reviewer", "contributor":
+		default:
+			return ErrInvalidRole
+		}
+	}
+
+	if _, err := uc.checkAssignAccess(ctx, taskID, actorID, userID); err != nil {
+		return err
+	}
+
+	assigned, err := uc.repo.IsAssigned(ctx, taskID, userID)
 	if err != nil {
 		return err
 	}
-	if projectID == uuid.Nil {
-		return errors.New("task not found")
+	if assigned {
+		return ErrAlreadyAssigned
 	}
 
-	ok, err := uc.repo.UserExists(ctx, userID)
+	if err := uc.repo.AddAssignee(ctx, taskID, actorID, userID, role); err != nil {
+		return err
+	}
+
+	uc.notifyAssignment(ctx, taskID, actorID, userID, true)
+	return nil
+}
+
+func (uc *UseCase) Unassign(ctx context.Context, taskID, actorID, userID uuid.UUID) error {
+	if _, err := uc.checkAssignAccess(ctx, taskID, actorID, userID); err != nil {
+		return err
+	}
+
+	assigned, err := uc.repo.IsAssigned(ctx, taskID, userID)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		return errors.New("user not found")
+	if !assigned {
+		return ErrNotAssigned
+	}
+
+	if err := uc.repo.RemoveAssignee(ctx, taskID, actorID, userID); err != nil {
+		return err
+	}
+
+	uc.notifyAssignment(ctx, taskID, actorID, userID, false)
+	return nil
+}
+
+// UnassignAll снимает всех исполнителей задачи. Права те же, что у Assign:
+// без роли owner можно снять только самого себя.
+func (uc *UseCase) UnassignAll(ctx context.Context, taskID, actorID uuid.UUID) error {
+	projectID, err := uc.repo.GetProjectIDByTask(ctx, taskID)
+	if err != nil {
+		return err
 	}
 
 	isActorMember, err := uc.repo.IsProjectMember(ctx, projectID, actorID)
@@ -69,39 +161,106 @@ func (uc *UseCase) Assign(ctx context.Context, taskID, actorID, userID uuid.UUID
 		return err
 	}
 	if !isActorMember {
-		return errors.New("forbidden")
+		return ErrForbidden
+	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return err
 	}
 
-	isTargetMember, err := uc.repo.IsProjectMember(ctx, projectID, userID)
+	assignees, err := uc.repo.ListAssignees(ctx, taskID)
 	if err != nil {
 		return err
 	}
-	if !isTargetMember {
-		return errors.New("user not in project")
+	if len(assignees) == 0 {
+		return ErrNotAssigned
 	}
 
-	if actorID != userID {
+	othersAssigned := false
+	for _, a := range assignees {
+		if a.UserID != actorID {
+			othersAssigned = true
+			break
+		}
+	}
+	if othersAssigned {
 		role, err := uc.repo.GetMemberRole(ctx, projectID, actorID)
 		if err != nil {
 			return err
 		}
 		if role != "owner" {
-			return errors.New("forbidden")
+			return ErrForbidden
 		}
 	}
 
-	cur, err := uc.repo.GetAssignee(ctx, taskID)
-	if err != nil {
+	if err := uc.repo.ClearAssignees(ctx, taskID, actorID); err != nil {
 		return err
 	}
-	if cur != nil && cur.UserID == userID {
-		return errors.New("already assigned")
+
+	uc.notifyTaskChanged(ctx, taskID, &actorID, "%s removed all assignees from %q")
+	return nil
+}
+
+func (uc *UseCase) AssignmentHistory(ctx context.Context, taskID uuid.UUID, p page.Params) (page.Page[AssignmentEventDTO], error) {
+	p = p.Normalize()
+
+	if _, err := uc.repo.GetProjectIDByTask(ctx, taskID); err != nil {
+		return page.Page[AssignmentEventDTO]{}, err
+	}
+	return uc.repo.ListAssignmentHistory(ctx, taskID, p)
+}
+
+// checkAssignAccess проверяет права на изменение исполнителей задачи:
+// участник проекта может назначить (снять) только себя, owner — любого участника.
+func (uc *UseCase) checkAssignAccess(ctx context.Context, taskID, actorID, userID uuid.UUID) (uuid.UUID, error) {
+	projectID, err := uc.repo.GetProjectIDByTask(ctx, taskID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if projectID == uuid.Nil {
+		return uuid.Nil, ErrNotFound
+	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return uuid.Nil, err
+	}
+
+	ok, err := uc.repo.UserExists(ctx, userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !ok {
+		return uuid.Nil, ErrUserNotFound
+	}
+
+	isActorMember, err := uc.repo.IsProjectMember(ctx, projectID, actorID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !isActorMember {
+		return uuid.Nil, ErrForbidden
+	}
+
+	isTargetMember, err := uc.repo.IsProjectMember(ctx, projectID, userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if !isTargetMember {
+		return uuid.Nil, ErrNotProjectMember
+	}
+
+	if actorID != userID {
+		role, err := uc.repo.GetMemberRole(ctx, projectID, actorID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if role != "owner" {
+			return uuid.Nil, ErrForbidden
+		}
 	}
 
-	return uc.repo.SetAssignee(ctx, taskID, userID)
+	return projectID, nil
 }
 
-func (uc *UseCase) Delete(ctx context.Context, taskID, actorID uuid.UUID) error {
+func (uc *UseCase) Delete(ctx context.Context, taskID, actorID uuid.UUID, expectedVersion *int) error {
 	projectID, err := uc.repo.GetProjectIDByTask(ctx, taskID)
 	if err != nil {
 		return err
@@ -114,6 +273,40 @@ func (uc *UseCase) Delete(ctx context.Context, taskID, actorID uuid.UUID) error
 	if role != "owner" {
 		return ErrForbidden
 	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return err
+	}
 
-	return uc.repo.DeleteTask(ctx, taskID)
+	// После удаления задача уже не читается, поэтому берём её заранее.
+	t, err := uc.repo.GetByID(ctx, taskID, withEdges)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.repo.DeleteTask(ctx, taskID, expectedVersion); err != nil {
+		return err
+	}
+
+	uc.notifyWatchers(ctx, t, &actorID, fmt.Sprintf("%s deleted %q", uc.userName(ctx, &actorID), t.Title))
+	return nil
+}
+
+// ensureWritable отклоняет изменения задач в архивном проекте.
+func (uc *UseCase) ensureWritable(ctx context.Context, projectID uuid.UUID) error {
+	archived, err := uc.repo.IsProjectArchived(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if archived {
+		return ErrProjectArchived
+	}
+	return nil
+}
+
+func validStatus(s string) bool {
+	switch s {
+	case "todo", "in_progress", "done":
+		return true
+	}
+	return false
 }
@@ -0,0 +1,41 @@
+package task
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+func (uc *UseCase) ListTrash(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[TaskDTO], error) {
+	p = p.Normalize()
+	return uc.repo.ListTrash(ctx, projectID, p)
+}
+
+// Restore возвращает задачу из корзины. Права те же, что у Delete: только owner проекта.
+func (uc *UseCase) Restore(ctx context.Context, taskID, actorID uuid.UUID) (TaskDTO, error) {
+	projectID, err := uc.repo.GetTrashedProjectID(ctx, taskID)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+
+	role, err := uc.repo.GetMemberRole(ctx, projectID, actorID)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+	if role != "owner" {
+		return TaskDTO{}, ErrForbidden
+	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return TaskDTO{}, err
+	}
+
+	restored, err := uc.repo.RestoreTask(ctx, taskID)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+
+	uc.notifyTaskChanged(ctx, taskID, &actorID, "%s restored %q")
+	return restored, nil
+}
@@ -0,0 +1,285 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/schema"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskactivity"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskassignmentevent"
+	"project-manager-dashboard-go/ent/taskreminder"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+// trashKeys — корзина проекта, недавно удалённые первыми.
+var trashKeys = page.Keyset{
+	{Field: task.FieldDeletedAt, Desc: true},
+	{Field: task.FieldID, Desc: true},
+}
+
+func (r *EntRepo) ListTrash(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[TaskDTO], error) {
+	c, err := trashKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+
+	ctx = schema.SkipSoftDelete(ctx)
+	q := r.client.Task.
+		Query().
+		Where(
+			task.TrashedProjectIDEQ(projectID),
+			task.DeletedAtNotNil(),
+		)
+	var res page.Page[TaskDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+
+	items, err := q.
+		Where(page.Where[predicate.Task](trashKeys, c)).
+		Order(page.Order[task.OrderOption](trashKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+	items, res.Next, res.Prev = page.Cut(p, c, items, func(t *ent.Task) []any {
+		return []any{t.DeletedAt, t.ID}
+	})
+
+	out := make([]TaskDTO, 0, len(items))
+	for _, t := range items {
+		item := TaskDTO{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      string(t.Status),
+			CreatedAt:   t.CreatedAt,
+			Version:     t.Version,
+			DueDate:     dueDate(t),
+			MilestoneID: t.MilestoneID,
+			ProjectID:   projectID,
+			DeletedAt:   t.DeletedAt,
+		}
+		if t.TrashedPosition != nil {
+			item.Position = *t.TrashedPosition
+		}
+		out = append(out, item)
+	}
+	res.Items = out
+	return res, nil
+}
+
+func (r *EntRepo) GetTrashedProjectID(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error) {
+	t, err := r.client.Task.
+		Query().
+		Where(task.IDEQ(taskID), task.DeletedAtNotNil()).
+		Only(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, ErrNotFound
+		}
+		return uuid.Nil, err
+	}
+	if t.TrashedProjectID == nil {
+		return uuid.Nil, ErrNotFound
+	}
+	return *t.TrashedProjectID, nil
+}
+
+// RestoreTask возвращает задачу из корзины на доску проекта. Задача встаёт
+// на прежнюю позицию, если она ещё существует, иначе — в конец списка.
+func (r *EntRepo) RestoreTask(ctx context.Context, taskID uuid.UUID) (_ TaskDTO, err error) {
+	ctx = schema.SkipSoftDelete(ctx)
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	t, err := tx.Task.
+		Query().
+		Where(task.IDEQ(taskID), task.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			err = ErrNotFound
+		}
+		return TaskDTO{}, err
+	}
+	if t.TrashedProjectID == nil {
+		err = ErrNotFound
+		return TaskDTO{}, err
+	}
+	projectID := *t.TrashedProjectID
+
+	alive, err := tx.Project.
+		Query().
+		Where(project.IDEQ(projectID), project.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+	if !alive {
+		err = ErrProjectDeleted
+		return TaskDTO{}, err
+	}
+
+	count, err := tx.ProjectTask.
+		Query().
+		Where(projecttask.HasProjectWith(project.IDEQ(projectID))).
+		Count(ctx)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+
+	target := count
+	if t.TrashedPosition != nil && *t.TrashedPosition >= 0 && *t.TrashedPosition < count {
+		target = *t.TrashedPosition
+	}
+
+	_, err = tx.ProjectTask.
+		Update().
+		Where(
+			projecttask.HasProjectWith(project.IDEQ(projectID)),
+			projecttask.PositionGTE(target),
+		).
+		AddPosition(1).
+		Save(ctx)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+
+	pt, err := tx.ProjectTask.
+		Create().
+		SetProjectID(projectID).
+		SetTaskID(taskID).
+		SetPosition(target).
+		Save(ctx)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+
+	t, err = tx.Task.
+		UpdateOneID(taskID).
+		ClearDeletedAt().
+		ClearTrashedProjectID().
+		ClearTrashedPosition().
+		Save(ctx)
+	if err != nil {
+		return TaskDTO{}, err
+	}
+
+	if err = recordActivity(ctx, tx.Client(), taskID, nil, taskactivity.KindRestored, nil, nil); err != nil {
+		return TaskDTO{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return TaskDTO{}, err
+	}
+
+	return TaskDTO{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      string(t.Status),
+		CreatedAt:   t.CreatedAt,
+		Version:     t.Version,
+		DueDate:     dueDate(t),
+		Position:    pt.Position,
+		MilestoneID: t.MilestoneID,
+		ProjectID:   projectID,
+	}, nil
+}
+
+// PurgeDeleted окончательно удаляет задачи, которые лежат в корзине дольше,
+// чем до момента before.
+func (r *EntRepo) PurgeDeleted(ctx context.Context, before time.Time) (n int, err error) {
+	ctx = schema.SkipSoftDelete(ctx)
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	ids, err := tx.Task.
+		Query().
+		Where(task.DeletedAtLT(before)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, tx.Commit()
+	}
+
+	_, err = tx.TaskAssignee.
+		Delete().
+		Where(taskassignee.HasTaskWith(task.IDIn(ids...))).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.TaskAssignmentEvent.
+		Delete().
+		Where(taskassignmentevent.HasTaskWith(task.IDIn(ids...))).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.TaskWatcher.
+		Delete().
+		Where(taskwatcher.HasTaskWith(task.IDIn(ids...))).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.TaskActivity.
+		Delete().
+		Where(taskactivity.HasTaskWith(task.IDIn(ids...))).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.TaskReminder.
+		Delete().
+		Where(taskreminder.HasTaskWith(task.IDIn(ids...))).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err = tx.Task.
+		Delete().
+		Where(task.IDIn(ids...)).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return n, tx.Commit()
+}
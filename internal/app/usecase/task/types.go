@@ -5,12 +5,15 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 type TaskAssigneeDTO struct {
 	UserID uuid.UUID
 	Name   string
 	Email  string
+	Role   *string
 }
 
 type TaskDTO struct {
@@ -20,8 +23,90 @@ type TaskDTO struct {
 	Status      string
 	CreatedAt   time.Time
 	Position    int
+	MilestoneID *uuid.UUID
+	ProjectID   uuid.UUID
+	DeletedAt   *time.Time
+	DueDate     *time.Time
+	Version     int
+
+	Assignees []TaskAssigneeDTO
+	Watchers  []TaskWatcherDTO
+}
+
+type TaskWatcherDTO struct {
+	UserID    uuid.UUID
+	Name      string
+	Email     string
+	WatchedAt time.Time
+}
+
+type AssignmentEventDTO struct {
+	ID         uuid.UUID
+	Action     string // "assigned" | "unassigned"
+	ActorID    uuid.UUID
+	FromUserID *uuid.UUID
+	ToUserID   *uuid.UUID
+	Role       *string
+	CreatedAt  time.Time
+}
+
+// Операции строки diff описания.
+const (
+	DiffEqual   = "="
+	DiffAdded   = "+"
+	DiffRemoved = "-"
+)
+
+type DiffLine struct {
+	Op   string
+	Text string
+}
+
+type ActivityDTO struct {
+	ID        uuid.UUID
+	Kind      string
+	ActorID   *uuid.UUID
+	ActorName *string
+	From      *string
+	To        *string
+	Message   string
+	Diff      []DiffLine
+	CreatedAt time.Time
+}
+
+type ListFilter struct {
+	// AssigneeID оставляет только задачи, среди исполнителей которых есть этот пользователь.
+	AssigneeID *uuid.UUID
+}
+
+// Include — связи задачи, которые загружаются вместе с ней.
+type Include struct {
+	Assignees bool
+	Watchers  bool
+}
+
+// withEdges — задача целиком; так её читают уведомления и outbox.
+var withEdges = Include{Assignees: true, Watchers: true}
+
+// OverdueFilter — выборка просроченных задач: по проекту или по исполнителю.
+type OverdueFilter struct {
+	ProjectID  *uuid.UUID
+	AssigneeID *uuid.UUID
+}
+
+// Пороги напоминаний о сроке.
+const (
+	ReminderDueSoon = "due_soon"
+	ReminderOverdue = "overdue"
+)
 
-	Assignee *TaskAssigneeDTO
+// ReminderDTO — напоминание, которое нужно отправить по задаче.
+type ReminderDTO struct {
+	TaskID    uuid.UUID
+	ProjectID uuid.UUID
+	Title     string
+	Kind      string
+	DueDate   time.Time
 }
 
 type UpdateInput struct {
@@ -29,23 +114,52 @@ type UpdateInput struct {
 	Description *string
 	Status      *string
 	Position    *int
+	DueDate     *time.Time
+	// ClearDueDate снимает срок; DueDate при этом игнорируется.
+	ClearDueDate bool
+	// ExpectedVersion — версия из If-Match; если задана и не совпадает
+	// с текущей, обновление отклоняется с ErrVersionConflict.
+	ExpectedVersion *int
 }
 
 type CreateInput struct {
 	Title       string
 	Description *string
 	Status      string
+	DueDate     *time.Time
+	// CreatorID — автор задачи; если задан, он автоматически становится наблюдателем.
+	CreatorID *uuid.UUID
 }
 
 type TasksRepository interface {
-	ListByProject(ctx context.Context, projectID uuid.UUID, limit, offset int) ([]TaskDTO, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID, f ListFilter, inc Include, p page.Params) (page.Page[TaskDTO], error)
 	Update(ctx context.Context, id uuid.UUID, in UpdateInput) (TaskDTO, error)
 	CreateInProject(ctx context.Context, projectID uuid.UUID, in CreateInput) (TaskDTO, error)
 	GetProjectIDByTask(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error)
 	UserExists(ctx context.Context, userID uuid.UUID) (bool, error)
 	IsProjectMember(ctx context.Context, projectID, userID uuid.UUID) (bool, error)
 	GetMemberRole(ctx context.Context, projectID, userID uuid.UUID) (string, error)
-	GetAssignee(ctx context.Context, taskID uuid.UUID) (*TaskAssigneeDTO, error)
-	SetAssignee(ctx context.Context, taskID, userID uuid.UUID) error
-	DeleteTask(ctx context.Context, taskID uuid.UUID) error
+	IsProjectArchived(ctx context.Context, projectID uuid.UUID) (bool, error)
+	IsAssigned(ctx context.Context, taskID, userID uuid.UUID) (bool, error)
+	ListAssignees(ctx context.Context, taskID uuid.UUID) ([]TaskAssigneeDTO, error)
+	AddAssignee(ctx context.Context, taskID, actorID, userID uuid.UUID, role *string) error
+	RemoveAssignee(ctx context.Context, taskID, actorID, userID uuid.UUID) error
+	ClearAssignees(ctx context.Context, taskID, actorID uuid.UUID) error
+	ListAssignmentHistory(ctx context.Context, taskID uuid.UUID, p page.Params) (page.Page[AssignmentEventDTO], error)
+	ListActivity(ctx context.Context, taskID uuid.UUID, p page.Params) (page.Page[ActivityDTO], error)
+	UserNames(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error)
+	GetByID(ctx context.Context, taskID uuid.UUID, inc Include) (TaskDTO, error)
+	IsWatching(ctx context.Context, taskID, userID uuid.UUID) (bool, error)
+	AddWatcher(ctx context.Context, taskID, userID uuid.UUID) error
+	RemoveWatcher(ctx context.Context, taskID, userID uuid.UUID) error
+	ListWatchedByUser(ctx context.Context, userID uuid.UUID, p page.Params) (page.Page[TaskDTO], error)
+	DeleteTask(ctx context.Context, taskID uuid.UUID, expectedVersion *int) error
+	ListTrash(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[TaskDTO], error)
+	GetTrashedProjectID(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error)
+	RestoreTask(ctx context.Context, taskID uuid.UUID) (TaskDTO, error)
+	ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error)
+	ProjectMemberIDsByEmail(ctx context.Context, projectID uuid.UUID, emails []string) ([]uuid.UUID, error)
+	ListOverdue(ctx context.Context, f OverdueFilter, now time.Time, p page.Params) (page.Page[TaskDTO], error)
+	ListPendingReminders(ctx context.Context, kind string, from, to time.Time, limit int) ([]ReminderDTO, error)
+	CreateReminder(ctx context.Context, r ReminderDTO) (bool, error)
 }
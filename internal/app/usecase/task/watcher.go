@@ -0,0 +1,67 @@
+package task
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+func (uc *UseCase) GetByID(ctx context.Context, taskID uuid.UUID, inc Include) (TaskDTO, error) {
+	return uc.repo.GetByID(ctx, taskID, inc)
+}
+
+// Watch подписывает участника проекта на изменения задачи.
+func (uc *UseCase) Watch(ctx context.Context, taskID, userID uuid.UUID) error {
+	projectID, err := uc.repo.GetProjectIDByTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return err
+	}
+
+	isMember, err := uc.repo.IsProjectMember(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrForbidden
+	}
+
+	watching, err := uc.repo.IsWatching(ctx, taskID, userID)
+	if err != nil {
+		return err
+	}
+	if watching {
+		return ErrAlreadyWatching
+	}
+
+	return uc.repo.AddWatcher(ctx, taskID, userID)
+}
+
+func (uc *UseCase) Unwatch(ctx context.Context, taskID, userID uuid.UUID) error {
+	projectID, err := uc.repo.GetProjectIDByTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if err := uc.ensureWritable(ctx, projectID); err != nil {
+		return err
+	}
+	return uc.repo.RemoveWatcher(ctx, taskID, userID)
+}
+
+func (uc *UseCase) ListWatched(ctx context.Context, userID uuid.UUID, p page.Params) (page.Page[TaskDTO], error) {
+	p = p.Normalize()
+
+	ok, err := uc.repo.UserExists(ctx, userID)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+	if !ok {
+		return page.Page[TaskDTO]{}, ErrUserNotFound
+	}
+
+	return uc.repo.ListWatchedByUser(ctx, userID, p)
+}
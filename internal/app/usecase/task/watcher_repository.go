@@ -0,0 +1,227 @@
+package task
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/projecttask"
+	"project-manager-dashboard-go/ent/task"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/ent/taskwatcher"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+func (r *EntRepo) GetByID(ctx context.Context, taskID uuid.UUID, inc Include) (TaskDTO, error) {
+	return getTask(ctx, r.client, taskID, inc)
+}
+
+// getTask читает задачу через c — клиент репозитория или транзакции.
+func getTask(ctx context.Context, c *ent.Client, taskID uuid.UUID, inc Include) (TaskDTO, error) {
+	pt, err := c.ProjectTask.
+		Query().
+		Where(projecttask.HasTaskWith(task.IDEQ(taskID))).
+		WithProject().
+		WithTask(func(tq *ent.TaskQuery) {
+			withIncludes(tq, inc)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return TaskDTO{}, ErrNotFound
+		}
+		return TaskDTO{}, err
+	}
+
+	t := pt.Edges.Task
+	if t == nil || pt.Edges.Project == nil {
+		return TaskDTO{}, ErrNotFound
+	}
+
+	out := TaskDTO{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      string(t.Status),
+		CreatedAt:   t.CreatedAt,
+		Version:     t.Version,
+		DueDate:     dueDate(t),
+		Position:    pt.Position,
+		MilestoneID: t.MilestoneID,
+		ProjectID:   pt.Edges.Project.ID,
+
+		Assignees: toAssigneeDTOs(t.Edges.Assignees),
+	}
+	if inc.Watchers {
+		out.Watchers = toWatcherDTOs(t.Edges.Watchers)
+	}
+	return out, nil
+}
+
+func toWatcherDTOs(rows []*ent.TaskWatcher) []TaskWatcherDTO {
+	out := make([]TaskWatcherDTO, 0, len(rows))
+	for _, w := range rows {
+		u := w.Edges.User
+		if u == nil {
+			continue
+		}
+		out = append(out, TaskWatcherDTO{
+			UserID:    u.ID,
+			Name:      u.Name,
+			Email:     u.Email,
+			WatchedAt: w.CreatedAt,
+		})
+	}
+	return out
+}
+
+// withIncludes подгружает к задачам связи из inc тем же запросом.
+func withIncludes(tq *ent.TaskQuery, inc Include) {
+	if inc.Assignees {
+		tq.WithAssignees(func(aq *ent.TaskAssigneeQuery) {
+			aq.WithUser().Order(ent.Asc(taskassignee.FieldCreatedAt))
+		})
+	}
+	if inc.Watchers {
+		tq.WithWatchers(func(wq *ent.TaskWatcherQuery) {
+			wq.WithUser().Order(ent.Asc(taskwatcher.FieldCreatedAt))
+		})
+	}
+}
+
+func (r *EntRepo) IsWatching(ctx context.Context, taskID, userID uuid.UUID) (bool, error) {
+	return r.client.TaskWatcher.
+		Query().
+		Where(
+			taskwatcher.HasTaskWith(task.IDEQ(taskID)),
+			taskwatcher.HasUserWith(user.IDEQ(userID)),
+		).
+		Exist(ctx)
+}
+
+func (r *EntRepo) AddWatcher(ctx context.Context, taskID, userID uuid.UUID) error {
+	_, err := r.client.TaskWatcher.
+		Create().
+		SetTaskID(taskID).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil && ent.IsConstraintError(err) {
+		return ErrAlreadyWatching
+	}
+	return err
+}
+
+func (r *EntRepo) RemoveWatcher(ctx context.Context, taskID, userID uuid.UUID) error {
+	n, err := r.client.TaskWatcher.
+		Delete().
+		Where(
+			taskwatcher.HasTaskWith(task.IDEQ(taskID)),
+			taskwatcher.HasUserWith(user.IDEQ(userID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotWatching
+	}
+	return nil
+}
+
+// watcherKeys — наблюдаемые задачи, последние подписки первыми.
+var watcherKeys = page.Keyset{
+	{Field: taskwatcher.FieldCreatedAt, Desc: true},
+	{Field: taskwatcher.FieldID, Desc: true},
+}
+
+func (r *EntRepo) ListWatchedByUser(ctx context.Context, userID uuid.UUID, p page.Params) (page.Page[TaskDTO], error) {
+	c, err := watcherKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+
+	q := r.client.TaskWatcher.
+		Query().
+		Where(
+			taskwatcher.HasUserWith(user.IDEQ(userID)),
+			// Интерцептор мягкого удаления не действует на подзапросы
+			// предикатов: задачи в корзине отсекаются явно.
+			taskwatcher.HasTaskWith(task.DeletedAtIsNil()),
+		)
+	var res page.Page[TaskDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.TaskWatcher](watcherKeys, c)).
+		WithTask(func(tq *ent.TaskQuery) {
+			tq.WithProjectTasks(func(pq *ent.ProjectTaskQuery) {
+				pq.WithProject()
+			})
+		}).
+		Order(page.Order[taskwatcher.OrderOption](watcherKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[TaskDTO]{}, err
+	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(w *ent.TaskWatcher) []any {
+		return []any{w.CreatedAt, w.ID}
+	})
+
+	out := make([]TaskDTO, 0, len(rows))
+	for _, w := range rows {
+		t := w.Edges.Task
+		if t == nil {
+			continue
+		}
+
+		item := TaskDTO{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      string(t.Status),
+			CreatedAt:   t.CreatedAt,
+			Version:     t.Version,
+			DueDate:     dueDate(t),
+			MilestoneID: t.MilestoneID,
+		}
+		if len(t.Edges.ProjectTasks) > 0 {
+			pt := t.Edges.ProjectTasks[0]
+			item.Position = pt.Position
+			if pt.Edges.Project != nil {
+				item.ProjectID = pt.Edges.Project.ID
+			}
+		}
+		out = append(out, item)
+	}
+	res.Items = out
+	return res, nil
+}
+
+// ensureWatcher подписывает пользователя на задачу внутри транзакции,
+// если он ещё не подписан.
+func ensureWatcher(ctx context.Context, tx *ent.Tx, taskID, userID uuid.UUID) error {
+	exists, err := tx.TaskWatcher.
+		Query().
+		Where(
+			taskwatcher.HasTaskWith(task.IDEQ(taskID)),
+			taskwatcher.HasUserWith(user.IDEQ(userID)),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	_, err = tx.TaskWatcher.
+		Create().
+		SetTaskID(taskID).
+		SetUserID(userID).
+		Save(ctx)
+	return err
+}
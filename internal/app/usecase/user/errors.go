@@ -0,0 +1,10 @@
+package user
+
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
+
+var (
+	ErrNotFound      = apperr.NotFound("user_not_found", "user not found")
+	ErrEmailTaken    = apperr.Conflict("email_taken", "email already registered")
+	ErrNameRequired  = apperr.Invalid("name_required", "name is required")
+	ErrEmailRequired = apperr.Invalid("email_required", "email is required")
+)
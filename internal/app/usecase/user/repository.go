@@ -5,9 +5,17 @@ import (
 
 	"github.com/google/uuid"
 	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/predicate"
 	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
+// userKeys — пользователи в порядке регистрации.
+var userKeys = page.Keyset{
+	{Field: user.FieldCreatedAt},
+	{Field: user.FieldID},
+}
+
 type UserRepo struct {
 	ent *ent.Client
 }
@@ -16,11 +24,30 @@ func NewUserRepo(entClient *ent.Client) *UserRepo {
 	return &UserRepo{ent: entClient}
 }
 
-func (r *UserRepo) List(ctx context.Context, limit int) ([]User, error) {
-	rows, err := r.ent.User.Query().Limit(limit).All(ctx)
+func (r *UserRepo) List(ctx context.Context, p page.Params) (page.Page[User], error) {
+	c, err := userKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[User]{}, err
+	}
+
+	q := r.ent.User.Query()
+	var res page.Page[User]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[User]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.User](userKeys, c)).
+		Order(page.Order[user.OrderOption](userKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
 	if err != nil {
-		return nil, err
+		return page.Page[User]{}, err
 	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(u *ent.User) []any {
+		return []any{u.CreatedAt, u.ID}
+	})
 
 	out := make([]User, 0, len(rows))
 	for _, u := range rows {
@@ -31,7 +58,8 @@ func (r *UserRepo) List(ctx context.Context, limit int) ([]User, error) {
 			Country: u.Country,
 		})
 	}
-	return out, nil
+	res.Items = out
+	return res, nil
 }
 
 func (r *UserRepo) Create(ctx context.Context, in CreateUserInput) (User, error) {
@@ -42,6 +70,9 @@ func (r *UserRepo) Create(ctx context.Context, in CreateUserInput) (User, error)
 
 	u, err := q.Save(ctx)
 	if err != nil {
+		if ent.IsConstraintError(err) {
+			return User{}, ErrEmailTaken
+		}
 		return User{}, err
 	}
 
@@ -56,6 +87,9 @@ func (r *UserRepo) Create(ctx context.Context, in CreateUserInput) (User, error)
 func (r *UserRepo) GetByID(ctx context.Context, id uuid.UUID) (User, error) {
 	u, err := r.ent.User.Query().Where(user.IDEQ(id)).Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return User{}, ErrNotFound
+		}
 		return User{}, err
 	}
 
@@ -2,7 +2,10 @@ package user
 
 import (
 	"context"
+
 	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 type User struct {
@@ -19,7 +22,7 @@ type CreateUserInput struct {
 }
 
 type UserRepository interface {
-	List(ctx context.Context, limit int) ([]User, error)
+	List(ctx context.Context, p page.Params) (page.Page[User], error)
 	Create(ctx context.Context, in CreateUserInput) (User, error)
 	GetByID(ctx context.Context, id uuid.UUID) (User, error)
 }
@@ -2,19 +2,28 @@ package user
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
 )
 
 func NewUserUsecase(repo UserRepository) UserRepository {
 	return &userUC{repo: repo}
 }
 
-func (u *userUC) List(ctx context.Context, limit int) ([]User, error) {
-	return u.repo.List(ctx, limit)
+func (u *userUC) List(ctx context.Context, p page.Params) (page.Page[User], error) {
+	return u.repo.List(ctx, p.Normalize())
 }
 
 func (u *userUC) Create(ctx context.Context, in CreateUserInput) (User, error) {
+	if strings.TrimSpace(in.Email) == "" {
+		return User{}, ErrEmailRequired
+	}
+	if strings.TrimSpace(in.Name) == "" {
+		return User{}, ErrNameRequired
+	}
 	return u.repo.Create(ctx, in)
 }
 
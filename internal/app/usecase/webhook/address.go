@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+)
+
+// errPrivateAddr — адрес получателя оказался внутренним при соединении.
+var errPrivateAddr = errors.New("webhook: private address")
+
+// sharedAddrs — 100.64.0.0/10 (CGNAT); там же метаданные некоторых облаков.
+var sharedAddrs = netip.MustParsePrefix("100.64.0.0/10")
+
+// metadataHosts — имена сервисов метаданных облаков, которые не всегда
+// резолвятся снаружи, но внутри ведут на link-local адрес.
+var metadataHosts = []string{"metadata", "metadata.google.internal", "metadata.goog"}
+
+// lookupAddrs подменяется в тестах.
+var lookupAddrs = net.DefaultResolver.LookupNetIP
+
+// publicAddr: вебхук можно слать только на глобальный unicast адрес —
+// не loopback, не RFC 1918 / ULA, не link-local, не CGNAT.
+func publicAddr(a netip.Addr) bool {
+	a = a.Unmap()
+	return a.IsGlobalUnicast() && !a.IsPrivate() && !sharedAddrs.Contains(a)
+}
+
+// checkHost отклоняет хосты, которые ведут во внутреннюю сеть. Имя
+// проверяется по всем адресам, в которые оно резолвится сейчас; при
+// отправке адрес проверяется ещё раз (см. dialControl), потому что DNS
+// может измениться.
+func checkHost(ctx context.Context, host string) error {
+	name := strings.TrimSuffix(strings.ToLower(host), ".")
+	if name == "localhost" || strings.HasSuffix(name, ".localhost") || strings.HasSuffix(name, ".internal") {
+		return ErrPrivateURL
+	}
+	for _, m := range metadataHosts {
+		if name == m {
+			return ErrPrivateURL
+		}
+	}
+
+	if a, err := netip.ParseAddr(name); err == nil {
+		if !publicAddr(a) {
+			return ErrPrivateURL
+		}
+		return nil
+	}
+
+	addrs, err := lookupAddrs(ctx, "ip", name)
+	if err != nil || len(addrs) == 0 {
+		return ErrInvalidURL
+	}
+	for _, a := range addrs {
+		if !publicAddr(a) {
+			return ErrPrivateURL
+		}
+	}
+	return nil
+}
+
+// dialControl не даёт соединиться с внутренним адресом, даже если имя
+// после сохранения вебхука стало резолвиться в него.
+func dialControl(_, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !publicAddr(ap.Addr()) {
+		return errPrivateAddr
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestNormalizeURLRejectsPrivateHosts(t *testing.T) {
+	dns := map[string][]netip.Addr{
+		"hooks.example.com": {netip.MustParseAddr("93.184.216.34")},
+		"evil.example.com":  {netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.5")},
+	}
+	orig := lookupAddrs
+	lookupAddrs = func(_ context.Context, _, host string) ([]netip.Addr, error) {
+		if a, ok := dns[host]; ok {
+			return a, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	t.Cleanup(func() { lookupAddrs = orig })
+
+	tests := []struct {
+		url  string
+		want error
+	}{
+		{"https://hooks.example.com/in", nil},
+		{"https://93.184.216.34:8443/in", nil},
+		{"https://evil.example.com/in", ErrPrivateURL},
+		{"https://unknown.example.com/in", ErrInvalidURL},
+		{"http://localhost:8080/", ErrPrivateURL},
+		{"http://api.localhost/", ErrPrivateURL},
+		{"http://127.0.0.1/", ErrPrivateURL},
+		{"http://[::1]/", ErrPrivateURL},
+		{"http://[::ffff:192.168.1.1]/", ErrPrivateURL},
+		{"http://0.0.0.0/", ErrPrivateURL},
+		{"http://10.1.2.3/", ErrPrivateURL},
+		{"http://172.16.0.1/", ErrPrivateURL},
+		{"http://192.168.0.10/", ErrPrivateURL},
+		{"http://169.254.169.254/latest/meta-data/", ErrPrivateURL},
+		{"http://100.100.100.200/", ErrPrivateURL},
+		{"http://[fd00:ec2::254]/", ErrPrivateURL},
+		{"http://metadata.google.internal/", ErrPrivateURL},
+		{"ftp://hooks.example.com/", ErrInvalidURL},
+	}
+	for _, tt := range tests {
+		_, err := normalizeURL(context.Background(), tt.url)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: err = %v, want %v", tt.url, err, tt.want)
+		}
+	}
+}
+
+func TestDialControlRejectsPrivateAddrs(t *testing.T) {
+	for addr, ok := range map[string]bool{
+		"93.184.216.34:443":  true,
+		"127.0.0.1:80":       false,
+		"10.0.0.1:443":       false,
+		"169.254.169.254:80": false,
+		"[::1]:443":          false,
+		"[2606:4700::1]:443": true,
+	} {
+		err := dialControl("tcp", addr, nil)
+		if (err == nil) != ok {
+			t.Errorf("%s: err = %v", addr, err)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package webhook
+
+import "project-manager-dashboard-go/internal/app/usecase/apperr"
+
+var (
+	ErrNotFound         = apperr.NotFound("webhook_not_found", "webhook not found")
+	ErrDeliveryNotFound = apperr.NotFound("delivery_not_found", "delivery not found")
+	ErrProjectNotFound  = apperr.NotFound("project_not_found", "project not found")
+	ErrForbidden        = apperr.Forbidden("forbidden", "forbidden")
+	ErrInvalidURL       = apperr.Invalid("invalid_url", "invalid webhook url")
+	ErrPrivateURL       = apperr.Invalid("private_url", "webhook url points to a private address")
+	ErrInvalidEvents    = apperr.Invalid("invalid_events", "invalid event types")
+	ErrInactive         = apperr.Conflict("webhook_disabled", "webhook is disabled")
+)
@@ -0,0 +1,451 @@
+package webhook
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/predicate"
+	"project-manager-dashboard-go/ent/project"
+	"project-manager-dashboard-go/ent/projectuser"
+	"project-manager-dashboard-go/ent/user"
+	"project-manager-dashboard-go/ent/webhook"
+	"project-manager-dashboard-go/ent/webhookdelivery"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type EntRepo struct {
+	client *ent.Client
+}
+
+func NewEntRepo(c *ent.Client) *EntRepo {
+	return &EntRepo{client: c}
+}
+
+func (r *EntRepo) ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return r.client.Project.
+		Query().
+		Where(project.IDEQ(projectID)).
+		Exist(ctx)
+}
+
+func (r *EntRepo) GetMemberRole(ctx context.Context, projectID, userID uuid.UUID) (string, error) {
+	m, err := r.client.ProjectUser.
+		Query().
+		Where(
+			projectuser.HasProjectWith(project.IDEQ(projectID)),
+			projectuser.HasUserWith(user.IDEQ(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrForbidden
+		}
+		return "", err
+	}
+	return string(m.Role), nil
+}
+
+func (r *EntRepo) Create(ctx context.Context, projectID uuid.UUID, in CreateInput) (WebhookDTO, error) {
+	wh, err := r.client.Webhook.
+		Create().
+		SetProjectID(projectID).
+		SetURL(in.URL).
+		SetSecret(in.Secret).
+		SetEvents(in.Events).
+		SetCreatedBy(in.ActorID).
+		Save(ctx)
+	if err != nil {
+		return WebhookDTO{}, err
+	}
+	return toWebhookDTO(wh), nil
+}
+
+func (r *EntRepo) GetByID(ctx context.Context, id uuid.UUID) (WebhookDTO, error) {
+	wh, err := r.client.Webhook.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return WebhookDTO{}, ErrNotFound
+		}
+		return WebhookDTO{}, err
+	}
+	return toWebhookDTO(wh), nil
+}
+
+// webhookKeys — вебхуки проекта в порядке создания.
+var webhookKeys = page.Keyset{
+	{Field: webhook.FieldCreatedAt},
+	{Field: webhook.FieldID},
+}
+
+func (r *EntRepo) ListByProject(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[WebhookDTO], error) {
+	c, err := webhookKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[WebhookDTO]{}, err
+	}
+
+	q := r.client.Webhook.
+		Query().
+		Where(webhook.ProjectIDEQ(projectID))
+	var res page.Page[WebhookDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[WebhookDTO]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.Webhook](webhookKeys, c)).
+		Order(page.Order[webhook.OrderOption](webhookKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[WebhookDTO]{}, err
+	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(wh *ent.Webhook) []any {
+		return []any{wh.CreatedAt, wh.ID}
+	})
+
+	out := make([]WebhookDTO, 0, len(rows))
+	for _, wh := range rows {
+		out = append(out, toWebhookDTO(wh))
+	}
+	res.Items = out
+	return res, nil
+}
+
+func (r *EntRepo) Update(ctx context.Context, id uuid.UUID, in UpdateInput) (WebhookDTO, error) {
+	upd := r.client.Webhook.UpdateOneID(id)
+	if in.URL != nil {
+		upd.SetURL(*in.URL)
+	}
+	if in.Secret != nil {
+		upd.SetSecret(*in.Secret)
+	}
+	if in.Events != nil {
+		upd.SetEvents(in.Events)
+	}
+	if in.Active != nil {
+		upd.SetActive(*in.Active)
+		if *in.Active {
+			upd.SetConsecutiveFailures(0).ClearDisabledAt()
+		}
+	}
+
+	wh, err := upd.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return WebhookDTO{}, ErrNotFound
+		}
+		return WebhookDTO{}, err
+	}
+	return toWebhookDTO(wh), nil
+}
+
+func (r *EntRepo) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.WebhookDelivery.
+		Delete().
+		Where(webhookdelivery.WebhookIDEQ(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Webhook.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Subscribed фильтрует события в Go: вебхуков у проекта единицы, а
+// запрос по JSON-колонке зависел бы от диалекта.
+func (r *EntRepo) Subscribed(ctx context.Context, projectID uuid.UUID, event string) ([]uuid.UUID, error) {
+	rows, err := r.client.Webhook.
+		Query().
+		Where(
+			webhook.ProjectIDEQ(projectID),
+			webhook.Active(true),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uuid.UUID
+	for _, wh := range rows {
+		if slices.Contains(wh.Events, event) {
+			ids = append(ids, wh.ID)
+		}
+	}
+	return ids, nil
+}
+
+// Enqueue: релей outbox может передать событие повторно, поэтому
+// вебхуки, у которых уже есть доставка этого события, пропускаются.
+func (r *EntRepo) Enqueue(ctx context.Context, webhookIDs []uuid.UUID, eventID uuid.UUID, event, payload string) error {
+	queued, err := r.client.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.EventIDEQ(eventID),
+			webhookdelivery.RedeliveryOfIsNil(),
+		).
+		QueryWebhook().
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	builders := make([]*ent.WebhookDeliveryCreate, 0, len(webhookIDs))
+	for _, id := range webhookIDs {
+		if slices.Contains(queued, id) {
+			continue
+		}
+		builders = append(builders, r.client.WebhookDelivery.
+			Create().
+			SetWebhookID(id).
+			SetEventID(eventID).
+			SetEvent(event).
+			SetPayload(payload))
+	}
+	if len(builders) == 0 {
+		return nil
+	}
+	return r.client.WebhookDelivery.CreateBulk(builders...).Exec(ctx)
+}
+
+// deliveryKeys — журнал доставок, новые первыми.
+var deliveryKeys = page.Keyset{
+	{Field: webhookdelivery.FieldCreatedAt, Desc: true},
+	{Field: webhookdelivery.FieldID, Desc: true},
+}
+
+func (r *EntRepo) ListDeliveries(ctx context.Context, webhookID uuid.UUID, p page.Params) (page.Page[DeliveryDTO], error) {
+	c, err := deliveryKeys.Decode(p.Cursor)
+	if err != nil {
+		return page.Page[DeliveryDTO]{}, err
+	}
+
+	q := r.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.WebhookIDEQ(webhookID))
+	var res page.Page[DeliveryDTO]
+	if res.Total, err = page.Total(ctx, p, q.Clone().Count); err != nil {
+		return page.Page[DeliveryDTO]{}, err
+	}
+
+	rows, err := q.
+		Where(page.Where[predicate.WebhookDelivery](deliveryKeys, c)).
+		Order(page.Order[webhookdelivery.OrderOption](deliveryKeys, c)...).
+		Limit(p.Limit + 1).
+		Offset(p.Offset).
+		All(ctx)
+	if err != nil {
+		return page.Page[DeliveryDTO]{}, err
+	}
+	rows, res.Next, res.Prev = page.Cut(p, c, rows, func(d *ent.WebhookDelivery) []any {
+		return []any{d.CreatedAt, d.ID}
+	})
+
+	out := make([]DeliveryDTO, 0, len(rows))
+	for _, d := range rows {
+		out = append(out, toDeliveryDTO(d))
+	}
+	res.Items = out
+	return res, nil
+}
+
+func (r *EntRepo) GetDelivery(ctx context.Context, id uuid.UUID) (DeliveryDTO, error) {
+	d, err := r.client.WebhookDelivery.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DeliveryDTO{}, ErrDeliveryNotFound
+		}
+		return DeliveryDTO{}, err
+	}
+	return toDeliveryDTO(d), nil
+}
+
+func (r *EntRepo) Redeliver(ctx context.Context, d DeliveryDTO) (DeliveryDTO, error) {
+	created, err := r.client.WebhookDelivery.
+		Create().
+		SetWebhookID(d.WebhookID).
+		SetEventID(d.EventID).
+		SetEvent(d.Event).
+		SetPayload(d.Payload).
+		SetRedeliveryOf(d.ID).
+		Save(ctx)
+	if err != nil {
+		return DeliveryDTO{}, err
+	}
+	return toDeliveryDTO(created), nil
+}
+
+func (r *EntRepo) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]DueDelivery, error) {
+	rows, err := r.client.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+			webhookdelivery.HasWebhookWith(webhook.Active(true)),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]DueDelivery, 0, len(rows))
+	for _, d := range rows {
+		out = append(out, DueDelivery{
+			Delivery: toDeliveryDTO(d),
+			URL:      d.Edges.Webhook.URL,
+			Secret:   d.Edges.Webhook.Secret,
+		})
+	}
+	return out, nil
+}
+
+func (r *EntRepo) MarkSucceeded(ctx context.Context, d DeliveryDTO, res Result, at time.Time) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	err = recordAttempt(tx.WebhookDelivery.UpdateOneID(d.ID), res, at).
+		SetStatus(webhookdelivery.StatusSucceeded).
+		ClearLastError().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Webhook.
+		UpdateOneID(d.WebhookID).
+		SetConsecutiveFailures(0).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *EntRepo) MarkFailed(ctx context.Context, d DeliveryDTO, res Result, at time.Time, next *time.Time) (failures int, err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	upd := recordAttempt(tx.WebhookDelivery.UpdateOneID(d.ID), res, at).
+		SetLastError(res.Err.Error())
+	if next != nil {
+		upd.SetNextAttemptAt(*next)
+	} else {
+		upd.SetStatus(webhookdelivery.StatusFailed)
+	}
+	if err = upd.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	wh, err := tx.Webhook.
+		UpdateOneID(d.WebhookID).
+		AddConsecutiveFailures(1).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return wh.ConsecutiveFailures, tx.Commit()
+}
+
+func (r *EntRepo) Disable(ctx context.Context, webhookID uuid.UUID, at time.Time) error {
+	return r.client.Webhook.
+		UpdateOneID(webhookID).
+		SetActive(false).
+		SetDisabledAt(at).
+		Exec(ctx)
+}
+
+// PurgeDeleted удаляет из журнала завершённые доставки старше before.
+func (r *EntRepo) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	return r.client.WebhookDelivery.
+		Delete().
+		Where(
+			webhookdelivery.StatusNEQ(webhookdelivery.StatusPending),
+			webhookdelivery.CreatedAtLT(before),
+		).
+		Exec(ctx)
+}
+
+func recordAttempt(upd *ent.WebhookDeliveryUpdateOne, res Result, at time.Time) *ent.WebhookDeliveryUpdateOne {
+	upd.AddAttempts(1).
+		SetLastAttemptAt(at).
+		SetDurationMs(int(res.Duration.Milliseconds()))
+	if res.StatusCode != 0 {
+		upd.SetResponseCode(res.StatusCode).SetResponseBody(res.Body)
+	} else {
+		upd.ClearResponseCode().ClearResponseBody()
+	}
+	return upd
+}
+
+func toWebhookDTO(wh *ent.Webhook) WebhookDTO {
+	return WebhookDTO{
+		ID:                  wh.ID,
+		ProjectID:           wh.ProjectID,
+		URL:                 wh.URL,
+		Events:              wh.Events,
+		Active:              wh.Active,
+		ConsecutiveFailures: wh.ConsecutiveFailures,
+		DisabledAt:          wh.DisabledAt,
+		CreatedBy:           wh.CreatedBy,
+		CreatedAt:           wh.CreatedAt,
+		UpdatedAt:           wh.UpdatedAt,
+	}
+}
+
+func toDeliveryDTO(d *ent.WebhookDelivery) DeliveryDTO {
+	return DeliveryDTO{
+		ID:            d.ID,
+		WebhookID:     d.WebhookID,
+		EventID:       d.EventID,
+		Event:         d.Event,
+		Payload:       d.Payload,
+		RedeliveryOf:  d.RedeliveryOf,
+		Status:        string(d.Status),
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+		ResponseCode:  d.ResponseCode,
+		ResponseBody:  d.ResponseBody,
+		LastError:     d.LastError,
+		DurationMS:    d.DurationMs,
+		LastAttemptAt: d.LastAttemptAt,
+		CreatedAt:     d.CreatedAt,
+	}
+}
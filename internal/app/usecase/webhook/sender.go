@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// Заголовки запроса вебхука.
+const (
+	HeaderWebhookID = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// maxResponseBody — сколько байт ответа сохраняется в журнале.
+const maxResponseBody = 4 << 10
+
+// HTTPSender отправляет POST с JSON-телом. Редиректы не выполняются:
+// ответ 3xx считается ошибкой, как и любой не 2xx. Соединения с
+// внутренними адресами запрещены; прокси из окружения не используется,
+// иначе проверялся бы адрес прокси, а не получателя.
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   dialControl,
+	}).DialContext
+
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, dd DueDelivery) Result {
+	d := dd.Delivery
+	body := []byte(d.Payload)
+	ts := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dd.URL, bytes.NewReader(body))
+	if err != nil {
+		return Result{Err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "project-manager-webhooks/1")
+	req.Header.Set(HeaderWebhookID, d.WebhookID.String())
+	req.Header.Set(HeaderEvent, d.Event)
+	req.Header.Set(HeaderDelivery, d.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(dd.Secret, ts, body))
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return Result{Duration: time.Since(start), Err: err}
+	}
+	defer resp.Body.Close()
+
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	res := Result{
+		StatusCode: resp.StatusCode,
+		Body:       toValidUTF8(raw),
+		Duration:   time.Since(start),
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		res.Err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return res
+}
+
+// Sign — HMAC-SHA256 от "<timestamp>.<body>" в hex. Метка времени входит
+// в подпись, чтобы перехваченный запрос нельзя было повторить позже.
+func Sign(secret string, ts int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// toValidUTF8 нужен, потому что ответ пишется в текстовую колонку, а
+// обрезка по maxResponseBody может разрезать символ.
+func toValidUTF8(b []byte) string {
+	if utf8.Valid(b) {
+		return string(b)
+	}
+	return string(bytes.ToValidUTF8(b, []byte("�")))
+}
@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+type WebhookService interface {
+	Create(ctx context.Context, projectID uuid.UUID, in CreateInput) (WebhookDTO, error)
+	List(ctx context.Context, projectID, actorID uuid.UUID, p page.Params) (page.Page[WebhookDTO], error)
+	Get(ctx context.Context, id, actorID uuid.UUID) (WebhookDTO, error)
+	Update(ctx context.Context, id, actorID uuid.UUID, in UpdateInput) (WebhookDTO, error)
+	Delete(ctx context.Context, id, actorID uuid.UUID) error
+	Deliveries(ctx context.Context, webhookID, actorID uuid.UUID, p page.Params) (page.Page[DeliveryDTO], error)
+	Redeliver(ctx context.Context, webhookID, deliveryID, actorID uuid.UUID) (DeliveryDTO, error)
+}
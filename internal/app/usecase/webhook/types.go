@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+// Events — события outbox, на которые можно подписаться; совпадают
+// с schema.WebhookEvents.
+var Events = []string{
+	outbox.TypeTaskCreated,
+	outbox.TypeTaskUpdated,
+	outbox.TypeTaskDeleted,
+	outbox.TypeTaskAssigned,
+	outbox.TypeTaskUnassigned,
+	outbox.TypeProjectMemberAdded,
+}
+
+// Статусы доставки.
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+type WebhookDTO struct {
+	ID                  uuid.UUID
+	ProjectID           uuid.UUID
+	URL                 string
+	Events              []string
+	Active              bool
+	ConsecutiveFailures int
+	DisabledAt          *time.Time
+	CreatedBy           uuid.UUID
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+
+	// Secret заполняется только при создании: потом его не показываем.
+	Secret string
+}
+
+type CreateInput struct {
+	ActorID uuid.UUID
+	URL     string
+	// Secret генерируется, если не передан.
+	Secret string
+	Events []string
+}
+
+// UpdateInput: nil — поле не меняется. Active=true включает выключенный
+// вебхук и обнуляет счётчик ошибок.
+type UpdateInput struct {
+	URL    *string
+	Secret *string
+	Events []string
+	Active *bool
+}
+
+type DeliveryDTO struct {
+	ID            uuid.UUID
+	WebhookID     uuid.UUID
+	EventID       uuid.UUID
+	Event         string
+	Payload       string
+	RedeliveryOf  *uuid.UUID
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	ResponseCode  *int
+	ResponseBody  *string
+	LastError     *string
+	DurationMS    *int
+	LastAttemptAt *time.Time
+	CreatedAt     time.Time
+}
+
+// DueDelivery — доставка из очереди вместе с адресом и секретом вебхука.
+type DueDelivery struct {
+	Delivery DeliveryDTO
+	URL      string
+	Secret   string
+}
+
+// Envelope — тело запроса вебхука. id — id события в outbox, одинаковый
+// у всех доставок события, включая повторные: по нему получатель
+// отбрасывает дубликаты.
+type Envelope struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	ProjectID uuid.UUID       `json:"projectId"`
+	ActorID   *uuid.UUID      `json:"actorId,omitempty"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Result — исход одной попытки доставки. Err != nil — запрос не дошёл
+// или ответ не 2xx.
+type Result struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+	Err        error
+}
+
+// Sender отправляет подписанный запрос вебхуку.
+type Sender interface {
+	Send(ctx context.Context, d DueDelivery) Result
+}
+
+type WebhookRepository interface {
+	ProjectExists(ctx context.Context, projectID uuid.UUID) (bool, error)
+	GetMemberRole(ctx context.Context, projectID, userID uuid.UUID) (string, error)
+
+	Create(ctx context.Context, projectID uuid.UUID, in CreateInput) (WebhookDTO, error)
+	GetByID(ctx context.Context, id uuid.UUID) (WebhookDTO, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID, p page.Params) (page.Page[WebhookDTO], error)
+	Update(ctx context.Context, id uuid.UUID, in UpdateInput) (WebhookDTO, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Subscribed возвращает активные вебхуки проекта, подписанные на событие.
+	Subscribed(ctx context.Context, projectID uuid.UUID, event string) ([]uuid.UUID, error)
+	// Enqueue пропускает вебхуки, которым событие уже поставлено в очередь.
+	Enqueue(ctx context.Context, webhookIDs []uuid.UUID, eventID uuid.UUID, event, payload string) error
+
+	ListDeliveries(ctx context.Context, webhookID uuid.UUID, p page.Params) (page.Page[DeliveryDTO], error)
+	GetDelivery(ctx context.Context, id uuid.UUID) (DeliveryDTO, error)
+	Redeliver(ctx context.Context, d DeliveryDTO) (DeliveryDTO, error)
+
+	// DueDeliveries возвращает ожидающие доставки активных вебхуков.
+	DueDeliveries(ctx context.Context, now time.Time, limit int) ([]DueDelivery, error)
+	MarkSucceeded(ctx context.Context, d DeliveryDTO, res Result, at time.Time) error
+	// MarkFailed записывает неудачную попытку: next == nil — попытки
+	// исчерпаны. Возвращает счётчик ошибок вебхука подряд.
+	MarkFailed(ctx context.Context, d DeliveryDTO, res Result, at time.Time, next *time.Time) (int, error)
+	Disable(ctx context.Context, webhookID uuid.UUID, at time.Time) error
+}
@@ -0,0 +1,315 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+const (
+	queueBatch = 50
+	// maxBackoff ограничивает паузу между попытками доставки.
+	maxBackoff = 6 * time.Hour
+)
+
+type UseCase struct {
+	repo         WebhookRepository
+	sender       Sender
+	maxAttempts  int
+	disableAfter int
+}
+
+// NewWebhookUsecase: доставка повторяется maxAttempts раз; после
+// disableAfter неудачных попыток подряд вебхук выключается.
+func NewWebhookUsecase(repo WebhookRepository, sender Sender, maxAttempts, disableAfter int) *UseCase {
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	if disableAfter <= 0 {
+		disableAfter = 1
+	}
+	return &UseCase{repo: repo, sender: sender, maxAttempts: maxAttempts, disableAfter: disableAfter}
+}
+
+func (uc *UseCase) Create(ctx context.Context, projectID uuid.UUID, in CreateInput) (WebhookDTO, error) {
+	if err := uc.requireOwner(ctx, projectID, in.ActorID); err != nil {
+		return WebhookDTO{}, err
+	}
+
+	u, err := normalizeURL(ctx, in.URL)
+	if err != nil {
+		return WebhookDTO{}, err
+	}
+	in.URL = u
+
+	in.Events, err = normalizeEvents(in.Events)
+	if err != nil {
+		return WebhookDTO{}, err
+	}
+
+	if in.Secret == "" {
+		if in.Secret, err = newSecret(); err != nil {
+			return WebhookDTO{}, err
+		}
+	}
+
+	created, err := uc.repo.Create(ctx, projectID, in)
+	if err != nil {
+		return WebhookDTO{}, err
+	}
+	created.Secret = in.Secret
+	return created, nil
+}
+
+func (uc *UseCase) List(ctx context.Context, projectID, actorID uuid.UUID, p page.Params) (page.Page[WebhookDTO], error) {
+	p = p.Normalize()
+	if err := uc.requireOwner(ctx, projectID, actorID); err != nil {
+		return page.Page[WebhookDTO]{}, err
+	}
+	return uc.repo.ListByProject(ctx, projectID, p)
+}
+
+func (uc *UseCase) Get(ctx context.Context, id, actorID uuid.UUID) (WebhookDTO, error) {
+	return uc.getOwned(ctx, id, actorID)
+}
+
+func (uc *UseCase) Update(ctx context.Context, id, actorID uuid.UUID, in UpdateInput) (WebhookDTO, error) {
+	if _, err := uc.getOwned(ctx, id, actorID); err != nil {
+		return WebhookDTO{}, err
+	}
+
+	if in.URL != nil {
+		u, err := normalizeURL(ctx, *in.URL)
+		if err != nil {
+			return WebhookDTO{}, err
+		}
+		in.URL = &u
+	}
+	if in.Events != nil {
+		events, err := normalizeEvents(in.Events)
+		if err != nil {
+			return WebhookDTO{}, err
+		}
+		in.Events = events
+	}
+	if in.Secret != nil && *in.Secret == "" {
+		s, err := newSecret()
+		if err != nil {
+			return WebhookDTO{}, err
+		}
+		in.Secret = &s
+	}
+
+	updated, err := uc.repo.Update(ctx, id, in)
+	if err != nil {
+		return WebhookDTO{}, err
+	}
+	if in.Secret != nil {
+		updated.Secret = *in.Secret
+	}
+	return updated, nil
+}
+
+func (uc *UseCase) Delete(ctx context.Context, id, actorID uuid.UUID) error {
+	if _, err := uc.getOwned(ctx, id, actorID); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, id)
+}
+
+// Deliveries — журнал доставок вебхука, новые первыми.
+func (uc *UseCase) Deliveries(ctx context.Context, webhookID, actorID uuid.UUID, p page.Params) (page.Page[DeliveryDTO], error) {
+	p = p.Normalize()
+	if _, err := uc.getOwned(ctx, webhookID, actorID); err != nil {
+		return page.Page[DeliveryDTO]{}, err
+	}
+	return uc.repo.ListDeliveries(ctx, webhookID, p)
+}
+
+// Redeliver ставит в очередь копию доставки с тем же телом и id события.
+func (uc *UseCase) Redeliver(ctx context.Context, webhookID, deliveryID, actorID uuid.UUID) (DeliveryDTO, error) {
+	wh, err := uc.getOwned(ctx, webhookID, actorID)
+	if err != nil {
+		return DeliveryDTO{}, err
+	}
+	if !wh.Active {
+		return DeliveryDTO{}, ErrInactive
+	}
+
+	d, err := uc.repo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return DeliveryDTO{}, err
+	}
+	if d.WebhookID != webhookID {
+		return DeliveryDTO{}, ErrDeliveryNotFound
+	}
+	return uc.repo.Redeliver(ctx, d)
+}
+
+// Handle ставит событие outbox в очередь доставки всем подписанным
+// вебхукам проекта; сама отправка — в ProcessQueue. Реализует outbox.Sink.
+func (uc *UseCase) Handle(ctx context.Context, ev outbox.Event) error {
+	if ev.ProjectID == nil || !slices.Contains(Events, ev.Type) {
+		return nil
+	}
+
+	ids, err := uc.repo.Subscribed(ctx, *ev.ProjectID, ev.Type)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	payload, err := json.Marshal(Envelope{
+		ID:        ev.ID,
+		Type:      ev.Type,
+		CreatedAt: ev.CreatedAt.UTC(),
+		ProjectID: *ev.ProjectID,
+		ActorID:   ev.ActorID,
+		Data:      ev.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	return uc.repo.Enqueue(ctx, ids, ev.ID, ev.Type, string(payload))
+}
+
+// ProcessQueue отправляет доставки, время попытки которых наступило.
+// Неудача откладывает доставку с растущей паузой, после maxAttempts
+// попыток она помечается как failed. Вебхук, у которого disableAfter
+// попыток подряд закончились ошибкой, выключается.
+func (uc *UseCase) ProcessQueue(ctx context.Context, now time.Time) (int, error) {
+	due, err := uc.repo.DueDeliveries(ctx, now, queueBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	disabled := make(map[uuid.UUID]bool)
+	for _, dd := range due {
+		d := dd.Delivery
+		if disabled[d.WebhookID] {
+			continue
+		}
+
+		res := uc.sender.Send(ctx, dd)
+		at := time.Now()
+		if res.Err == nil {
+			if err := uc.repo.MarkSucceeded(ctx, d, res, at); err != nil {
+				return sent, err
+			}
+			sent++
+			continue
+		}
+
+		attempts := d.Attempts + 1
+		log.Printf("webhook %s delivery %s: attempt %d: %v", d.WebhookID, d.ID, attempts, res.Err)
+
+		var next *time.Time
+		if attempts < uc.maxAttempts {
+			t := now.Add(backoff(attempts))
+			next = &t
+		}
+		failures, err := uc.repo.MarkFailed(ctx, d, res, at, next)
+		if err != nil {
+			return sent, err
+		}
+		if failures >= uc.disableAfter {
+			if err := uc.repo.Disable(ctx, d.WebhookID, at); err != nil {
+				return sent, err
+			}
+			disabled[d.WebhookID] = true
+			log.Printf("webhook %s disabled after %d failures in a row", d.WebhookID, failures)
+		}
+	}
+	return sent, nil
+}
+
+func (uc *UseCase) getOwned(ctx context.Context, id, actorID uuid.UUID) (WebhookDTO, error) {
+	wh, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return WebhookDTO{}, err
+	}
+	if err := uc.requireOwner(ctx, wh.ProjectID, actorID); err != nil {
+		return WebhookDTO{}, err
+	}
+	return wh, nil
+}
+
+// requireOwner: вебхуками проекта управляет только его owner.
+func (uc *UseCase) requireOwner(ctx context.Context, projectID, actorID uuid.UUID) error {
+	ok, err := uc.repo.ProjectExists(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrProjectNotFound
+	}
+
+	role, err := uc.repo.GetMemberRole(ctx, projectID, actorID)
+	if err != nil {
+		return err
+	}
+	if role != "owner" {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func normalizeURL(ctx context.Context, raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return "", ErrInvalidURL
+	}
+	if err := checkHost(ctx, u.Hostname()); err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// normalizeEvents убирает повторы; пустой список и неизвестные типы — ошибка.
+func normalizeEvents(events []string) ([]string, error) {
+	out := make([]string, 0, len(events))
+	for _, e := range events {
+		if !slices.Contains(Events, e) {
+			return nil, ErrInvalidEvents
+		}
+		if !slices.Contains(out, e) {
+			out = append(out, e)
+		}
+	}
+	if len(out) == 0 {
+		return nil, ErrInvalidEvents
+	}
+	return out, nil
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// backoff: 1m, 2m, 4m, ... но не больше maxBackoff.
+func backoff(attempts int) time.Duration {
+	d := time.Minute
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d
+}
@@ -0,0 +1,181 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+)
+
+// EventsChannel — канал NOTIFY, по которому реплики узнают о новых событиях.
+const EventsChannel = "outbox_events"
+
+const (
+	catchUpBatch      = 500
+	listenMaxBackoff  = 30 * time.Second
+	listenInitBackoff = time.Second
+)
+
+// PGNotifier — получатель outbox, который рассылает всем репликам номер
+// публикации события через pg_notify. В уведомлении только номер: размер
+// payload NOTIFY ограничен (8000 байт), а тело реплика прочитает из
+// outbox сама.
+type PGNotifier struct {
+	db      *sql.DB
+	channel string
+}
+
+func NewPGNotifier(db *sql.DB, channel string) *PGNotifier {
+	return &PGNotifier{db: db, channel: channel}
+}
+
+func (n *PGNotifier) Handle(ctx context.Context, ev outbox.Event) error {
+	_, err := n.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, n.channel, strconv.FormatInt(ev.PublishedSeq, 10))
+	return err
+}
+
+// EventLoader читает события outbox для PGListener.
+type EventLoader interface {
+	Range(ctx context.Context, after, upTo int64, limit int) ([]outbox.Event, error)
+	LastPublished(ctx context.Context) (int64, error)
+}
+
+// PGListener слушает канал NOTIFY на выделенном соединении и передаёт
+// события в sink (обычно outbox.Bus) своей реплики. После обрыва
+// соединения переподключается с растущей паузой и досылает события,
+// пропущенные за время простоя.
+//
+// Позиция слушателя — номер публикации (outbox.Event.PublishedSeq), а не
+// номер вставки: релей выдаёт номера публикации подряд и только
+// зафиксированным событиям, поэтому событие с меньшим номером не может
+// появиться после большего и позиции хватает одного числа.
+type PGListener struct {
+	db      *sql.DB
+	channel string
+	loader  EventLoader
+	sink    outbox.Sink
+
+	// last — номер публикации последнего переданного события.
+	last    int64
+	started bool
+}
+
+func NewPGListener(db *sql.DB, channel string, loader EventLoader, sink outbox.Sink) *PGListener {
+	return &PGListener{
+		db:      db,
+		channel: channel,
+		loader:  loader,
+		sink:    sink,
+	}
+}
+
+// Run блокируется до отмены ctx.
+func (l *PGListener) Run(ctx context.Context) {
+	backoff := listenInitBackoff
+	for {
+		connected, err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = listenInitBackoff
+		}
+		log.Printf("event listener: %v; reconnecting in %s", err, backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > listenMaxBackoff {
+			backoff = listenMaxBackoff
+		}
+	}
+}
+
+// listen работает, пока живо соединение. connected — успели ли начать
+// слушать: от этого зависит, сбрасывать ли паузу переподключения.
+func (l *PGListener) listen(ctx context.Context) (connected bool, err error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	rawErr := conn.Raw(func(dc any) error {
+		pc := dc.(*stdlib.Conn).Conn()
+
+		if _, err = pc.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+			return driver.ErrBadConn
+		}
+		connected = true
+
+		// Подписка уже действует, поэтому всё, что опубликовано после
+		// LastPublished, придёт уведомлением или будет дочитано здесь.
+		var upTo int64
+		upTo, err = l.loader.LastPublished(ctx)
+		if err != nil {
+			return driver.ErrBadConn
+		}
+		if !l.started {
+			l.last, l.started = upTo, true
+		} else if err = l.deliver(ctx, upTo); err != nil {
+			return driver.ErrBadConn
+		}
+
+		for {
+			var n *pgconn.Notification
+			n, err = pc.WaitForNotification(ctx)
+			if err != nil {
+				// Соединение с активным LISTEN не должно вернуться в пул.
+				return driver.ErrBadConn
+			}
+
+			seq, perr := strconv.ParseInt(n.Payload, 10, 64)
+			if perr != nil {
+				log.Printf("event listener: bad payload %q", n.Payload)
+				continue
+			}
+			if err = l.deliver(ctx, seq); err != nil {
+				return driver.ErrBadConn
+			}
+		}
+	})
+	if err == nil && rawErr != nil && !errors.Is(rawErr, driver.ErrBadConn) {
+		err = rawErr
+	}
+	return connected, err
+}
+
+// deliver передаёт события с номерами публикации в (last, upTo].
+// Уведомления могут прийти не все (например, во время переподключения),
+// поэтому читается весь диапазон, а не одно событие.
+func (l *PGListener) deliver(ctx context.Context, upTo int64) error {
+	for l.last < upTo {
+		events, err := l.loader.Range(ctx, l.last, upTo, catchUpBatch)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			l.last = upTo
+			return nil
+		}
+		for _, ev := range events {
+			if err := l.sink.Handle(ctx, ev); err != nil {
+				return err
+			}
+			l.last = ev.PublishedSeq
+		}
+	}
+	return nil
+}
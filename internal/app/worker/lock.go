@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Locker — блокировка, общая для всех реплик сервиса.
+type Locker interface {
+	// TryLock не ждёт: ok == false, если блокировку держит другая реплика.
+	TryLock(ctx context.Context, name string) (unlock func(), ok bool, err error)
+}
+
+// PGLocker реализует Locker на сессионных advisory-блокировках PostgreSQL.
+// Блокировка живёт на выделенном соединении и снимается при его закрытии,
+// поэтому упавшая реплика её не удерживает.
+type PGLocker struct {
+	db *sql.DB
+}
+
+func NewPGLocker(db *sql.DB) *PGLocker {
+	return &PGLocker{db: db}
+}
+
+func (l *PGLocker) TryLock(ctx context.Context, name string) (func(), bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var ok bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, name).Scan(&ok)
+	if err != nil || !ok {
+		_ = conn.Close()
+		return nil, false, err
+	}
+
+	unlock := func() {
+		_, _ = conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, name)
+		_ = conn.Close()
+	}
+	return unlock, true, nil
+}
@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	mailQueueLockName = "worker:mail-queue"
+	digestLockName    = "worker:mail-digest"
+)
+
+// MailProcessor отправляет письма из очереди.
+type MailProcessor interface {
+	ProcessQueue(ctx context.Context, now time.Time) (int, error)
+}
+
+// DigestSender собирает ежедневные дайджесты.
+type DigestSender interface {
+	SendDigests(ctx context.Context, now time.Time) (int, error)
+}
+
+// MailQueue периодически разбирает очередь исходящей почты. Очередь
+// обрабатывает одна реплика — та, что взяла блокировку.
+type MailQueue struct {
+	Interval time.Duration
+
+	locker    Locker
+	processor MailProcessor
+}
+
+func NewMailQueue(interval time.Duration, locker Locker, processor MailProcessor) *MailQueue {
+	return &MailQueue{
+		Interval:  interval,
+		locker:    locker,
+		processor: processor,
+	}
+}
+
+// Run блокируется до отмены ctx.
+func (q *MailQueue) Run(ctx context.Context) {
+	ticker := time.NewTicker(q.Interval)
+	defer ticker.Stop()
+
+	q.runOnce(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.runOnce(ctx)
+		}
+	}
+}
+
+func (q *MailQueue) runOnce(ctx context.Context) {
+	unlock, ok, err := q.locker.TryLock(ctx, mailQueueLockName)
+	if err != nil {
+		log.Printf("mail queue: lock: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	n, err := q.processor.ProcessQueue(ctx, time.Now())
+	if err != nil {
+		log.Printf("mail queue: %v", err)
+	}
+	if n > 0 {
+		log.Printf("mail queue: sent %d", n)
+	}
+}
+
+// MailDigest раз в сутки, в час Hour (UTC), отправляет дайджесты. Время
+// проверяется каждые checkInterval; повторная отправка в тот же день
+// не создаёт писем, так как уведомления уже отмечены.
+type MailDigest struct {
+	Hour int
+
+	locker  Locker
+	sender  DigestSender
+	lastDay string
+}
+
+const digestCheckInterval = 5 * time.Minute
+
+func NewMailDigest(hour int, locker Locker, sender DigestSender) *MailDigest {
+	return &MailDigest{
+		Hour:   hour,
+		locker: locker,
+		sender: sender,
+	}
+}
+
+// Run блокируется до отмены ctx.
+func (d *MailDigest) Run(ctx context.Context) {
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		d.runIfDue(ctx, time.Now().UTC())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *MailDigest) runIfDue(ctx context.Context, now time.Time) {
+	day := now.Format("2006-01-02")
+	if now.Hour() != d.Hour || d.lastDay == day {
+		return
+	}
+
+	unlock, ok, err := d.locker.TryLock(ctx, digestLockName)
+	if err != nil {
+		log.Printf("mail digest: lock: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	n, err := d.sender.SendDigests(ctx, now)
+	if err != nil {
+		log.Printf("mail digest: %v", err)
+		return
+	}
+	d.lastDay = day
+	if n > 0 {
+		log.Printf("mail digest: queued %d", n)
+	}
+}
@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const outboxRelayLockName = "worker:outbox-relay"
+
+// OutboxPublisher публикует неотправленные события outbox.
+type OutboxPublisher interface {
+	Relay(ctx context.Context) (int, error)
+}
+
+// OutboxRelay периодически публикует события outbox. Порядок событий
+// сохраняется, потому что релей работает в одной реплике — той, что
+// взяла блокировку.
+type OutboxRelay struct {
+	Interval time.Duration
+
+	locker    Locker
+	publisher OutboxPublisher
+}
+
+func NewOutboxRelay(interval time.Duration, locker Locker, publisher OutboxPublisher) *OutboxRelay {
+	return &OutboxRelay{
+		Interval:  interval,
+		locker:    locker,
+		publisher: publisher,
+	}
+}
+
+// Run блокируется до отмены ctx.
+func (o *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.Interval)
+	defer ticker.Stop()
+
+	o.runOnce(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			o.runOnce(ctx)
+		}
+	}
+}
+
+func (o *OutboxRelay) runOnce(ctx context.Context) {
+	unlock, ok, err := o.locker.TryLock(ctx, outboxRelayLockName)
+	if err != nil {
+		log.Printf("outbox relay: lock: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	n, err := o.publisher.Relay(ctx)
+	if err != nil {
+		log.Printf("outbox relay: %v", err)
+	}
+	if n > 0 {
+		log.Printf("outbox relay: published %d", n)
+	}
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const reminderLockName = "worker:due-reminders"
+
+// ReminderSender отправляет напоминания о сроках задач.
+type ReminderSender interface {
+	SendReminders(ctx context.Context, now time.Time, dueSoon time.Duration) (int, error)
+}
+
+// DueReminder периодически ищет задачи, срок которых скоро наступит или уже
+// прошёл. Проход выполняет только реплика, взявшая блокировку; повторную
+// отправку дополнительно исключает уникальный индекс напоминаний.
+type DueReminder struct {
+	Interval time.Duration
+	DueSoon  time.Duration
+
+	locker Locker
+	sender ReminderSender
+}
+
+func NewDueReminder(interval, dueSoon time.Duration, locker Locker, sender ReminderSender) *DueReminder {
+	return &DueReminder{
+		Interval: interval,
+		DueSoon:  dueSoon,
+		locker:   locker,
+		sender:   sender,
+	}
+}
+
+// Run блокируется до отмены ctx.
+func (d *DueReminder) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+
+	d.runOnce(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.runOnce(ctx)
+		}
+	}
+}
+
+func (d *DueReminder) runOnce(ctx context.Context) {
+	unlock, ok, err := d.locker.TryLock(ctx, reminderLockName)
+	if err != nil {
+		log.Printf("due reminders: lock: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	n, err := d.sender.SendReminders(ctx, time.Now(), d.DueSoon)
+	if err != nil {
+		log.Printf("due reminders: %v", err)
+	}
+	if n > 0 {
+		log.Printf("due reminders: sent %d", n)
+	}
+}
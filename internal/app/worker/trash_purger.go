@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+)
+
+const purgeLockPrefix = "worker:purge:"
+
+// Purger окончательно удаляет записи, попавшие в корзину раньше before.
+type Purger interface {
+	PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+}
+
+// TrashPurger периодически очищает корзину от записей старше Retention.
+// Проход выполняет только реплика, взявшая блокировку.
+type TrashPurger struct {
+	Retention time.Duration
+	Interval  time.Duration
+
+	locker  Locker
+	names   []string
+	purgers []Purger
+}
+
+func NewTrashPurger(retention, interval time.Duration, locker Locker) *TrashPurger {
+	return &TrashPurger{
+		Retention: retention,
+		Interval:  interval,
+		locker:    locker,
+	}
+}
+
+// Register добавляет источник очистки. Источники обрабатываются в порядке
+// регистрации: задачи стоит регистрировать раньше проектов.
+func (p *TrashPurger) Register(name string, purger Purger) {
+	p.names = append(p.names, name)
+	p.purgers = append(p.purgers, purger)
+}
+
+// Run блокируется до отмены ctx.
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	p.purgeOnce(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.purgeOnce(ctx)
+		}
+	}
+}
+
+func (p *TrashPurger) purgeOnce(ctx context.Context) {
+	// Источники одного очистителя чистятся под общей блокировкой, чтобы
+	// порядок регистрации соблюдался и между репликами.
+	lockName := purgeLockPrefix + strings.Join(p.names, ",")
+	unlock, ok, err := p.locker.TryLock(ctx, lockName)
+	if err != nil {
+		log.Printf("trash purge: lock: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	before := time.Now().Add(-p.Retention)
+	for i, purger := range p.purgers {
+		name := p.names[i]
+		n, err := purger.PurgeDeleted(ctx, before)
+		if err != nil {
+			log.Printf("trash purge %s: %v", name, err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("trash purge %s: removed %d", name, n)
+		}
+	}
+}
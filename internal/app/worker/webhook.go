@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const webhookQueueLockName = "worker:webhook-queue"
+
+// WebhookProcessor доставляет события вебхукам.
+type WebhookProcessor interface {
+	ProcessQueue(ctx context.Context, now time.Time) (int, error)
+}
+
+// WebhookQueue периодически разбирает очередь доставок вебхуков. Очередь
+// обрабатывает одна реплика — та, что взяла блокировку.
+type WebhookQueue struct {
+	Interval time.Duration
+
+	locker    Locker
+	processor WebhookProcessor
+}
+
+func NewWebhookQueue(interval time.Duration, locker Locker, processor WebhookProcessor) *WebhookQueue {
+	return &WebhookQueue{
+		Interval:  interval,
+		locker:    locker,
+		processor: processor,
+	}
+}
+
+// Run блокируется до отмены ctx.
+func (q *WebhookQueue) Run(ctx context.Context) {
+	ticker := time.NewTicker(q.Interval)
+	defer ticker.Stop()
+
+	q.runOnce(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.runOnce(ctx)
+		}
+	}
+}
+
+func (q *WebhookQueue) runOnce(ctx context.Context) {
+	unlock, ok, err := q.locker.TryLock(ctx, webhookQueueLockName)
+	if err != nil {
+		log.Printf("webhook queue: lock: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	n, err := q.processor.ProcessQueue(ctx, time.Now())
+	if err != nil {
+		log.Printf("webhook queue: %v", err)
+	}
+	if n > 0 {
+		log.Printf("webhook queue: delivered %d", n)
+	}
+}
@@ -0,0 +1,160 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	stdhttp "net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/internal/app/usecase/apperr"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+	projectuc "project-manager-dashboard-go/internal/app/usecase/project"
+	taskuc "project-manager-dashboard-go/internal/app/usecase/task"
+)
+
+const (
+	codeInternal = "internal_error"
+	codeNotFound = "not_found"
+	// codePageLimit в стиле кодов gqlgen (COMPLEXITY_LIMIT_EXCEEDED и
+	// др.): это ошибка запроса, а не доменная.
+	codePageLimit = "PAGE_LIMIT_REQUIRED"
+)
+
+// NewHandler — HTTP-обработчик GraphQL. complexityLimit — предел
+// стоимости запроса; связь Connection стоит first (или last) её узлов.
+func NewHandler(client *ent.Client, projects projectuc.ProjectService, tasks taskuc.TaskService, complexityLimit int) stdhttp.Handler {
+	es := NewExecutableSchema(Config{Resolvers: &Resolver{
+		client:   client,
+		projects: projects,
+		tasks:    tasks,
+	}})
+
+	srv := handler.New(pagedSchema{es})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(pageLimit{})
+	srv.Use(extension.FixedComplexityLimit(complexityLimit))
+	srv.SetErrorPresenter(presentError)
+	return srv
+}
+
+// pagedSchema умножает стоимость выборки внутри Connection на размер
+// страницы: без этого десять узлов и сотня стоят одинаково.
+type pagedSchema struct {
+	graphql.ExecutableSchema
+}
+
+func (s pagedSchema) Complexity(typeName, fieldName string, child int, args map[string]any) (int, bool) {
+	if n, ok := pageSize(args); ok {
+		return 1 + n*child, true
+	}
+	return s.ExecutableSchema.Complexity(typeName, fieldName, child, args)
+}
+
+// pageLimit требует first или last у каждой Connection в запросе, не
+// больше page.MaxLimit: без них entgql читает связь целиком.
+type pageLimit struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = pageLimit{}
+
+func (pageLimit) ExtensionName() string { return "PageLimit" }
+
+func (pageLimit) Validate(graphql.ExecutableSchema) error { return nil }
+
+func (pageLimit) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if rc.Operation == nil {
+		return nil
+	}
+	return checkPages(rc.Operation.SelectionSet, rc.Variables)
+}
+
+func checkPages(set ast.SelectionSet, vars map[string]any) *gqlerror.Error {
+	for _, sel := range set {
+		switch s := sel.(type) {
+		case *ast.Field:
+			if s.Definition != nil && strings.HasSuffix(s.Definition.Type.Name(), "Connection") {
+				n, ok := pageSize(s.ArgumentMap(vars))
+				if !ok || n > page.MaxLimit {
+					err := gqlerror.ErrorPosf(s.Position, "field %q requires first or last not greater than %d", s.Alias, page.MaxLimit)
+					err.Extensions = map[string]any{"code": codePageLimit}
+					return err
+				}
+			}
+			if err := checkPages(s.SelectionSet, vars); err != nil {
+				return err
+			}
+		case *ast.InlineFragment:
+			if err := checkPages(s.SelectionSet, vars); err != nil {
+				return err
+			}
+		case *ast.FragmentSpread:
+			if s.Definition == nil {
+				continue
+			}
+			if err := checkPages(s.Definition.SelectionSet, vars); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pageSize — first или last из аргументов поля. Литералы приходят как
+// int64, переменные — как json.Number или float64.
+func pageSize(args map[string]any) (int, bool) {
+	for _, name := range []string{"first", "last"} {
+		switch v := args[name].(type) {
+		case int:
+			return v, true
+		case int64:
+			return int(v), true
+		case float64:
+			return int(v), true
+		case json.Number:
+			n, err := v.Int64()
+			if err != nil {
+				return 0, false
+			}
+			return int(n), true
+		}
+	}
+	return 0, false
+}
+
+// presentError переводит ошибки резолверов в ответ: доменные — с кодом
+// apperr, остальные не раскрываются клиенту и пишутся в лог.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gerr := graphql.DefaultErrorPresenter(ctx, err)
+	if e, ok := apperr.As(err); ok {
+		gerr.Message = e.Message
+		gerr.Extensions = map[string]any{"code": e.Code}
+		return gerr
+	}
+	if ent.IsNotFound(err) {
+		gerr.Message = "not found"
+		gerr.Extensions = map[string]any{"code": codeNotFound}
+		return gerr
+	}
+	if gerr.Err == nil {
+		// ошибка разбора, валидации или ограничений запроса
+		return gerr
+	}
+	log.Printf("graphql: %v", err)
+	gerr.Message = "internal error"
+	gerr.Extensions = map[string]any{"code": codeInternal}
+	return gerr
+}
@@ -0,0 +1,164 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	projectuc "project-manager-dashboard-go/internal/app/usecase/project"
+)
+
+// failingProjects отвечает на Update заданной ошибкой; остальные методы
+// в тесте не вызываются.
+type failingProjects struct {
+	projectuc.ProjectService
+	err error
+}
+
+func (f failingProjects) Update(context.Context, uuid.UUID, projectuc.UpdateInput) (projectuc.ProjectDTO, error) {
+	return projectuc.ProjectDTO{}, f.err
+}
+
+type gqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+// do выполняет запрос. Все проверяемые запросы отклоняются до обращения
+// к базе, поэтому клиент ent не нужен.
+func do(t *testing.T, h stdhttp.Handler, ctx context.Context, query string, vars map[string]any) gqlResponse {
+	t.Helper()
+	body, err := json.Marshal(map[string]any{"query": query, "variables": vars})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest("POST", "/graphql", bytes.NewReader(body)).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var out gqlResponse
+	if err := json.NewDecoder(rec.Body).Decode(&out); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	return out
+}
+
+func TestHandlerRejectsQueries(t *testing.T) {
+	h := NewHandler(nil, nil, nil, 1000)
+
+	tests := []struct {
+		name     string
+		query    string
+		vars     map[string]any
+		wantCode string
+	}{
+		{
+			name:     "no first or last",
+			query:    `{ projects { totalCount } }`,
+			wantCode: codePageLimit,
+		},
+		{
+			name:     "first above max",
+			query:    `{ projects(first: 101) { totalCount } }`,
+			wantCode: codePageLimit,
+		},
+		{
+			name:     "first above max in variable",
+			query:    `query($n: Int) { projects(first: $n) { totalCount } }`,
+			vars:     map[string]any{"n": 200},
+			wantCode: codePageLimit,
+		},
+		{
+			name:     "nested connection without first",
+			query:    `{ projects(first: 10) { edges { node { projectTasks { totalCount } } } } }`,
+			wantCode: codePageLimit,
+		},
+		{
+			name: "nested connection in fragment",
+			query: `{ projects(last: 10) { edges { node { ...P } } } }
+				fragment P on Project { milestones { totalCount } }`,
+			wantCode: codePageLimit,
+		},
+		{
+			name:     "too complex",
+			query:    `{ projects(first: 100) { edges { node { projectTasks(first: 100) { edges { node { id } } } } } } }`,
+			wantCode: "COMPLEXITY_LIMIT_EXCEEDED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := do(t, h, context.Background(), tt.query, tt.vars)
+			if len(out.Errors) != 1 {
+				t.Fatalf("errors = %+v, want one", out.Errors)
+			}
+			if got := out.Errors[0].Extensions["code"]; got != tt.wantCode {
+				t.Errorf("code = %v, want %s (%s)", got, tt.wantCode, out.Errors[0].Message)
+			}
+		})
+	}
+}
+
+func TestHandlerMutationErrors(t *testing.T) {
+	id := uuid.New()
+	withActor := audit.WithActor(context.Background(), uuid.New())
+
+	tests := []struct {
+		name        string
+		err         error
+		ctx         context.Context
+		query       string
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name:        "no actor",
+			ctx:         context.Background(),
+			query:       `mutation($id: ID!) { deleteProject(id: $id) }`,
+			wantCode:    "invalid_request",
+			wantMessage: "X-Actor-ID header is required",
+		},
+		{
+			name:        "domain error",
+			err:         projectuc.ErrVersionConflict,
+			ctx:         withActor,
+			query:       `mutation($id: ID!) { updateProject(id: $id, input: {name: "x", expectedVersion: 1}) { id } }`,
+			wantCode:    "version_mismatch",
+			wantMessage: "project version conflict",
+		},
+		{
+			name:        "unknown error",
+			err:         errors.New("pq: connection refused"),
+			ctx:         withActor,
+			query:       `mutation($id: ID!) { updateProject(id: $id, input: {name: "x"}) { id } }`,
+			wantCode:    codeInternal,
+			wantMessage: "internal error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHandler(nil, failingProjects{err: tt.err}, nil, 1000)
+			out := do(t, h, tt.ctx, tt.query, map[string]any{"id": id})
+			if len(out.Errors) != 1 {
+				t.Fatalf("errors = %+v, want one", out.Errors)
+			}
+			e := out.Errors[0]
+			if got := e.Extensions["code"]; got != tt.wantCode {
+				t.Errorf("code = %v, want %s", got, tt.wantCode)
+			}
+			if e.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", e.Message, tt.wantMessage)
+			}
+		})
+	}
+}
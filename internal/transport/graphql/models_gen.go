@@ -0,0 +1,39 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graphql
+
+import (
+	"project-manager-dashboard-go/ent/task"
+	"time"
+)
+
+type CreateProjectInput struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+type CreateTaskInput struct {
+	Title       string       `json:"title"`
+	Description *string      `json:"description,omitempty"`
+	Status      *task.Status `json:"status,omitempty"`
+	DueDate     *time.Time   `json:"dueDate,omitempty"`
+}
+
+// expectedVersion — как If-Match в REST: при несовпадении версии мутация
+// отклоняется с кодом version_mismatch.
+type UpdateProjectInput struct {
+	Name            *string `json:"name,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	ExpectedVersion *int    `json:"expectedVersion,omitempty"`
+}
+
+type UpdateTaskInput struct {
+	Title       *string      `json:"title,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Status      *task.Status `json:"status,omitempty"`
+	Position    *int         `json:"position,omitempty"`
+	DueDate     *time.Time   `json:"dueDate,omitempty"`
+	// снять срок; dueDate при этом игнорируется
+	ClearDueDate    *bool `json:"clearDueDate,omitempty"`
+	ExpectedVersion *int  `json:"expectedVersion,omitempty"`
+}
@@ -0,0 +1,106 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/enttest"
+	_ "project-manager-dashboard-go/ent/runtime"
+)
+
+// newTestClient — ent поверх sqlite в памяти: запросы GraphQL идут
+// напрямую в ent, use case им не нужны.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestHandlerQueriesWithoutActor(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	alpha := client.Project.Create().SetName("Alpha").SaveX(ctx)
+	client.Project.Create().SetName("Beta").SaveX(ctx)
+	client.Project.Create().SetName("Gamma").SetDeletedAt(time.Now()).SaveX(ctx)
+	for _, title := range []string{"write spec", "review spec", "ship"} {
+		tk := client.Task.Create().SetTitle(title).SaveX(ctx)
+		client.ProjectTask.Create().SetProject(alpha).SetTask(tk).SaveX(ctx)
+	}
+
+	h := NewHandler(client, nil, nil, 1000)
+
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]any
+		want  string
+	}{
+		{
+			name:  "connection skips trashed",
+			query: `{ projects(first: 10, orderBy: {field: NAME}) { totalCount edges { node { name } } } }`,
+			want:  `{"projects":{"edges":[{"node":{"name":"Alpha"}},{"node":{"name":"Beta"}}],"totalCount":2}}`,
+		},
+		{
+			name:  "where filter",
+			query: `{ tasks(first: 10, where: {titleContains: "spec"}) { totalCount } }`,
+			want:  `{"tasks":{"totalCount":2}}`,
+		},
+		{
+			name:  "page of nested connection",
+			query: `{ projects(first: 1, where: {name: "Alpha"}) { edges { node { projectTasks(first: 2) { totalCount edges { node { id } } pageInfo { hasNextPage } } } } } }`,
+			want:  `{"projects":{"edges":[{"node":{"projectTasks":{"edges":[{"node":{"id":"*"}},{"node":{"id":"*"}}],"pageInfo":{"hasNextPage":true},"totalCount":3}}}]}}`,
+		},
+		{
+			name:  "node by id",
+			query: `query($id: ID!) { node(id: $id) { ... on Project { name } } }`,
+			vars:  map[string]any{"id": alpha.ID},
+			want:  `{"node":{"name":"Alpha"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := do(t, h, context.Background(), tt.query, tt.vars)
+			if len(out.Errors) != 0 {
+				t.Fatalf("errors = %+v", out.Errors)
+			}
+			if got := string(maskIDs(out.Data)); got != tt.want {
+				t.Errorf("data = %s\nwant   %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// maskIDs заменяет значения полей id на "*": они случайные.
+func maskIDs(data json.RawMessage) []byte {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return data
+	}
+	var walk func(any)
+	walk = func(v any) {
+		switch x := v.(type) {
+		case map[string]any:
+			for k, e := range x {
+				if k == "id" {
+					x[k] = "*"
+					continue
+				}
+				walk(e)
+			}
+		case []any:
+			for _, e := range x {
+				walk(e)
+			}
+		}
+	}
+	walk(v)
+	out, _ := json.Marshal(v)
+	return out
+}
@@ -0,0 +1,181 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.68
+
+import (
+	"context"
+	"project-manager-dashboard-go/ent"
+	"project-manager-dashboard-go/ent/taskassignee"
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	projectuc "project-manager-dashboard-go/internal/app/usecase/project"
+	taskuc "project-manager-dashboard-go/internal/app/usecase/task"
+
+	"github.com/google/uuid"
+)
+
+// CreateProject is the resolver for the createProject field.
+func (r *mutationResolver) CreateProject(ctx context.Context, input CreateProjectInput) (*ent.Project, error) {
+	ownerID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := r.projects.Create(ctx, projectuc.CreateInput{
+		Name:        input.Name,
+		Description: input.Description,
+		OwnerID:     ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.project(ctx, p.ID)
+}
+
+// UpdateProject is the resolver for the updateProject field.
+func (r *mutationResolver) UpdateProject(ctx context.Context, id uuid.UUID, input UpdateProjectInput) (*ent.Project, error) {
+	p, err := r.projects.Update(ctx, id, projectuc.UpdateInput{
+		Name:            input.Name,
+		Description:     input.Description,
+		ExpectedVersion: input.ExpectedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.project(ctx, p.ID)
+}
+
+// DeleteProject is the resolver for the deleteProject field.
+func (r *mutationResolver) DeleteProject(ctx context.Context, id uuid.UUID, expectedVersion *int) (uuid.UUID, error) {
+	actorID, err := actor(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := r.projects.Delete(ctx, id, actorID, expectedVersion); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// ArchiveProject is the resolver for the archiveProject field.
+func (r *mutationResolver) ArchiveProject(ctx context.Context, id uuid.UUID) (*ent.Project, error) {
+	actorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.projects.Archive(ctx, id, actorID); err != nil {
+		return nil, err
+	}
+	return r.project(ctx, id)
+}
+
+// UnarchiveProject is the resolver for the unarchiveProject field.
+func (r *mutationResolver) UnarchiveProject(ctx context.Context, id uuid.UUID) (*ent.Project, error) {
+	actorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.projects.Unarchive(ctx, id, actorID); err != nil {
+		return nil, err
+	}
+	return r.project(ctx, id)
+}
+
+// InviteMember is the resolver for the inviteMember field.
+func (r *mutationResolver) InviteMember(ctx context.Context, projectID uuid.UUID, userID uuid.UUID) (*ent.Project, error) {
+	inviterID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.projects.Invite(ctx, projectID, inviterID, userID); err != nil {
+		return nil, err
+	}
+	return r.project(ctx, projectID)
+}
+
+// CreateTask is the resolver for the createTask field.
+func (r *mutationResolver) CreateTask(ctx context.Context, projectID uuid.UUID, input CreateTaskInput) (*ent.Task, error) {
+	in := taskuc.CreateInput{
+		Title:       input.Title,
+		Description: input.Description,
+		DueDate:     input.DueDate,
+	}
+	if input.Status != nil {
+		in.Status = string(*input.Status)
+	}
+	if creatorID, ok := audit.ActorFrom(ctx); ok {
+		in.CreatorID = &creatorID
+	}
+	t, err := r.tasks.CreateInProject(ctx, projectID, in)
+	if err != nil {
+		return nil, err
+	}
+	return r.task(ctx, t.ID)
+}
+
+// UpdateTask is the resolver for the updateTask field.
+func (r *mutationResolver) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTaskInput) (*ent.Task, error) {
+	in := taskuc.UpdateInput{
+		Title:           input.Title,
+		Description:     input.Description,
+		Position:        input.Position,
+		DueDate:         input.DueDate,
+		ClearDueDate:    input.ClearDueDate != nil && *input.ClearDueDate,
+		ExpectedVersion: input.ExpectedVersion,
+	}
+	if input.Status != nil {
+		s := string(*input.Status)
+		in.Status = &s
+	}
+	t, err := r.tasks.Update(ctx, id, in)
+	if err != nil {
+		return nil, err
+	}
+	return r.task(ctx, t.ID)
+}
+
+// DeleteTask is the resolver for the deleteTask field.
+func (r *mutationResolver) DeleteTask(ctx context.Context, id uuid.UUID, expectedVersion *int) (uuid.UUID, error) {
+	actorID, err := actor(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if err := r.tasks.Delete(ctx, id, actorID, expectedVersion); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// AssignTask is the resolver for the assignTask field.
+func (r *mutationResolver) AssignTask(ctx context.Context, taskID uuid.UUID, userID uuid.UUID, role *taskassignee.Role) (*ent.Task, error) {
+	actorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rs *string
+	if role != nil {
+		s := role.String()
+		rs = &s
+	}
+	if err := r.tasks.Assign(ctx, taskID, actorID, userID, rs); err != nil {
+		return nil, err
+	}
+	return r.task(ctx, taskID)
+}
+
+// UnassignTask is the resolver for the unassignTask field.
+func (r *mutationResolver) UnassignTask(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) (*ent.Task, error) {
+	actorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.tasks.Unassign(ctx, taskID, actorID, userID); err != nil {
+		return nil, err
+	}
+	return r.task(ctx, taskID)
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/user"
+	"project-manager-dashboard-go/internal/transport/grpc/pb"
+)
+
+// actorHeader — ключ метаданных с автором запроса, аналог X-Actor-ID.
+const actorHeader = "x-actor-id"
+
+// publicMethods доступны без автора: без них не создать первого пользователя.
+var publicMethods = map[string]bool{
+	pb.UserService_CreateUser_FullMethodName: true,
+}
+
+// Auth проверяет автора из метаданных и кладёт его в контекст так же,
+// как auditContext в HTTP API, чтобы журнал и правила доступа use case
+// работали одинаково для обоих транспортов.
+type Auth struct {
+	users user.UserRepository
+}
+
+func NewAuth(users user.UserRepository) *Auth {
+	return &Auth{users: users}
+}
+
+func (a *Auth) Unary(ctx context.Context, req any, info *ggrpc.UnaryServerInfo, handler ggrpc.UnaryHandler) (any, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *Auth) Stream(srv any, ss ggrpc.ServerStream, info *ggrpc.StreamServerInfo, handler ggrpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+}
+
+func (a *Auth) authenticate(ctx context.Context, method string) (context.Context, error) {
+	var raw string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(actorHeader); len(v) > 0 {
+			raw = v[0]
+		}
+	}
+	if raw == "" {
+		if publicMethods[method] {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "x-actor-id is required")
+	}
+
+	actorID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid x-actor-id")
+	}
+	if _, err := a.users.GetByID(ctx, actorID); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unknown actor")
+	}
+	return audit.WithActor(ctx, actorID), nil
+}
+
+type authStream struct {
+	ggrpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// actor возвращает автора, которого положил Auth.
+func actor(ctx context.Context) (uuid.UUID, error) {
+	id, ok := audit.ActorFrom(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "x-actor-id is required")
+	}
+	return id, nil
+}
@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+	"project-manager-dashboard-go/internal/app/usecase/project"
+	"project-manager-dashboard-go/internal/app/usecase/task"
+	"project-manager-dashboard-go/internal/app/usecase/user"
+	"project-manager-dashboard-go/internal/transport/grpc/pb"
+)
+
+func toUser(u user.User) *pb.User {
+	return &pb.User{
+		Id:      u.ID.String(),
+		Email:   u.Email,
+		Name:    u.Name,
+		Country: u.Country,
+	}
+}
+
+func toMembers(in []project.ProjectMemberDTO) []*pb.Member {
+	out := make([]*pb.Member, 0, len(in))
+	for _, m := range in {
+		out = append(out, &pb.Member{
+			UserId: m.UserID.String(),
+			Name:   m.Name,
+			Email:  m.Email,
+			Role:   m.Role,
+		})
+	}
+	return out
+}
+
+func toProject(p project.ProjectDTO) *pb.Project {
+	return &pb.Project{
+		Id:          p.ID.String(),
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+		Version:     int32(p.Version),
+		ArchivedAt:  optTimestamp(p.ArchivedAt),
+		Members:     toMembers(p.Members),
+	}
+}
+
+func toTask(t task.TaskDTO) *pb.Task {
+	out := &pb.Task{
+		Id:          t.ID.String(),
+		ProjectId:   t.ProjectID.String(),
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      t.Status,
+		Position:    int32(t.Position),
+		MilestoneId: optUUID(t.MilestoneID),
+		DueDate:     optTimestamp(t.DueDate),
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		Version:     int32(t.Version),
+		Assignees:   make([]*pb.Assignee, 0, len(t.Assignees)),
+	}
+	for _, a := range t.Assignees {
+		out.Assignees = append(out.Assignees, &pb.Assignee{
+			UserId: a.UserID.String(),
+			Name:   a.Name,
+			Email:  a.Email,
+			Role:   a.Role,
+		})
+	}
+	return out
+}
+
+func optTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func optUUID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+func optInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+func toPageParams(limit, offset int32, token string, total bool) page.Params {
+	return page.Params{
+		Limit:  int(limit),
+		Offset: int(offset),
+		Cursor: token,
+		Total:  total,
+	}.Normalize()
+}
+
+func totalSize(n *int) *int32 {
+	if n == nil {
+		return nil
+	}
+	v := int32(*n)
+	return &v
+}
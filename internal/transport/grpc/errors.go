@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"project-manager-dashboard-go/internal/app/usecase/apperr"
+	"project-manager-dashboard-go/internal/app/usecase/project"
+	"project-manager-dashboard-go/internal/app/usecase/task"
+	"project-manager-dashboard-go/internal/app/usecase/user"
+)
+
+// toStatus переводит ошибки use case в коды gRPC по классу доменной
+// ошибки, как и HTTP-обработчики; прочие ошибки — Internal, как 500.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	e, ok := apperr.As(err)
+	if !ok {
+		log.Printf("grpc: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+	switch {
+	case errors.Is(err, project.ErrAlreadyMember),
+		errors.Is(err, task.ErrAlreadyAssigned),
+		errors.Is(err, user.ErrEmailTaken):
+		return status.Error(codes.AlreadyExists, e.Message)
+	case e.Kind == apperr.KindNotFound:
+		return status.Error(codes.NotFound, e.Message)
+	case e.Kind == apperr.KindForbidden:
+		return status.Error(codes.PermissionDenied, e.Message)
+	case e.Kind == apperr.KindConflict, e.Kind == apperr.KindPrecondition:
+		return status.Error(codes.FailedPrecondition, e.Message)
+	default:
+		return status.Error(codes.InvalidArgument, e.Message)
+	}
+}
+
+func parseID(s, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s", name)
+	}
+	return id, nil
+}
@@ -0,0 +1,6 @@
+// Package pb — сгенерированный код gRPC API. После правки pm.proto:
+//
+//	go generate ./internal/transport/grpc/pb
+package pb
+
+//go:generate protoc -I . --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative pm.proto
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+
+	"project-manager-dashboard-go/internal/app/usecase/project"
+	"project-manager-dashboard-go/internal/transport/grpc/pb"
+)
+
+type ProjectServer struct {
+	pb.UnimplementedProjectServiceServer
+	uc project.ProjectService
+}
+
+func NewProjectServer(uc project.ProjectService) *ProjectServer {
+	return &ProjectServer{uc: uc}
+}
+
+func (s *ProjectServer) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	res, err := s.uc.List(ctx, project.ListFilter{Archived: req.Archived}, project.Include{},
+		toPageParams(req.GetLimit(), req.GetOffset(), req.GetPageToken(), req.GetIncludeTotal()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := &pb.ListProjectsResponse{
+		Projects:      make([]*pb.Project, 0, len(res.Items)),
+		NextPageToken: res.Next,
+		PrevPageToken: res.Prev,
+		TotalSize:     totalSize(res.Total),
+	}
+	for _, p := range res.Items {
+		out.Projects = append(out.Projects, toProject(p))
+	}
+	return out, nil
+}
+
+func (s *ProjectServer) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.Project, error) {
+	ownerID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.uc.Create(ctx, project.CreateInput{
+		Name:        req.GetName(),
+		Description: req.Description,
+		OwnerID:     ownerID,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProject(p), nil
+}
+
+func (s *ProjectServer) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.Project, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.uc.GetByID(ctx, id, allMembers)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProject(p), nil
+}
+
+func (s *ProjectServer) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.Project, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.uc.Update(ctx, id, project.UpdateInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		ExpectedVersion: optInt(req.ExpectedVersion),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProject(p), nil
+}
+
+func (s *ProjectServer) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+	actorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.Delete(ctx, id, actorID, optInt(req.ExpectedVersion)); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteProjectResponse{}, nil
+}
+
+// allMembers — в gRPC-ответах проект несёт всех участников и без задач.
+var allMembers = project.Include{Members: true, Limit: -1}
+
+type MembershipServer struct {
+	pb.UnimplementedMembershipServiceServer
+	uc project.ProjectService
+}
+
+func NewMembershipServer(uc project.ProjectService) *MembershipServer {
+	return &MembershipServer{uc: uc}
+}
+
+func (s *MembershipServer) ListMembers(ctx context.Context, req *pb.ListMembersRequest) (*pb.ListMembersResponse, error) {
+	id, err := parseID(req.GetProjectId(), "project_id")
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.uc.GetByID(ctx, id, allMembers)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ListMembersResponse{Members: toMembers(p.Members)}, nil
+}
+
+func (s *MembershipServer) AddMember(ctx context.Context, req *pb.AddMemberRequest) (*pb.AddMemberResponse, error) {
+	projectID, err := parseID(req.GetProjectId(), "project_id")
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(req.GetUserId(), "user_id")
+	if err != nil {
+		return nil, err
+	}
+	inviterID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.Invite(ctx, projectID, inviterID, userID); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.AddMemberResponse{}, nil
+}
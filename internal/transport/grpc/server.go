@@ -0,0 +1,31 @@
+// Package grpc — gRPC API поверх тех же use case, что и HTTP API.
+package grpc
+
+import (
+	ggrpc "google.golang.org/grpc"
+
+	"project-manager-dashboard-go/internal/app/usecase/board"
+	"project-manager-dashboard-go/internal/app/usecase/project"
+	"project-manager-dashboard-go/internal/app/usecase/task"
+	"project-manager-dashboard-go/internal/app/usecase/user"
+	"project-manager-dashboard-go/internal/transport/grpc/pb"
+)
+
+func NewServer(
+	users user.UserRepository,
+	projects project.ProjectService,
+	tasks task.TaskService,
+	boards board.BoardService,
+) *ggrpc.Server {
+	auth := NewAuth(users)
+	s := ggrpc.NewServer(
+		ggrpc.ChainUnaryInterceptor(auth.Unary),
+		ggrpc.ChainStreamInterceptor(auth.Stream),
+	)
+
+	pb.RegisterUserServiceServer(s, NewUserServer(users))
+	pb.RegisterProjectServiceServer(s, NewProjectServer(projects))
+	pb.RegisterMembershipServiceServer(s, NewMembershipServer(projects))
+	pb.RegisterTaskServiceServer(s, NewTaskServer(tasks, boards))
+	return s
+}
@@ -0,0 +1,256 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"project-manager-dashboard-go/internal/app/usecase/board"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+	"project-manager-dashboard-go/internal/app/usecase/task"
+	"project-manager-dashboard-go/internal/transport/grpc/pb"
+)
+
+// taskAssignees — в pb.Task есть исполнители, но нет наблюдателей.
+var taskAssignees = task.Include{Assignees: true}
+
+type TaskServer struct {
+	pb.UnimplementedTaskServiceServer
+	uc     task.TaskService
+	boards board.BoardService
+}
+
+func NewTaskServer(uc task.TaskService, boards board.BoardService) *TaskServer {
+	return &TaskServer{uc: uc, boards: boards}
+}
+
+func (s *TaskServer) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	projectID, err := parseID(req.GetProjectId(), "project_id")
+	if err != nil {
+		return nil, err
+	}
+	var f task.ListFilter
+	if req.AssigneeId != nil {
+		id, err := parseID(req.GetAssigneeId(), "assignee_id")
+		if err != nil {
+			return nil, err
+		}
+		f.AssigneeID = &id
+	}
+
+	res, err := s.uc.ListByProject(ctx, projectID, f, taskAssignees,
+		toPageParams(req.GetLimit(), req.GetOffset(), req.GetPageToken(), req.GetIncludeTotal()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := &pb.ListTasksResponse{
+		Tasks:         make([]*pb.Task, 0, len(res.Items)),
+		NextPageToken: res.Next,
+		PrevPageToken: res.Prev,
+		TotalSize:     totalSize(res.Total),
+	}
+	for _, t := range res.Items {
+		out.Tasks = append(out.Tasks, toTask(t))
+	}
+	return out, nil
+}
+
+func (s *TaskServer) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.Task, error) {
+	projectID, err := parseID(req.GetProjectId(), "project_id")
+	if err != nil {
+		return nil, err
+	}
+	creatorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in := task.CreateInput{
+		Title:       req.GetTitle(),
+		Description: req.Description,
+		Status:      req.GetStatus(),
+		CreatorID:   &creatorID,
+	}
+	if in.Status == "" {
+		in.Status = "todo"
+	}
+	if req.DueDate != nil {
+		d := req.DueDate.AsTime()
+		in.DueDate = &d
+	}
+
+	t, err := s.uc.CreateInProject(ctx, projectID, in)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toTask(t), nil
+}
+
+func (s *TaskServer) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.Task, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.uc.GetByID(ctx, id, taskAssignees)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toTask(t), nil
+}
+
+func (s *TaskServer) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.Task, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	in := task.UpdateInput{
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          req.Status,
+		Position:        optInt(req.Position),
+		ClearDueDate:    req.GetClearDueDate(),
+		ExpectedVersion: optInt(req.ExpectedVersion),
+	}
+	if req.DueDate != nil {
+		d := req.DueDate.AsTime()
+		in.DueDate = &d
+	}
+
+	t, err := s.uc.Update(ctx, id, in)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toTask(t), nil
+}
+
+func (s *TaskServer) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+	actorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.Delete(ctx, id, actorID, optInt(req.ExpectedVersion)); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.DeleteTaskResponse{}, nil
+}
+
+func (s *TaskServer) AssignTask(ctx context.Context, req *pb.AssignTaskRequest) (*pb.AssignTaskResponse, error) {
+	taskID, err := parseID(req.GetTaskId(), "task_id")
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(req.GetUserId(), "user_id")
+	if err != nil {
+		return nil, err
+	}
+	actorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.Assign(ctx, taskID, actorID, userID, req.Role); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.AssignTaskResponse{}, nil
+}
+
+func (s *TaskServer) UnassignTask(ctx context.Context, req *pb.UnassignTaskRequest) (*pb.UnassignTaskResponse, error) {
+	taskID, err := parseID(req.GetTaskId(), "task_id")
+	if err != nil {
+		return nil, err
+	}
+	userID, err := parseID(req.GetUserId(), "user_id")
+	if err != nil {
+		return nil, err
+	}
+	actorID, err := actor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.Unassign(ctx, taskID, actorID, userID); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.UnassignTaskResponse{}, nil
+}
+
+// WatchTaskChanges отдаёт события задач проекта из той же подписки, что и
+// SSE доски: сначала пропущенные после after_seq, затем новые.
+func (s *TaskServer) WatchTaskChanges(req *pb.WatchTaskChangesRequest, stream pb.TaskService_WatchTaskChangesServer) error {
+	ctx := stream.Context()
+
+	projectID, err := parseID(req.GetProjectId(), "project_id")
+	if err != nil {
+		return err
+	}
+	actorID, err := actor(ctx)
+	if err != nil {
+		return err
+	}
+
+	sub, err := s.boards.Subscribe(ctx, projectID, actorID, req.AfterSeq)
+	if err != nil {
+		return toStatus(err)
+	}
+	defer sub.Close()
+
+	if sub.Reset {
+		if err := stream.Send(&pb.TaskChange{Reload: true}); err != nil {
+			return err
+		}
+	}
+
+	for _, ev := range sub.Replay {
+		if !sub.Fresh(ev) {
+			continue
+		}
+		if err := sendTaskChange(stream, ev); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Dropped:
+			// Клиент отстал: пусть переподключится с after_seq.
+			return status.Error(codes.ResourceExhausted, "subscriber is too slow")
+		case ev := <-sub.Events:
+			// Опоздавшее событие может прийти с меньшим номером: повторы
+			// отсекаются по ID, а не по номеру.
+			if !sub.Fresh(ev) {
+				continue
+			}
+			if err := sendTaskChange(stream, ev); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendTaskChange пропускает события, которые не относятся к задачам.
+func sendTaskChange(stream pb.TaskService_WatchTaskChangesServer, ev outbox.Event) error {
+	if ev.AggregateType != outbox.AggregateTask {
+		return nil
+	}
+	return stream.Send(&pb.TaskChange{
+		Seq:       ev.PublishedSeq,
+		EventId:   ev.ID.String(),
+		Type:      ev.Type,
+		TaskId:    ev.AggregateID.String(),
+		ActorId:   optUUID(ev.ActorID),
+		CreatedAt: timestamppb.New(ev.CreatedAt),
+		Payload:   ev.Payload,
+	})
+}
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+
+	"project-manager-dashboard-go/internal/app/usecase/user"
+	"project-manager-dashboard-go/internal/transport/grpc/pb"
+)
+
+type UserServer struct {
+	pb.UnimplementedUserServiceServer
+	uc user.UserRepository
+}
+
+func NewUserServer(uc user.UserRepository) *UserServer {
+	return &UserServer{uc: uc}
+}
+
+func (s *UserServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	res, err := s.uc.List(ctx, toPageParams(req.GetLimit(), 0, req.GetPageToken(), req.GetIncludeTotal()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := &pb.ListUsersResponse{
+		Users:         make([]*pb.User, 0, len(res.Items)),
+		NextPageToken: res.Next,
+		PrevPageToken: res.Prev,
+		TotalSize:     totalSize(res.Total),
+	}
+	for _, u := range res.Items {
+		out.Users = append(out.Users, toUser(u))
+	}
+	return out, nil
+}
+
+func (s *UserServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	u, err := s.uc.Create(ctx, user.CreateUserInput{
+		Email:   req.GetEmail(),
+		Name:    req.GetName(),
+		Country: req.GetCountry(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toUser(u), nil
+}
+
+func (s *UserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.uc.GetByID(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toUser(u), nil
+}
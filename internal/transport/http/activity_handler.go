@@ -0,0 +1,46 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+func (h *TaskHandler) Activity(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid task id")
+		return
+	}
+
+	p := parsePage(r)
+
+	res, err := h.uc.Activity(r.Context(), taskID, p)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.TaskActivityResponse, 0, len(res.Items))
+	for _, a := range res.Items {
+		var diff []dto.ActivityDiffLine
+		for _, l := range a.Diff {
+			diff = append(diff, dto.ActivityDiffLine{Op: l.Op, Text: l.Text})
+		}
+		out = append(out, dto.TaskActivityResponse{
+			ID:        a.ID,
+			Kind:      a.Kind,
+			ActorID:   a.ActorID,
+			ActorName: a.ActorName,
+			From:      a.From,
+			To:        a.To,
+			Message:   a.Message,
+			Diff:      diff,
+			CreatedAt: a.CreatedAt,
+		})
+	}
+	writePage(w, r, res, out)
+}
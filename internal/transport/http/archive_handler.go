@@ -0,0 +1,61 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/project"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+func (h *ProjectHandler) ArchiveProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	h.setArchived(w, r, h.uc.Archive)
+}
+
+func (h *ProjectHandler) UnarchiveProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	h.setArchived(w, r, h.uc.Unarchive)
+}
+
+func (h *ProjectHandler) setArchived(
+	w stdhttp.ResponseWriter,
+	r *stdhttp.Request,
+	apply func(ctx context.Context, projectID, actorID uuid.UUID) (project.ProjectDTO, error),
+) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	var req dto.ArchiveProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx := audit.WithActor(r.Context(), actorID)
+
+	p, err := apply(ctx, projectID, actorID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, dto.ProjectResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		ArchivedAt:  p.ArchivedAt,
+	})
+}
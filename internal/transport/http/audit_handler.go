@@ -0,0 +1,130 @@
+package http
+
+import (
+	stdhttp "net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+type AuditHandler struct {
+	uc audit.AuditService
+}
+
+func NewAuditHandler(uc audit.AuditService) *AuditHandler {
+	return &AuditHandler{uc: uc}
+}
+
+func (h *AuditHandler) ListByProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	f, ok := parseAuditQuery(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.uc.ListByProject(r.Context(), projectID, f, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writePage(w, r, res, toAuditResponses(res.Items))
+}
+
+func (h *AuditHandler) ListByEntity(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	entityID, err := uuid.Parse(chi.URLParam(r, "entityId"))
+	if err != nil {
+		writeBadRequest(w, "invalid entity id")
+		return
+	}
+
+	f, ok := parseAuditQuery(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.uc.ListByEntity(r.Context(), chi.URLParam(r, "entityType"), entityID, f, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writePage(w, r, res, toAuditResponses(res.Items))
+}
+
+// parseAuditQuery разбирает ?actor=<userId>&from=<RFC3339>&to=<RFC3339>.
+func parseAuditQuery(w stdhttp.ResponseWriter, r *stdhttp.Request) (audit.Filter, bool) {
+	var f audit.Filter
+	q := r.URL.Query()
+
+	if s := q.Get("actor"); s != "" {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			writeBadRequest(w, "invalid actor")
+			return f, false
+		}
+		f.ActorID = &id
+	}
+	if s := q.Get("from"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			writeBadRequest(w, "invalid from")
+			return f, false
+		}
+		f.From = &t
+	}
+	if s := q.Get("to"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			writeBadRequest(w, "invalid to")
+			return f, false
+		}
+		f.To = &t
+	}
+
+	return f, true
+}
+
+func toAuditResponses(items []audit.EventDTO) []dto.AuditEventResponse {
+	out := make([]dto.AuditEventResponse, 0, len(items))
+	for _, e := range items {
+		out = append(out, dto.AuditEventResponse{
+			ID:         e.ID,
+			ActorID:    e.ActorID,
+			EntityType: e.EntityType,
+			EntityID:   e.EntityID,
+			Operation:  e.Operation,
+			Changes:    e.Changes,
+			RequestID:  e.RequestID,
+			ProjectID:  e.ProjectID,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+	return out
+}
+
+// auditContext переносит в контекст request ID и, если клиент передал
+// заголовок X-Actor-ID, автора изменений. Обработчики, получающие actorId
+// в теле запроса, уточняют автора сами.
+func auditContext(next stdhttp.Handler) stdhttp.Handler {
+	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		ctx := r.Context()
+		if id := middleware.GetReqID(ctx); id != "" {
+			ctx = audit.WithRequestID(ctx, id)
+		}
+		if s := r.Header.Get("X-Actor-ID"); s != "" {
+			if id, err := uuid.Parse(s); err == nil {
+				ctx = audit.WithActor(ctx, id)
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	stdhttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/board"
+	"project-manager-dashboard-go/internal/app/usecase/outbox"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+	"project-manager-dashboard-go/internal/transport/http/openapi"
+)
+
+const (
+	// boardKeepAlive — пауза между комментариями, которые не дают прокси
+	// закрыть простаивающее соединение.
+	boardKeepAlive = 25 * time.Second
+	// boardRetry — через сколько браузер переподключается после обрыва.
+	boardRetry = 3 * time.Second
+)
+
+type BoardHandler struct {
+	uc board.BoardService
+}
+
+func NewBoardHandler(uc board.BoardService) *BoardHandler {
+	return &BoardHandler{uc: uc}
+}
+
+// Stream: GET /projects/{id}/events — Server-Sent Events доски проекта.
+// Автор — из X-Actor-ID или ?actorId= (EventSource не умеет заголовки),
+// продолжение — с Last-Event-ID или ?lastEventId=.
+func (h *BoardHandler) Stream(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	actorID, ok := audit.ActorFrom(ctx)
+	if s := r.URL.Query().Get("actorId"); s != "" {
+		actorID, err = uuid.Parse(s)
+		if err != nil {
+			writeBadRequest(w, "invalid actorId")
+			return
+		}
+		ok = true
+	}
+	if !ok {
+		writeBadRequest(w, "actorId is required")
+		return
+	}
+
+	var lastSeq *int64
+	last := r.Header.Get("Last-Event-ID")
+	if last == "" {
+		last = r.URL.Query().Get("lastEventId")
+	}
+	if last != "" {
+		v, err := strconv.ParseInt(last, 10, 64)
+		if err != nil {
+			writeBadRequest(w, "invalid Last-Event-ID")
+			return
+		}
+		lastSeq = &v
+	}
+
+	flusher, ok := w.(stdhttp.Flusher)
+	if !ok {
+		openapi.WriteProblem(w, openapi.NewProblem(stdhttp.StatusInternalServerError, codeInternal, "streaming unsupported"))
+		return
+	}
+
+	sub, err := h.uc.Subscribe(ctx, projectID, actorID, lastSeq)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(stdhttp.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", boardRetry.Milliseconds()); err != nil {
+		return
+	}
+	if sub.Reset {
+		if _, err := fmt.Fprint(w, "event: reset\ndata: {}\n\n"); err != nil {
+			return
+		}
+	}
+
+	for _, ev := range sub.Replay {
+		if !sub.Fresh(ev) {
+			continue
+		}
+		if err := writeBoardEvent(w, ev); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(boardKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sub.Dropped:
+			// Подписчик отстал: закрываем поток, клиент продолжит с Last-Event-ID.
+			return
+		case ev := <-sub.Events:
+			if !sub.Fresh(ev) {
+				continue
+			}
+			if err := writeBoardEvent(w, ev); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeBoardEvent(w stdhttp.ResponseWriter, ev outbox.Event) error {
+	data, err := json.Marshal(dto.BoardEventResponse{
+		ID:        ev.ID,
+		Type:      ev.Type,
+		CreatedAt: ev.CreatedAt,
+		ActorID:   ev.ActorID,
+		Data:      ev.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.PublishedSeq, ev.Type, data)
+	return err
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditEventResponse struct {
+	ID         uuid.UUID      `json:"id"`
+	ActorID    *uuid.UUID     `json:"actorId,omitempty"`
+	EntityType string         `json:"entityType"`
+	EntityID   uuid.UUID      `json:"entityId"`
+	Operation  string         `json:"operation"`
+	Changes    map[string]any `json:"changes,omitempty"`
+	RequestID  *string        `json:"requestId,omitempty"`
+	ProjectID  *uuid.UUID     `json:"projectId,omitempty"`
+	CreatedAt  time.Time      `json:"createdAt"`
+}
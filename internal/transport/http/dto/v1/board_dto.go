@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardEventResponse — поле data события в потоке /projects/{id}/events.
+type BoardEventResponse struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	ActorID   *uuid.UUID      `json:"actorId,omitempty"`
+	Data      json.RawMessage `json:"data"`
+}
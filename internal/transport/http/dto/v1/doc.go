@@ -0,0 +1,6 @@
+// Package v1 — тела запросов и ответов API /v1.
+//
+// Формы отсюда не меняются несовместимо: переименование или удаление поля
+// — это новая версия API с собственным пакетом dto/v2 рядом, а обработчики
+// /v1 продолжают отдавать эти типы.
+package v1
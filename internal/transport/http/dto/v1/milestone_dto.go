@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateMilestoneRequest struct {
+	ActorID     string     `json:"actorId" format:"uuid"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	TargetDate  *time.Time `json:"targetDate,omitempty"`
+}
+
+type UpdateMilestoneRequest struct {
+	ActorID     string     `json:"actorId" format:"uuid"`
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Status      *string    `json:"status,omitempty" enum:"open,closed"`
+	TargetDate  *time.Time `json:"targetDate,omitempty"`
+	// снять срок; targetDate при этом игнорируется
+	ClearTargetDate bool `json:"clearTargetDate,omitempty"`
+}
+
+type DeleteMilestoneRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type MilestoneTaskRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+	TaskID  string `json:"taskId" format:"uuid"`
+}
+
+type MilestoneResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	ProjectID   uuid.UUID  `json:"projectId"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	Status      string     `json:"status"`
+	TargetDate  *time.Time `json:"targetDate,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+type MilestoneSummaryResponse struct {
+	Milestone     MilestoneResponse `json:"milestone"`
+	Total         int               `json:"total"`
+	TasksByStatus map[string]int    `json:"tasksByStatus"`
+	Completion    int               `json:"completion"`
+	OnTrack       bool              `json:"onTrack"`
+	Overdue       bool              `json:"overdue"`
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	Type      string     `json:"type"`
+	Message   string     `json:"message"`
+	ActorID   *uuid.UUID `json:"actorId,omitempty"`
+	ProjectID *uuid.UUID `json:"projectId,omitempty"`
+	TaskID    *uuid.UUID `json:"taskId,omitempty"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"readAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type MarkNotificationRequest struct {
+	ActorID string `json:"actorId" format:"uuid"` // владелец уведомления
+}
+
+type MarkAllReadResponse struct {
+	Updated int `json:"updated"`
+}
+
+type NotificationPreferenceResponse struct {
+	Type  string `json:"type"`
+	InApp bool   `json:"inApp"`
+	Email bool   `json:"email"`
+}
+
+type NotificationSettingsResponse struct {
+	EmailMode string                           `json:"emailMode"` // "instant" | "daily" | "off"
+	Types     []NotificationPreferenceResponse `json:"types"`
+}
+
+// UpdateNotificationPreferencesRequest: тип уведомления -> включено.
+// Непереданные поля и типы не меняются.
+type UpdateNotificationPreferencesRequest struct {
+	EmailMode *string         `json:"emailMode,omitempty" enum:"instant,daily,off"`
+	InApp     map[string]bool `json:"inApp,omitempty"`
+	Email     map[string]bool `json:"email,omitempty"`
+}
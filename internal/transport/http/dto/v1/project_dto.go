@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeleteProjectRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type ArchiveProjectRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type RestoreProjectRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type CreateProjectRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	UserID      string  `json:"userId" format:"uuid"`
+}
+
+type ProjectUserResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Role  string    `json:"role"`
+}
+
+type UpdateProjectRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type ProjectResponse struct {
+	ID          uuid.UUID             `json:"id"`
+	Name        string                `json:"name"`
+	Description *string               `json:"description,omitempty"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	UpdatedAt   *time.Time            `json:"updatedAt,omitempty"`
+	Version     int                   `json:"version,omitempty"`
+	ArchivedAt  *time.Time            `json:"archivedAt,omitempty"`
+	DeletedAt   *time.Time            `json:"deletedAt,omitempty"`
+	Users       []ProjectUserResponse `json:"users"`
+	Tasks       []TaskResponse        `json:"tasks"`
+
+	// Курсоры продолжения включённых коллекций: для
+	// GET /projects/{id}/members и GET /projects/{id}/tasks.
+	UsersNext string `json:"usersNextCursor,omitempty"`
+	TasksNext string `json:"tasksNextCursor,omitempty"`
+}
+
+type InviteUserRequest struct {
+	InviterID string `json:"inviterId" format:"uuid"`
+	UserID    string `json:"userId" format:"uuid"`
+}
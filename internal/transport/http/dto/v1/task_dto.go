@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeleteTaskRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type CreateTaskRequest struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description,omitempty"`
+	Status      string  `json:"status,omitempty"`
+	DueDate     *string `json:"dueDate,omitempty"` // RFC 3339
+	ActorID     string  `json:"actorId,omitempty"` // автор задачи, становится наблюдателем
+}
+
+type TaskAssigneeResponse struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Role  *string   `json:"role,omitempty"`
+}
+
+type TaskResponse struct {
+	ID          uuid.UUID              `json:"id"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description,omitempty"`
+	Status      string                 `json:"status"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	Assignees   []TaskAssigneeResponse `json:"assignees"`
+	Position    int                    `json:"position"`
+	MilestoneID *uuid.UUID             `json:"milestoneId,omitempty"`
+	ProjectID   *uuid.UUID             `json:"projectId,omitempty"`
+	Watchers    []TaskWatcherResponse  `json:"watchers,omitempty"`
+	DeletedAt   *time.Time             `json:"deletedAt,omitempty"`
+	DueDate     *time.Time             `json:"dueDate,omitempty"`
+	Version     int                    `json:"version,omitempty"`
+}
+
+type TaskWatcherResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	WatchedAt time.Time `json:"watchedAt"`
+}
+
+type RestoreTaskRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type WatchTaskRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type UpdateTaskRequest struct {
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Status      *string `json:"status,omitempty" enum:"todo,in_progress,done"`
+	Position    *int    `json:"position,omitempty"`
+	DueDate     *string `json:"dueDate,omitempty"` // RFC 3339; "" снимает срок
+}
+
+type AssignTaskRequest struct {
+	ActorID string  `json:"actorId" format:"uuid"` // кто делает действие
+	UserID  string  `json:"userId,omitempty"`      // на кого назначаем (если пусто - на себя)
+	Role    *string `json:"role,omitempty" enum:"owner,reviewer,contributor"`
+}
+
+type UnassignTaskRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+	UserID  string `json:"userId,omitempty"` // кого снимаем (если пусто - себя)
+}
+
+type AssignmentEventResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Action     string     `json:"action"`
+	ActorID    uuid.UUID  `json:"actorId"`
+	FromUserID *uuid.UUID `json:"fromUserId"`
+	ToUserID   *uuid.UUID `json:"toUserId"`
+	Role       *string    `json:"role,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type ActivityDiffLine struct {
+	Op   string `json:"op"` // "=" | "+" | "-"
+	Text string `json:"text"`
+}
+
+type TaskActivityResponse struct {
+	ID        uuid.UUID          `json:"id"`
+	Kind      string             `json:"kind"`
+	ActorID   *uuid.UUID         `json:"actorId,omitempty"`
+	ActorName *string            `json:"actorName,omitempty"`
+	From      *string            `json:"from,omitempty"`
+	To        *string            `json:"to,omitempty"`
+	Message   string             `json:"message"`
+	Diff      []ActivityDiffLine `json:"diff,omitempty"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
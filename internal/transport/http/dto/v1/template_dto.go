@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SaveTemplateRequest struct {
+	ActorID        string  `json:"actorId" format:"uuid"`
+	Name           string  `json:"name"`
+	Description    *string `json:"description,omitempty"`
+	IncludeMembers bool    `json:"includeMembers,omitempty"`
+}
+
+type CreateFromTemplateRequest struct {
+	UserID      string     `json:"userId" format:"uuid"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	StartDate   *time.Time `json:"startDate,omitempty"`
+}
+
+type CloneProjectRequest struct {
+	ActorID        string     `json:"actorId" format:"uuid"`
+	Name           *string    `json:"name,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	StartDate      *time.Time `json:"startDate,omitempty"`
+	IncludeMembers bool       `json:"includeMembers,omitempty"`
+}
+
+type DeleteTemplateRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type TemplateTaskResponse struct {
+	Title         string `json:"title"`
+	Description   string `json:"description,omitempty"`
+	Priority      string `json:"priority"`
+	Position      int    `json:"position"`
+	DueOffsetDays *int   `json:"dueOffsetDays,omitempty"`
+}
+
+type TemplateMemberResponse struct {
+	UserID uuid.UUID `json:"userId"`
+	Role   string    `json:"role"`
+}
+
+type TemplateResponse struct {
+	ID          uuid.UUID                `json:"id"`
+	Name        string                   `json:"name"`
+	Description *string                  `json:"description,omitempty"`
+	CreatedBy   uuid.UUID                `json:"createdBy"`
+	CreatedAt   time.Time                `json:"createdAt"`
+	Tasks       []TemplateTaskResponse   `json:"tasks,omitempty"`
+	Members     []TemplateMemberResponse `json:"members,omitempty"`
+}
@@ -1,4 +1,4 @@
-package dto
+package v1
 
 type UserDTO struct {
 	ID      string `json:"id" format:"uuid"`
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateWebhookRequest struct {
+	ActorID string   `json:"actorId" format:"uuid"`
+	URL     string   `json:"url"`
+	Secret  string   `json:"secret,omitempty"` // пусто — сгенерировать
+	Events  []string `json:"events"`
+}
+
+// UpdateWebhookRequest: непереданные поля не меняются. secret: "" —
+// сгенерировать новый.
+type UpdateWebhookRequest struct {
+	ActorID string   `json:"actorId" format:"uuid"`
+	URL     *string  `json:"url,omitempty"`
+	Secret  *string  `json:"secret,omitempty"`
+	Events  []string `json:"events,omitempty"`
+	Active  *bool    `json:"active,omitempty"`
+}
+
+type WebhookActorRequest struct {
+	ActorID string `json:"actorId" format:"uuid"`
+}
+
+type WebhookResponse struct {
+	ID                  uuid.UUID  `json:"id"`
+	ProjectID           uuid.UUID  `json:"projectId"`
+	URL                 string     `json:"url"`
+	Events              []string   `json:"events"`
+	Active              bool       `json:"active"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+	DisabledAt          *time.Time `json:"disabledAt,omitempty"`
+	CreatedBy           uuid.UUID  `json:"createdBy"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+
+	// Secret возвращается только при создании и смене секрета.
+	Secret string `json:"secret,omitempty"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	WebhookID     uuid.UUID       `json:"webhookId"`
+	EventID       uuid.UUID       `json:"eventId"`
+	Event         string          `json:"event"`
+	Payload       json.RawMessage `json:"payload"`
+	RedeliveryOf  *uuid.UUID      `json:"redeliveryOf,omitempty"`
+	Status        string          `json:"status"` // "pending" | "succeeded" | "failed"
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt *time.Time      `json:"nextAttemptAt,omitempty"`
+	ResponseCode  *int            `json:"responseCode,omitempty"`
+	ResponseBody  *string         `json:"responseBody,omitempty"`
+	Error         *string         `json:"error,omitempty"`
+	DurationMS    *int            `json:"durationMs,omitempty"`
+	LastAttemptAt *time.Time      `json:"lastAttemptAt,omitempty"`
+	CreatedAt     time.Time       `json:"createdAt"`
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"errors"
+	stdhttp "net/http"
+	"strconv"
+	"strings"
+)
+
+var errInvalidIfMatch = errors.New("invalid If-Match")
+
+// ETag строится из счётчика версии сущности.
+func etag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+func setETag(w stdhttp.ResponseWriter, version int) {
+	w.Header().Set("ETag", etag(version))
+}
+
+// parseIfMatch возвращает версию из заголовка If-Match. Отсутствующий
+// заголовок и "*" означают «без проверки» (nil). Поддерживается одно
+// значение; слабые ETag (W/"...") сравниваются по значению.
+func parseIfMatch(r *stdhttp.Request) (*int, error) {
+	v := strings.TrimSpace(r.Header.Get("If-Match"))
+	if v == "" || v == "*" {
+		return nil, nil
+	}
+	if strings.Contains(v, ",") {
+		return nil, errInvalidIfMatch
+	}
+
+	v = strings.TrimPrefix(v, "W/")
+	if len(v) < 2 || v[0] != '"' || v[len(v)-1] != '"' {
+		return nil, errInvalidIfMatch
+	}
+
+	n, err := strconv.Atoi(v[1 : len(v)-1])
+	if err != nil {
+		return nil, errInvalidIfMatch
+	}
+	return &n, nil
+}
@@ -0,0 +1,159 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	stdhttp "net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+// parseInclude разбирает ?include=a,b. Без параметра действует def
+// (поведение v1 для эндпоинта), пустое значение — ни одной связи.
+func parseInclude(r *stdhttp.Request, def string, allowed ...string) (map[string]bool, error) {
+	q := r.URL.Query()
+	v := def
+	if q.Has("include") {
+		v = q.Get("include")
+	}
+
+	out := make(map[string]bool)
+	for _, name := range strings.Split(v, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf("invalid include: %s", name)
+		}
+		out[name] = true
+	}
+	return out, nil
+}
+
+// parseIncludeLimit — ?includeLimit=, размер включённых коллекций. Без
+// параметра — 0, то есть лимит по умолчанию; не целое и меньше единицы —
+// ошибка.
+func parseIncludeLimit(r *stdhttp.Request) (int, error) {
+	v := r.URL.Query().Get("includeLimit")
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid includeLimit: %s", v)
+	}
+	return n, nil
+}
+
+// fieldSet — выбранные поля ответа; вложенный набор ограничивает поля
+// объекта или элементов массива, nil — поле целиком.
+type fieldSet map[string]fieldSet
+
+// parseFields разбирает ?fields=id,name,tasks.title и проверяет пути по
+// json-тегам типа ответа v (для списков — типа элемента). Без параметра
+// возвращает nil: ответ отдаётся целиком.
+func parseFields(r *stdhttp.Request, v any) (fieldSet, error) {
+	raw := r.URL.Query().Get("fields")
+	if raw == "" {
+		return nil, nil
+	}
+
+	root := reflect.TypeOf(v)
+	out := fieldSet{}
+	for _, path := range strings.Split(raw, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		t, set := root, out
+		parts := strings.Split(path, ".")
+		for i, name := range parts {
+			ft, ok := jsonField(t, name)
+			if !ok {
+				return nil, fmt.Errorf("invalid fields: %s", path)
+			}
+			t = ft
+
+			sub, seen := set[name]
+			if i == len(parts)-1 {
+				// Поле целиком поглощает ранее выбранные подполя.
+				set[name] = nil
+				break
+			}
+			if seen && sub == nil {
+				break
+			}
+			if sub == nil {
+				sub = fieldSet{}
+				set[name] = sub
+			}
+			set = sub
+		}
+	}
+	return out, nil
+}
+
+// dtoPkg — пакет типов ответа: только их поля можно выбирать вглубь.
+var dtoPkg = reflect.TypeOf(dto.ProjectResponse{}).PkgPath()
+
+// jsonField ищет у структуры t (или у элемента среза) поле с json-именем
+// name и возвращает его тип.
+func jsonField(t reflect.Type, name string) (reflect.Type, bool) {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.PkgPath() != dtoPkg {
+		return nil, false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if tag == name {
+			return f.Type, true
+		}
+	}
+	return nil, false
+}
+
+// apply оставляет в v только выбранные поля. v проходит через JSON, так
+// что имена совпадают с теми, что видит клиент.
+func (fs fieldSet) apply(v any) any {
+	if fs == nil {
+		return v
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return v
+	}
+	return fs.prune(out)
+}
+
+func (fs fieldSet) prune(v any) any {
+	switch v := v.(type) {
+	case []any:
+		for i := range v {
+			v[i] = fs.prune(v[i])
+		}
+	case map[string]any:
+		for k, val := range v {
+			sub, ok := fs[k]
+			switch {
+			case !ok:
+				delete(v, k)
+			case sub != nil:
+				v[k] = sub.prune(val)
+			}
+		}
+	}
+	return v
+}
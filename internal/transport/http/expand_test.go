@@ -0,0 +1,29 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseIncludeLimit(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    int
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"?includeLimit=10", 10, false},
+		{"?includeLimit=500", 500, false},
+		{"?includeLimit=", 0, false},
+		{"?includeLimit=0", 0, true},
+		{"?includeLimit=-5", 0, true},
+		{"?includeLimit=ten", 0, true},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/v1/projects"+tt.query, nil)
+		got, err := parseIncludeLimit(r)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("%q: got %d, %v", tt.query, got, err)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log"
+	stdhttp "net/http"
+
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/idempotency"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// Idempotency — middleware для POST-эндпоинтов создания. Запрос с
+// заголовком Idempotency-Key выполняется один раз; повтор с тем же ключом
+// и телом получает сохранённый ответ, с другим телом — 422. Ключи
+// действуют в пределах автора запроса.
+type Idempotency struct {
+	uc idempotency.IdempotencyService
+}
+
+func NewIdempotency(uc idempotency.IdempotencyService) *Idempotency {
+	return &Idempotency{uc: uc}
+}
+
+func (m *Idempotency) Handler(next stdhttp.Handler) stdhttp.Handler {
+	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeBadRequest(w, "cannot read body")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := r.Context()
+		scope := requestScope(r, body)
+		saved, err := m.uc.Begin(ctx, scope, key, requestFingerprint(r, body))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if saved != nil {
+			if saved.ContentType != "" {
+				w.Header().Set("Content-Type", saved.ContentType)
+			}
+			w.Header().Set("Idempotent-Replayed", "true")
+			w.WriteHeader(saved.StatusCode)
+			_, _ = w.Write(saved.Body)
+			return
+		}
+
+		// Клиент мог уже отключиться, но результат всё равно нужно записать.
+		ctx = context.WithoutCancel(ctx)
+
+		// Паника обработчика станет 500 в Recoverer снаружи: ключ
+		// освобождаем, иначе он останется «в работе» до конца хранения.
+		defer func() {
+			if p := recover(); p != nil {
+				m.release(ctx, scope, key)
+				panic(p)
+			}
+		}()
+
+		rec := &responseRecorder{ResponseWriter: w, status: stdhttp.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		// Ответы 5xx не запоминаем: клиент должен иметь возможность повторить.
+		// Ошибки сохранения только логируем — ответ клиенту уже отправлен.
+		if rec.status >= stdhttp.StatusInternalServerError {
+			m.release(ctx, scope, key)
+			return
+		}
+		err = m.uc.Complete(ctx, scope, key, idempotency.Response{
+			StatusCode:  rec.status,
+			ContentType: rec.Header().Get("Content-Type"),
+			Body:        rec.body.Bytes(),
+		})
+		if err != nil {
+			log.Printf("idempotency complete %q: %v", key, err)
+		}
+	})
+}
+
+func (m *Idempotency) release(ctx context.Context, scope, key string) {
+	if err := m.uc.Release(ctx, scope, key); err != nil {
+		log.Printf("idempotency release %q: %v", key, err)
+	}
+}
+
+// requestScope — автор запроса: заголовок X-Actor-ID, иначе actorId или
+// userId из тела. Запросы без автора делят общую область ключей.
+func requestScope(r *stdhttp.Request, body []byte) string {
+	if id, ok := audit.ActorFrom(r.Context()); ok {
+		return id.String()
+	}
+	var req struct {
+		ActorID string `json:"actorId"`
+		UserID  string `json:"userId"`
+	}
+	_ = json.Unmarshal(body, &req)
+	for _, s := range []string{req.ActorID, req.UserID} {
+		if id, err := uuid.Parse(s); err == nil {
+			return id.String()
+		}
+	}
+	return ""
+}
+
+func requestFingerprint(r *stdhttp.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder пропускает ответ клиенту и одновременно копирует его.
+type responseRecorder struct {
+	stdhttp.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	if !rr.wroteHeader {
+		rr.status = status
+		rr.wroteHeader = true
+	}
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(p []byte) (int, error) {
+	rr.wroteHeader = true
+	rr.body.Write(p)
+	return rr.ResponseWriter.Write(p)
+}
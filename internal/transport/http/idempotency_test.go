@@ -0,0 +1,94 @@
+package http
+
+import (
+	"context"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project-manager-dashboard-go/internal/app/usecase/idempotency"
+)
+
+// fakeIdempotency запоминает вызовы middleware.
+type fakeIdempotency struct {
+	scopes   []string
+	released []string
+	saved    []string
+}
+
+func (f *fakeIdempotency) Begin(_ context.Context, scope, key, _ string) (*idempotency.Response, error) {
+	f.scopes = append(f.scopes, scope)
+	return nil, nil
+}
+
+func (f *fakeIdempotency) Complete(_ context.Context, _, key string, _ idempotency.Response) error {
+	f.saved = append(f.saved, key)
+	return nil
+}
+
+func (f *fakeIdempotency) Release(_ context.Context, _, key string) error {
+	f.released = append(f.released, key)
+	return nil
+}
+
+func TestIdempotencyReleasesKeyOnPanic(t *testing.T) {
+	uc := &fakeIdempotency{}
+	h := NewIdempotency(uc).Handler(stdhttp.HandlerFunc(func(stdhttp.ResponseWriter, *stdhttp.Request) {
+		panic("boom")
+	}))
+
+	req := httptest.NewRequest(stdhttp.MethodPost, "/projects", strings.NewReader(`{}`))
+	req.Header.Set(idempotencyKeyHeader, "k1")
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("panic was swallowed, want it re-raised for Recoverer")
+			}
+		}()
+		h.ServeHTTP(httptest.NewRecorder(), req)
+	}()
+
+	if len(uc.released) != 1 || uc.released[0] != "k1" {
+		t.Errorf("released = %v, want [k1]", uc.released)
+	}
+	if len(uc.saved) != 0 {
+		t.Errorf("saved = %v, want none", uc.saved)
+	}
+}
+
+func TestIdempotencyScope(t *testing.T) {
+	const actor = "7f3c2a5e-1b4d-4c8e-9a6f-2d1e0b9c8a7d"
+	tests := []struct {
+		name   string
+		header string
+		body   string
+		want   string
+	}{
+		{name: "header", header: actor, body: `{"userId":"00000000-0000-0000-0000-000000000001"}`, want: actor},
+		{name: "actorId", body: `{"title":"t","actorId":"` + actor + `"}`, want: actor},
+		{name: "userId", body: `{"name":"p","userId":"` + actor + `"}`, want: actor},
+		{name: "anonymous", body: `{"name":"p"}`, want: ""},
+		{name: "not json", body: `nope`, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &fakeIdempotency{}
+			h := auditContext(NewIdempotency(uc).Handler(stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+				w.WriteHeader(stdhttp.StatusCreated)
+			})))
+
+			req := httptest.NewRequest(stdhttp.MethodPost, "/projects", strings.NewReader(tt.body))
+			req.Header.Set(idempotencyKeyHeader, "k1")
+			if tt.header != "" {
+				req.Header.Set("X-Actor-ID", tt.header)
+			}
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			if len(uc.scopes) != 1 || uc.scopes[0] != tt.want {
+				t.Errorf("scope = %v, want %q", uc.scopes, tt.want)
+			}
+		})
+	}
+}
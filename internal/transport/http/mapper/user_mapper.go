@@ -2,7 +2,7 @@ package mapper
 
 import (
 	"project-manager-dashboard-go/internal/app/usecase/user"
-	"project-manager-dashboard-go/internal/transport/http/dto"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
 )
 
 func ToUserDTO(u user.User) dto.UserDTO {
@@ -0,0 +1,275 @@
+package http
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/milestone"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+type MilestoneHandler struct {
+	uc milestone.MilestoneService
+}
+
+func NewMilestoneHandler(uc milestone.MilestoneService) *MilestoneHandler {
+	return &MilestoneHandler{uc: uc}
+}
+
+func (h *MilestoneHandler) ListByProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	res, err := h.uc.ListByProject(ctx, projectID, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.MilestoneResponse, 0, len(res.Items))
+	for _, m := range res.Items {
+		out = append(out, toMilestoneResponse(m))
+	}
+	writePage(w, r, res, out)
+}
+
+func (h *MilestoneHandler) CreateInProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	var req dto.CreateMilestoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	created, err := h.uc.Create(ctx, projectID, milestone.CreateInput{
+		Name:        req.Name,
+		Description: req.Description,
+		TargetDate:  req.TargetDate,
+		ActorID:     actorID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, toMilestoneResponse(created))
+}
+
+func (h *MilestoneHandler) GetMilestone(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	m, err := h.uc.GetByID(ctx, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, toMilestoneResponse(m))
+}
+
+func (h *MilestoneHandler) UpdateMilestone(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	var req dto.UpdateMilestoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	updated, err := h.uc.Update(ctx, id, milestone.UpdateInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		Status:          req.Status,
+		TargetDate:      req.TargetDate,
+		ClearTargetDate: req.ClearTargetDate,
+		ActorID:         actorID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, toMilestoneResponse(updated))
+}
+
+func (h *MilestoneHandler) DeleteMilestone(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	var req dto.DeleteMilestoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	if err := h.uc.Delete(ctx, id, actorID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (h *MilestoneHandler) AssignTask(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	milestoneID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid milestone id")
+		return
+	}
+
+	var req dto.MilestoneTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	taskID, err := uuid.Parse(req.TaskID)
+	if err != nil {
+		writeBadRequest(w, "invalid taskId")
+		return
+	}
+
+	if err := h.uc.AssignTask(ctx, milestoneID, taskID, actorID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, map[string]string{"status": "assigned"})
+}
+
+func (h *MilestoneHandler) UnassignTask(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	milestoneID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid milestone id")
+		return
+	}
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "taskId"))
+	if err != nil {
+		writeBadRequest(w, "invalid task id")
+		return
+	}
+
+	var req dto.DeleteMilestoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	if err := h.uc.UnassignTask(ctx, milestoneID, taskID, actorID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (h *MilestoneHandler) Summary(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	s, err := h.uc.Summary(ctx, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, dto.MilestoneSummaryResponse{
+		Milestone:     toMilestoneResponse(s.Milestone),
+		Total:         s.Total,
+		TasksByStatus: s.TasksByStatus,
+		Completion:    s.Completion,
+		OnTrack:       s.OnTrack,
+		Overdue:       s.Overdue,
+	})
+}
+
+func toMilestoneResponse(m milestone.MilestoneDTO) dto.MilestoneResponse {
+	return dto.MilestoneResponse{
+		ID:          m.ID,
+		ProjectID:   m.ProjectID,
+		Name:        m.Name,
+		Description: m.Description,
+		Status:      m.Status,
+		TargetDate:  m.TargetDate,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
@@ -0,0 +1,171 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	stdhttp "net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/notification"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+type NotificationHandler struct {
+	uc notification.NotificationService
+}
+
+func NewNotificationHandler(uc notification.NotificationService) *NotificationHandler {
+	return &NotificationHandler{uc: uc}
+}
+
+// List: GET /users/{id}/notifications?unread=true&limit=&offset=
+func (h *NotificationHandler) List(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid user id")
+		return
+	}
+
+	q := r.URL.Query()
+	var f notification.ListFilter
+	if s := q.Get("unread"); s != "" {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			writeBadRequest(w, "invalid unread")
+			return
+		}
+		f.UnreadOnly = v
+	}
+
+	p := parsePage(r)
+
+	res, err := h.uc.List(r.Context(), userID, f, p)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.NotificationResponse, 0, len(res.Items))
+	for _, n := range res.Items {
+		out = append(out, toNotificationResponse(n))
+	}
+	writePage(w, r, res, out)
+}
+
+func (h *NotificationHandler) MarkRead(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	h.mark(w, r, h.uc.MarkRead)
+}
+
+func (h *NotificationHandler) MarkUnread(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	h.mark(w, r, h.uc.MarkUnread)
+}
+
+func (h *NotificationHandler) mark(
+	w stdhttp.ResponseWriter,
+	r *stdhttp.Request,
+	fn func(ctx context.Context, id, userID uuid.UUID) (notification.NotificationDTO, error),
+) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	var req dto.MarkNotificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+
+	n, err := fn(r.Context(), id, actorID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, toNotificationResponse(n))
+}
+
+func (h *NotificationHandler) MarkAllRead(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid user id")
+		return
+	}
+
+	n, err := h.uc.MarkAllRead(r.Context(), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, dto.MarkAllReadResponse{Updated: n})
+}
+
+func (h *NotificationHandler) GetPreferences(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid user id")
+		return
+	}
+
+	settings, err := h.uc.Settings(r.Context(), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, toSettingsResponse(settings))
+}
+
+func (h *NotificationHandler) UpdatePreferences(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid user id")
+		return
+	}
+
+	var req dto.UpdateNotificationPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	settings, err := h.uc.UpdateSettings(r.Context(), userID, notification.SettingsUpdate{
+		EmailMode: req.EmailMode,
+		InApp:     req.InApp,
+		Email:     req.Email,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, toSettingsResponse(settings))
+}
+
+func toNotificationResponse(n notification.NotificationDTO) dto.NotificationResponse {
+	return dto.NotificationResponse{
+		ID:        n.ID,
+		Type:      n.Type,
+		Message:   n.Message,
+		ActorID:   n.ActorID,
+		ProjectID: n.ProjectID,
+		TaskID:    n.TaskID,
+		Read:      n.ReadAt != nil,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+func toSettingsResponse(s notification.Settings) dto.NotificationSettingsResponse {
+	types := make([]dto.NotificationPreferenceResponse, 0, len(s.Types))
+	for _, p := range s.Types {
+		types = append(types, dto.NotificationPreferenceResponse{Type: p.Type, InApp: p.InApp, Email: p.Email})
+	}
+	return dto.NotificationSettingsResponse{EmailMode: s.EmailMode, Types: types}
+}
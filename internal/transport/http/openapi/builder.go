@@ -0,0 +1,140 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var pathParamRe = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Op — описание операции для Add. Тела запроса и ответов задаются
+// значениями dto-типов, схемы для них строятся автоматически.
+type Op struct {
+	ID      string
+	Summary string
+	Tag     string
+	// Params — query- и header-параметры. Параметры пути, не перечисленные
+	// здесь, добавляются сами как обязательные UUID.
+	Params []*Parameter
+	Body   any
+	// Responses: код ответа -> значение dto, Content или nil (без тела).
+	Responses map[int]any
+	// Headers — заголовки успешных (2xx) ответов.
+	Headers map[string]*Header
+}
+
+// Content — ответ не в JSON: HTML, поток событий и т.п.
+type Content struct {
+	Type   string
+	Schema *Schema
+}
+
+// New создаёт документ; пути операций отсчитываются от basePath
+// (например, /v1).
+func New(info Info, basePath string) *Document {
+	return &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Servers: []Server{{URL: basePath}},
+		Paths:   map[string]*PathItem{},
+		Components: Components{Schemas: map[string]*Schema{
+			"Error": problemSchema(),
+		}},
+	}
+}
+
+// Add регистрирует операцию method path. Путь — в синтаксисе chi и
+// OpenAPI одновременно: /projects/{id}.
+func (d *Document) Add(method, path string, op Op) {
+	item, ok := d.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		d.Paths[path] = item
+	}
+	m := strings.ToLower(method)
+	if _, dup := (*item)[m]; dup {
+		panic(fmt.Sprintf("openapi: duplicate operation %s %s", method, path))
+	}
+
+	o := &Operation{
+		OperationID: op.ID,
+		Summary:     op.Summary,
+		Responses:   map[string]*Response{},
+	}
+	if op.Tag != "" {
+		o.Tags = []string{op.Tag}
+	}
+
+	declared := map[string]bool{}
+	for _, p := range op.Params {
+		if p.In == InPath {
+			declared[p.Name] = true
+		}
+	}
+	for _, match := range pathParamRe.FindAllStringSubmatch(path, -1) {
+		if !declared[match[1]] {
+			o.Parameters = append(o.Parameters, PathParam(match[1], UUID()))
+		}
+	}
+	o.Parameters = append(o.Parameters, op.Params...)
+
+	if op.Body != nil {
+		o.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{"application/json": {Schema: d.schemaOf(op.Body)}},
+		}
+	}
+
+	for code, v := range op.Responses {
+		r := &Response{Description: http.StatusText(code)}
+		switch body := v.(type) {
+		case nil:
+		case Content:
+			r.Content = map[string]*MediaType{body.Type: {Schema: body.Schema}}
+		default:
+			r.Content = map[string]*MediaType{"application/json": {Schema: d.schemaOf(body)}}
+		}
+		if code >= 200 && code < 300 {
+			r.Headers = op.Headers
+		}
+		o.Responses[strconv.Itoa(code)] = r
+	}
+	o.Responses["default"] = &Response{
+		Description: "Ошибка",
+		Content: map[string]*MediaType{
+			ProblemContentType: {Schema: &Schema{Ref: "#/components/schemas/Error"}},
+		},
+	}
+
+	(*item)[m] = o
+}
+
+func PathParam(name string, s *Schema) *Parameter {
+	return &Parameter{Name: name, In: InPath, Required: true, Schema: s}
+}
+
+func QueryParam(name string, s *Schema, description string) *Parameter {
+	return &Parameter{Name: name, In: InQuery, Schema: s, Description: description}
+}
+
+func HeaderParam(name string, s *Schema, description string) *Parameter {
+	return &Parameter{Name: name, In: InHeader, Schema: s, Description: description}
+}
+
+func String() *Schema   { return &Schema{Type: "string"} }
+func UUID() *Schema     { return &Schema{Type: "string", Format: "uuid"} }
+func DateTime() *Schema { return &Schema{Type: "string", Format: "date-time"} }
+func Bool() *Schema     { return &Schema{Type: "boolean"} }
+
+func Int() *Schema { return &Schema{Type: "integer"} }
+
+func Enum(values ...string) *Schema {
+	s := &Schema{Type: "string"}
+	for _, v := range values {
+		s.Enum = append(s.Enum, v)
+	}
+	return s
+}
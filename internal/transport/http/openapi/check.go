@@ -0,0 +1,48 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Check сверяет маршруты роутера с операциями документа: у каждого
+// маршрута должна быть операция, и наоборот.
+func Check(routes chi.Routes, d *Document) error {
+	registered := map[string]bool{}
+	err := chi.Walk(routes, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		registered[strings.ToUpper(method)+" "+route] = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	documented := map[string]bool{}
+	for path, item := range d.Paths {
+		for method := range *item {
+			documented[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	var problems []string
+	for r := range registered {
+		if !documented[r] {
+			problems = append(problems, fmt.Sprintf("%s: route is not documented", r))
+		}
+	}
+	for op := range documented {
+		if !registered[op] {
+			problems = append(problems, fmt.Sprintf("%s: documented operation has no route", op))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return errors.New("openapi: spec and routes disagree:\n" + strings.Join(problems, "\n"))
+}
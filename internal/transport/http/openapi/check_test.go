@@ -0,0 +1,36 @@
+package openapi
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestCheck(t *testing.T) {
+	noop := func(http.ResponseWriter, *http.Request) {}
+
+	r := chi.NewRouter()
+	r.Get("/items/{id}", noop)
+	r.Post("/items", noop)
+	if err := Check(r, testDocument()); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+
+	r.Delete("/items/{id}", noop)
+	err := Check(r, testDocument())
+	if err == nil || !strings.Contains(err.Error(), "DELETE /items/{id}: route is not documented") {
+		t.Fatalf("Check with undocumented route = %v", err)
+	}
+
+	d := testDocument()
+	d.Add("GET", "/other", Op{ID: "other"})
+	r = chi.NewRouter()
+	r.Get("/items/{id}", noop)
+	r.Post("/items", noop)
+	err = Check(r, d)
+	if err == nil || !strings.Contains(err.Error(), "GET /other: documented operation has no route") {
+		t.Fatalf("Check with missing route = %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType — тип тела ответов об ошибках (RFC 7807).
+const ProblemContentType = "application/problem+json"
+
+// Problem — тело ответа об ошибке. Type всегда about:blank, Title —
+// текст статуса; клиенты различают ошибки по стабильному Code, Detail —
+// текст для человека. Errors перечисляет нарушения при проверке запроса.
+type Problem struct {
+	Type   string   `json:"type"`
+	Title  string   `json:"title"`
+	Status int      `json:"status"`
+	Code   string   `json:"code"`
+	Detail string   `json:"detail,omitempty"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+func NewProblem(status int, code, detail string) Problem {
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   code,
+		Detail: detail,
+	}
+}
+
+func WriteProblem(w http.ResponseWriter, p Problem) {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// problemSchema описывает Problem в компоненте Error документа.
+func problemSchema() *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"type":   {Type: "string", Format: "uri-reference"},
+			"title":  {Type: "string"},
+			"status": {Type: "integer"},
+			"code":   {Type: "string", Description: "стабильный машинный код ошибки"},
+			"detail": {Type: "string"},
+			"errors": {Type: "array", Items: &Schema{Type: "string"}},
+		},
+		Required: []string{"type", "title", "status", "code"},
+	}
+}
@@ -0,0 +1,134 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	uuidType = reflect.TypeOf(uuid.UUID{})
+	timeType = reflect.TypeOf(time.Time{})
+	rawType  = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaOf строит схему для значения v. Именованные структуры попадают в
+// components.schemas и возвращаются ссылкой.
+//
+// Поле обязательно, если в json-теге нет omitempty и это не указатель.
+// Теги format, example и enum (значения через запятую) переносятся в схему.
+func (d *Document) schemaOf(v any) *Schema {
+	return d.schemaFor(reflect.TypeOf(v))
+}
+
+func (d *Document) schemaFor(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: d.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return d.structSchema(t)
+		}
+		ref := &Schema{Ref: "#/components/schemas/" + t.Name()}
+		if _, ok := d.Components.Schemas[t.Name()]; ok {
+			return ref
+		}
+		// Заглушка до построения — на случай рекурсивных типов.
+		d.Components.Schemas[t.Name()] = &Schema{}
+		d.Components.Schemas[t.Name()] = d.structSchema(t)
+		return ref
+	default:
+		return &Schema{}
+	}
+}
+
+func (d *Document) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	d.addFields(s, t)
+	return s
+}
+
+func (d *Document) addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			d.addFields(s, f.Type)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		omitempty := strings.Contains(opts, "omitempty")
+
+		fs := d.schemaFor(f.Type)
+		if fs.Ref == "" {
+			if v := f.Tag.Get("format"); v != "" {
+				fs.Format = v
+			}
+			if v := f.Tag.Get("example"); v != "" {
+				fs.Examples = []any{v}
+			}
+			if v := f.Tag.Get("enum"); v != "" {
+				for _, e := range strings.Split(v, ",") {
+					fs.Enum = append(fs.Enum, e)
+				}
+			}
+			// Без omitempty nil-указатель, срез или map сериализуются в null.
+			if typ, ok := fs.Type.(string); ok && !omitempty && nullable(f.Type) {
+				fs.Type = []string{typ, "null"}
+				if fs.Enum != nil {
+					fs.Enum = append(fs.Enum, nil)
+				}
+			}
+		}
+		s.Properties[name] = fs
+
+		if !omitempty && f.Type.Kind() != reflect.Pointer {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+func nullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return t != reflect.PointerTo(rawType)
+	case reflect.Slice, reflect.Map:
+		return t != rawType
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Validator проверяет параметры и JSON-тело запроса по документу до того,
+// как запрос попадёт в обработчик. Запросы к путям, которых нет в
+// документе, пропускаются без проверки.
+type Validator struct {
+	routes  []route
+	printer *message.Printer
+}
+
+type route struct {
+	method   string
+	segments []string
+	params   []*Parameter
+	body     *jsonschema.Schema
+}
+
+const resourceURL = "openapi.json"
+
+func NewValidator(d *Document) (*Validator, error) {
+	raw, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	c.AssertFormat()
+	if err := c.AddResource(resourceURL, doc); err != nil {
+		return nil, err
+	}
+
+	v := &Validator{printer: message.NewPrinter(language.English)}
+	for path, item := range d.Paths {
+		for method, op := range *item {
+			rt := route{
+				method:   strings.ToUpper(method),
+				segments: splitPath(path),
+				params:   op.Parameters,
+			}
+			if op.RequestBody != nil {
+				if mt, ok := op.RequestBody.Content["application/json"]; ok && mt.Schema != nil {
+					loc := resourceURL + "#" + jsonPointer("paths", path, method, "requestBody", "content", "application/json", "schema")
+					rt.body, err = c.Compile(loc)
+					if err != nil {
+						return nil, fmt.Errorf("%s %s: %w", rt.method, path, err)
+					}
+				}
+			}
+			v.routes = append(v.routes, rt)
+		}
+	}
+	return v, nil
+}
+
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Под Mount путь операции — остаток после префикса (/v1).
+		path := r.URL.Path
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+			path = rctx.RoutePath
+		}
+
+		rt, pathValues, ok := v.match(r.Method, path)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if problems := v.checkParams(r, rt, pathValues); len(problems) > 0 {
+			writeInvalid(w, "invalid_parameters", "invalid parameters", problems)
+			return
+		}
+
+		if rt.body != nil && r.Body != nil {
+			raw, err := io.ReadAll(r.Body)
+			if err != nil {
+				writeInvalid(w, "invalid_body", "invalid body", nil)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(raw))
+
+			// Пустое тело оставляем обработчику: часть из них его допускает.
+			if len(bytes.TrimSpace(raw)) > 0 {
+				inst, err := jsonschema.UnmarshalJSON(bytes.NewReader(raw))
+				if err != nil {
+					writeInvalid(w, "invalid_json", "invalid json", nil)
+					return
+				}
+				if err := rt.body.Validate(inst); err != nil {
+					writeInvalid(w, "invalid_body", "invalid body", v.problems(err))
+					return
+				}
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// match ищет операцию по методу и пути; при нескольких совпадениях
+// выигрывает та, у которой больше литеральных сегментов.
+func (v *Validator) match(method, path string) (route, map[string]string, bool) {
+	segs := splitPath(path)
+
+	var (
+		best       route
+		bestValues map[string]string
+		bestParams = -1
+	)
+	for _, rt := range v.routes {
+		if rt.method != method || len(rt.segments) != len(segs) {
+			continue
+		}
+		values := map[string]string{}
+		params := 0
+		ok := true
+		for i, s := range rt.segments {
+			if name, isParam := strings.CutPrefix(s, "{"); isParam {
+				values[strings.TrimSuffix(name, "}")] = segs[i]
+				params++
+				continue
+			}
+			if s != segs[i] {
+				ok = false
+				break
+			}
+		}
+		if ok && (bestParams < 0 || params < bestParams) {
+			best, bestValues, bestParams = rt, values, params
+		}
+	}
+	return best, bestValues, bestParams >= 0
+}
+
+func (v *Validator) checkParams(r *http.Request, rt route, pathValues map[string]string) []string {
+	var problems []string
+	q := r.URL.Query()
+	for _, p := range rt.params {
+		var value string
+		var present bool
+		switch p.In {
+		case InPath:
+			value, present = pathValues[p.Name]
+		case InQuery:
+			present = q.Has(p.Name)
+			value = q.Get(p.Name)
+		case InHeader:
+			value = r.Header.Get(p.Name)
+			present = value != ""
+		}
+		if !present {
+			if p.Required {
+				problems = append(problems, fmt.Sprintf("%s: is required", p.Name))
+			}
+			continue
+		}
+		if err := checkValue(p.Schema, value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", p.Name, err))
+		}
+	}
+	return problems
+}
+
+// checkValue проверяет строковое значение параметра по его схеме.
+func checkValue(s *Schema, value string) error {
+	if s == nil {
+		return nil
+	}
+	switch s.Type {
+	case "integer":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.New("must be an integer")
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("must be a boolean")
+		}
+	}
+	switch s.Format {
+	case "uuid":
+		if _, err := uuid.Parse(value); err != nil {
+			return errors.New("must be a uuid")
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return errors.New("must be an RFC 3339 date-time")
+		}
+	}
+	if len(s.Enum) > 0 && !slices.Contains(s.Enum, any(value)) {
+		return fmt.Errorf("must be one of %v", s.Enum)
+	}
+	return nil
+}
+
+// problems разворачивает ошибку схемы в список «путь: причина».
+func (v *Validator) problems(err error) []string {
+	var ve *jsonschema.ValidationError
+	if !errors.As(err, &ve) {
+		return []string{err.Error()}
+	}
+	var out []string
+	var walk func(e *jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			out = append(out, fmt.Sprintf("/%s: %s",
+				strings.Join(e.InstanceLocation, "/"), e.ErrorKind.LocalizedString(v.printer)))
+			return
+		}
+		for _, c := range e.Causes {
+			walk(c)
+		}
+	}
+	walk(ve)
+	return out
+}
+
+func writeInvalid(w http.ResponseWriter, code, msg string, details []string) {
+	p := NewProblem(http.StatusBadRequest, code, msg)
+	p.Errors = details
+	WriteProblem(w, p)
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func jsonPointer(tokens ...string) string {
+	var sb strings.Builder
+	for _, t := range tokens {
+		t = strings.ReplaceAll(t, "~", "~0")
+		t = strings.ReplaceAll(t, "/", "~1")
+		sb.WriteString("/" + t)
+	}
+	return sb.String()
+}
@@ -0,0 +1,86 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type itemRequest struct {
+	Name  string `json:"name"`
+	Count int    `json:"count,omitempty"`
+}
+
+func testDocument() *Document {
+	d := New(Info{Title: "test", Version: "1"}, "/v1")
+	d.Add("GET", "/items/{id}", Op{
+		ID:        "getItem",
+		Params:    []*Parameter{QueryParam("limit", Int(), "")},
+		Responses: map[int]any{http.StatusOK: nil},
+	})
+	d.Add("POST", "/items", Op{
+		ID:        "createItem",
+		Body:      itemRequest{},
+		Responses: map[int]any{http.StatusCreated: nil},
+	})
+	return d
+}
+
+func TestValidatorMiddleware(t *testing.T) {
+	v, err := NewValidator(testDocument())
+	if err != nil {
+		t.Fatalf("NewValidator: %v", err)
+	}
+	h := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	const id = "6f1c2b1e-7c1a-4f7e-9c55-0b7f6f1d2a3b"
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		wantCode int
+		wantErr  string
+	}{
+		{"valid params", "GET", "/items/" + id + "?limit=10", "", http.StatusTeapot, ""},
+		{"bad path param", "GET", "/items/42", "", http.StatusBadRequest, "invalid_parameters"},
+		{"bad query param", "GET", "/items/" + id + "?limit=ten", "", http.StatusBadRequest, "invalid_parameters"},
+		{"valid body", "POST", "/items", `{"name":"a","count":1}`, http.StatusTeapot, ""},
+		{"bad body", "POST", "/items", `{"name":1}`, http.StatusBadRequest, "invalid_body"},
+		{"broken json", "POST", "/items", `{"name":`, http.StatusBadRequest, "invalid_json"},
+		{"empty body", "POST", "/items", "", http.StatusTeapot, ""},
+		{"unknown path", "GET", "/nope", "", http.StatusTeapot, ""},
+		{"unknown method", "DELETE", "/items", "", http.StatusTeapot, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d; body %s", rec.Code, tt.wantCode, rec.Body)
+			}
+			if tt.wantErr == "" {
+				return
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", ct, ProblemContentType)
+			}
+			var p Problem
+			if err := json.NewDecoder(rec.Body).Decode(&p); err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+			if p.Code != tt.wantErr {
+				t.Errorf("code = %q, want %q", p.Code, tt.wantErr)
+			}
+			if len(p.Errors) == 0 && tt.wantErr != "invalid_json" {
+				t.Error("problem has no errors")
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/task"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+func (h *TaskHandler) ListOverdueByProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	res, err := h.uc.ListOverdueByProject(r.Context(), projectID, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writePage(w, r, res, toOverdueResponses(res.Items))
+}
+
+func (h *TaskHandler) ListOverdueByUser(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid user id")
+		return
+	}
+
+	res, err := h.uc.ListOverdueByUser(r.Context(), userID, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writePage(w, r, res, toOverdueResponses(res.Items))
+}
+
+func toOverdueResponses(items []task.TaskDTO) []dto.TaskResponse {
+	out := make([]dto.TaskResponse, 0, len(items))
+	for _, t := range items {
+		projectID := t.ProjectID
+		out = append(out, dto.TaskResponse{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      t.Status,
+			CreatedAt:   t.CreatedAt,
+			Assignees:   toAssigneeResponses(t.Assignees),
+			Position:    t.Position,
+			MilestoneID: t.MilestoneID,
+			ProjectID:   &projectID,
+			DueDate:     t.DueDate,
+		})
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"fmt"
+	stdhttp "net/http"
+	"strconv"
+
+	"project-manager-dashboard-go/internal/app/usecase/page"
+)
+
+// Заголовок с общим числом элементов списка (при ?total=true).
+const totalCountHeader = "X-Total-Count"
+
+// parsePage разбирает ?limit=&offset=&cursor=&total=. Курсор берётся из
+// ссылок предыдущего ответа; offset оставлен для старых клиентов и с
+// курсором не учитывается.
+func parsePage(r *stdhttp.Request) page.Params {
+	q := r.URL.Query()
+	p := page.Params{Cursor: q.Get("cursor")}
+	if v, err := strconv.Atoi(q.Get("limit")); err == nil {
+		p.Limit = v
+	}
+	if v, err := strconv.Atoi(q.Get("offset")); err == nil {
+		p.Offset = v
+	}
+	p.Total, _ = strconv.ParseBool(q.Get("total"))
+	return p.Normalize()
+}
+
+// writePage отдаёт страницу списка: body — уже преобразованные элементы,
+// ссылки на соседние страницы — в Link (rel="next"/"prev"), общее число —
+// в X-Total-Count.
+func writePage[T any](w stdhttp.ResponseWriter, r *stdhttp.Request, res page.Page[T], body any) {
+	h := w.Header()
+	if res.Next != "" {
+		h.Add("Link", fmt.Sprintf("<%s>; rel=\"next\"", pageURL(r, res.Next)))
+	}
+	if res.Prev != "" {
+		h.Add("Link", fmt.Sprintf("<%s>; rel=\"prev\"", pageURL(r, res.Prev)))
+	}
+	if res.Total != nil {
+		h.Set(totalCountHeader, strconv.Itoa(*res.Total))
+	}
+	writeJSON(w, stdhttp.StatusOK, body)
+}
+
+// pageURL — тот же запрос с другим курсором; offset отбрасывается.
+func pageURL(r *stdhttp.Request, cursor string) string {
+	q := r.URL.Query()
+	q.Del("offset")
+	q.Set("cursor", cursor)
+	return r.URL.Path + "?" + q.Encode()
+}
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"log"
+	stdhttp "net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"project-manager-dashboard-go/internal/app/usecase/apperr"
+	"project-manager-dashboard-go/internal/transport/http/openapi"
+)
+
+// Коды ошибок, которые возникают в самом транспорте, а не в use case.
+const (
+	codeInvalidRequest   = "invalid_request"
+	codeInternal         = "internal_error"
+	codeNotFound         = "not_found"
+	codeMethodNotAllowed = "method_not_allowed"
+)
+
+// writeError — единственное место, где ошибки use case становятся
+// HTTP-ответом: статус выбирается по классу ошибки, code берётся из неё
+// самой. Неизвестные ошибки логируются и отдаются как 500 без подробностей.
+func writeError(w stdhttp.ResponseWriter, err error) {
+	e, ok := apperr.As(err)
+	if !ok {
+		log.Printf("http: %v", err)
+		openapi.WriteProblem(w, openapi.NewProblem(stdhttp.StatusInternalServerError, codeInternal, "internal error"))
+		return
+	}
+	openapi.WriteProblem(w, openapi.NewProblem(statusOf(e.Kind), e.Code, e.Message))
+}
+
+func statusOf(k apperr.Kind) int {
+	switch k {
+	case apperr.KindInvalid:
+		return stdhttp.StatusBadRequest
+	case apperr.KindNotFound:
+		return stdhttp.StatusNotFound
+	case apperr.KindForbidden:
+		return stdhttp.StatusForbidden
+	case apperr.KindConflict:
+		return stdhttp.StatusConflict
+	case apperr.KindPrecondition:
+		return stdhttp.StatusPreconditionFailed
+	case apperr.KindUnprocessable:
+		return stdhttp.StatusUnprocessableEntity
+	default:
+		return stdhttp.StatusInternalServerError
+	}
+}
+
+// writeBadRequest — запрос не разобран: некорректный id, JSON, заголовок
+// или параметр.
+func writeBadRequest(w stdhttp.ResponseWriter, detail string) {
+	openapi.WriteProblem(w, openapi.NewProblem(stdhttp.StatusBadRequest, codeInvalidRequest, detail))
+}
+
+// notFound — маршрута с таким путём нет.
+func notFound(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	openapi.WriteProblem(w, openapi.NewProblem(stdhttp.StatusNotFound, codeNotFound, "no route for "+r.URL.Path))
+}
+
+// methodNotAllowed — путь есть, но не для этого метода. chi не передаёт
+// своему обработчику список методов, поэтому Allow собирается заново.
+func methodNotAllowed(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	if allowed := allowedMethods(r); len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+	}
+	openapi.WriteProblem(w, openapi.NewProblem(stdhttp.StatusMethodNotAllowed, codeMethodNotAllowed, r.Method+" is not allowed for "+r.URL.Path))
+}
+
+func allowedMethods(r *stdhttp.Request) []string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.Routes == nil {
+		return nil
+	}
+	path := rctx.RoutePath
+	if path == "" {
+		path = r.URL.Path
+	}
+	var out []string
+	for _, m := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+		if rctx.Routes.Match(chi.NewRouteContext(), m, path) {
+			out = append(out, m)
+		}
+	}
+	return out
+}
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/idempotency"
+	"project-manager-dashboard-go/internal/app/usecase/milestone"
+	"project-manager-dashboard-go/internal/app/usecase/notification"
+	"project-manager-dashboard-go/internal/app/usecase/page"
+	"project-manager-dashboard-go/internal/app/usecase/project"
+	"project-manager-dashboard-go/internal/app/usecase/task"
+	"project-manager-dashboard-go/internal/app/usecase/user"
+	"project-manager-dashboard-go/internal/app/usecase/webhook"
+	"project-manager-dashboard-go/internal/transport/http/openapi"
+)
+
+// failingTasks отвечает на GetByID заданной ошибкой; остальные методы
+// в тесте не вызываются.
+type failingTasks struct {
+	task.TaskService
+	err error
+}
+
+func (f failingTasks) GetByID(context.Context, uuid.UUID, task.Include) (task.TaskDTO, error) {
+	return task.TaskDTO{}, f.err
+}
+
+func TestErrorResponses(t *testing.T) {
+	tests := []struct {
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{task.ErrNotFound, 404, "task_not_found"},
+		{task.ErrForbidden, 403, "forbidden"},
+		{task.ErrUserNotFound, 404, "user_not_found"},
+		{task.ErrNotProjectMember, 403, "not_project_member"},
+		{task.ErrAlreadyAssigned, 409, "already_assigned"},
+		{task.ErrNotAssigned, 404, "not_assigned"},
+		{task.ErrInvalidRole, 400, "invalid_role"},
+		{task.ErrAlreadyWatching, 409, "already_watching"},
+		{task.ErrNotWatching, 404, "not_watching"},
+		{task.ErrProjectNotFound, 404, "project_not_found"},
+		{task.ErrProjectDeleted, 409, "project_deleted"},
+		{task.ErrProjectArchived, 409, "project_archived"},
+		{task.ErrVersionConflict, 412, "version_mismatch"},
+		{task.ErrTitleRequired, 400, "title_required"},
+		{task.ErrInvalidStatus, 400, "invalid_status"},
+		{task.ErrInvalidPosition, 400, "invalid_position"},
+
+		{project.ErrNotFound, 404, "project_not_found"},
+		{project.ErrUserNotFound, 404, "user_not_found"},
+		{project.ErrForbidden, 403, "forbidden"},
+		{project.ErrAlreadyMember, 409, "already_member"},
+		{project.ErrArchived, 409, "project_archived"},
+		{project.ErrVersionConflict, 412, "version_mismatch"},
+		{project.ErrTemplateNotFound, 404, "template_not_found"},
+		{project.ErrNameRequired, 400, "name_required"},
+
+		{user.ErrNotFound, 404, "user_not_found"},
+		{user.ErrEmailTaken, 409, "email_taken"},
+		{user.ErrNameRequired, 400, "name_required"},
+		{user.ErrEmailRequired, 400, "email_required"},
+
+		{milestone.ErrNotFound, 404, "milestone_not_found"},
+		{milestone.ErrProjectNotFound, 404, "project_not_found"},
+		{milestone.ErrTaskNotFound, 404, "task_not_found"},
+		{milestone.ErrForbidden, 403, "forbidden"},
+		{milestone.ErrTaskProjectMismatch, 409, "task_project_mismatch"},
+		{milestone.ErrProjectArchived, 409, "project_archived"},
+		{milestone.ErrNameRequired, 400, "name_required"},
+		{milestone.ErrInvalidStatus, 400, "invalid_status"},
+
+		{webhook.ErrNotFound, 404, "webhook_not_found"},
+		{webhook.ErrDeliveryNotFound, 404, "delivery_not_found"},
+		{webhook.ErrProjectNotFound, 404, "project_not_found"},
+		{webhook.ErrForbidden, 403, "forbidden"},
+		{webhook.ErrInvalidURL, 400, "invalid_url"},
+		{webhook.ErrPrivateURL, 400, "private_url"},
+		{webhook.ErrInvalidEvents, 400, "invalid_events"},
+		{webhook.ErrInactive, 409, "webhook_disabled"},
+
+		{notification.ErrNotFound, 404, "notification_not_found"},
+		{notification.ErrUserNotFound, 404, "user_not_found"},
+		{notification.ErrForbidden, 403, "forbidden"},
+		{notification.ErrUnknownType, 400, "unknown_notification_type"},
+		{notification.ErrInvalidMode, 400, "invalid_email_mode"},
+
+		{idempotency.ErrInvalidKey, 400, "invalid_idempotency_key"},
+		{idempotency.ErrInProgress, 409, "idempotency_in_progress"},
+		{idempotency.ErrFingerprintMismatch, 422, "idempotency_key_reused"},
+
+		{page.ErrInvalidCursor, 400, "invalid_cursor"},
+
+		// Обёрнутая ошибка отвечает как исходная, неизвестная — 500.
+		{fmt.Errorf("load: %w", task.ErrNotFound), 404, "task_not_found"},
+		{errors.New("connection refused"), 500, codeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			h := &TaskHandler{uc: failingTasks{err: tt.err}}
+			r := chi.NewRouter()
+			r.Get("/tasks/{id}", h.GetTask)
+
+			req := httptest.NewRequest("GET", "/tasks/"+uuid.NewString(), nil)
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != openapi.ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", ct, openapi.ProblemContentType)
+			}
+			var p openapi.Problem
+			if err := json.NewDecoder(rec.Body).Decode(&p); err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+			if p.Code != tt.wantCode || p.Status != tt.wantStatus {
+				t.Errorf("problem = %d %q, want %d %q", p.Status, p.Code, tt.wantStatus, tt.wantCode)
+			}
+		})
+	}
+}
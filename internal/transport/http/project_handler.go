@@ -4,13 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	stdhttp "net/http"
-	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	"project-manager-dashboard-go/internal/app/usecase/audit"
 	"project-manager-dashboard-go/internal/app/usecase/project"
-	"project-manager-dashboard-go/internal/transport/http/dto"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
 )
 
 type ProjectHandler struct {
@@ -24,36 +24,71 @@ func NewProjectHandler(uc project.ProjectService) *ProjectHandler {
 func (h *ProjectHandler) ListProjects(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 	ctx := r.Context()
 
-	limit := 50
-	offset := 0
+	// По умолчанию архивные проекты скрыты; ?archived=true — только архивные,
+	// ?archived=all — все.
+	archived := false
+	f := project.ListFilter{Archived: &archived}
+	switch r.URL.Query().Get("archived") {
+	case "", "false":
+	case "true":
+		archived = true
+	case "all":
+		f.Archived = nil
+	default:
+		writeBadRequest(w, "invalid archived filter")
+		return
+	}
 
-	if s := r.URL.Query().Get("limit"); s != "" {
-		if v, err := strconv.Atoi(s); err == nil {
-			limit = v
-		}
+	inc, err := parseProjectInclude(r, "")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
 	}
-	if s := r.URL.Query().Get("offset"); s != "" {
-		if v, err := strconv.Atoi(s); err == nil {
-			offset = v
-		}
+	fields, err := parseFields(r, dto.ProjectResponse{})
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
 	}
 
-	items, err := h.uc.List(ctx, limit, offset)
+	res, err := h.uc.List(ctx, f, inc, parsePage(r))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusInternalServerError, map[string]string{"error": err.Error()})
+		writeError(w, err)
 		return
 	}
 
-	out := make([]dto.ProjectResponse, 0, len(items))
-	for _, p := range items {
-		out = append(out, dto.ProjectResponse{
+	out := make([]dto.ProjectResponse, 0, len(res.Items))
+	for _, p := range res.Items {
+		item := dto.ProjectResponse{
 			ID:          p.ID,
 			Name:        p.Name,
 			Description: p.Description,
 			CreatedAt:   p.CreatedAt,
-		})
+			ArchivedAt:  p.ArchivedAt,
+		}
+		setProjectIncludes(&item, p, inc)
+		out = append(out, item)
 	}
-	writeJSON(w, stdhttp.StatusOK, out)
+	writePage(w, r, res, fields.apply(out))
+}
+
+// parseProjectInclude: members — участники, tasks — задачи,
+// tasks.assignee — задачи с исполнителями. Коллекции режутся по
+// ?includeLimit=, продолжение — по курсорам usersNextCursor/tasksNextCursor.
+func parseProjectInclude(r *stdhttp.Request, def string) (project.Include, error) {
+	set, err := parseInclude(r, def, "members", "tasks", "tasks.assignee")
+	if err != nil {
+		return project.Include{}, err
+	}
+	limit, err := parseIncludeLimit(r)
+	if err != nil {
+		return project.Include{}, err
+	}
+	return project.Include{
+		Members:       set["members"],
+		Tasks:         set["tasks"],
+		TaskAssignees: set["tasks.assignee"],
+		Limit:         limit,
+	}, nil
 }
 
 func (h *ProjectHandler) CreateProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
@@ -61,15 +96,16 @@ func (h *ProjectHandler) CreateProject(w stdhttp.ResponseWriter, r *stdhttp.Requ
 
 	var req dto.CreateProjectRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid json"})
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
 	ownerID, err := uuid.Parse(req.UserID)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid userId"})
+		writeBadRequest(w, "invalid userId")
 		return
 	}
+	ctx = audit.WithActor(ctx, ownerID)
 
 	created, err := h.uc.Create(ctx, project.CreateInput{
 		Name:        req.Name,
@@ -77,7 +113,7 @@ func (h *ProjectHandler) CreateProject(w stdhttp.ResponseWriter, r *stdhttp.Requ
 		OwnerID:     ownerID,
 	})
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": err.Error()})
+		writeError(w, err)
 		return
 	}
 
@@ -94,34 +130,44 @@ func (h *ProjectHandler) UpdateProject(w stdhttp.ResponseWriter, r *stdhttp.Requ
 
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid id"})
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		writeBadRequest(w, err.Error())
 		return
 	}
 
 	var req dto.UpdateProjectRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid json"})
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
 	updated, err := h.uc.Update(ctx, id, project.UpdateInput{
-		Name:        req.Name,
-		Description: req.Description,
+		Name:            req.Name,
+		Description:     req.Description,
+		ExpectedVersion: expectedVersion,
 	})
 	if err != nil {
-		if errors.Is(err, project.ErrNotFound) {
-			writeJSON(w, stdhttp.StatusNotFound, map[string]string{"error": "not found"})
+		if errors.Is(err, project.ErrVersionConflict) {
+			h.writeProjectConflict(w, r, id)
 			return
 		}
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": err.Error()})
+		writeError(w, err)
 		return
 	}
 
+	setETag(w, updated.Version)
 	writeJSON(w, stdhttp.StatusOK, dto.ProjectResponse{
 		ID:          updated.ID,
 		Name:        updated.Name,
 		Description: updated.Description,
 		CreatedAt:   updated.CreatedAt,
+		UpdatedAt:   &updated.UpdatedAt,
+		Version:     updated.Version,
 	})
 }
 
@@ -130,50 +176,127 @@ func (h *ProjectHandler) GetProject(w stdhttp.ResponseWriter, r *stdhttp.Request
 
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid id"})
+		writeBadRequest(w, "invalid id")
 		return
 	}
 
-	p, err := h.uc.GetByID(ctx, id)
+	// Без ?include= проект по-прежнему отдаётся с участниками и задачами,
+	// но коллекции ограничены.
+	inc, err := parseProjectInclude(r, "members,tasks")
 	if err != nil {
-		if errors.Is(err, project.ErrNotFound) {
-			writeJSON(w, stdhttp.StatusNotFound, map[string]string{"error": "not found"})
-			return
-		}
-		writeJSON(w, stdhttp.StatusInternalServerError, map[string]string{"error": err.Error()})
+		writeBadRequest(w, err.Error())
+		return
+	}
+	fields, err := parseFields(r, dto.ProjectResponse{})
+	if err != nil {
+		writeBadRequest(w, err.Error())
 		return
 	}
 
-	users := make([]dto.ProjectUserResponse, 0, len(p.Members))
-	for _, m := range p.Members {
-		users = append(users, dto.ProjectUserResponse{
-			ID:    m.UserID,
-			Name:  m.Name,
-			Email: m.Email,
-			Role:  m.Role,
-		})
+	p, err := h.uc.GetByID(ctx, id, inc)
+	if err != nil {
+		writeError(w, err)
+		return
 	}
 
-	tasks := make([]dto.TaskResponse, 0, len(p.Tasks))
-	for _, t := range p.Tasks {
-		tasks = append(tasks, dto.TaskResponse{
-			ID:          t.ID,
-			Title:       t.Title,
-			Description: t.Description,
-			Status:      t.Status,
-			CreatedAt:   t.CreatedAt,
-			Position:    t.Position,
-		})
+	setETag(w, p.Version)
+	writeJSON(w, stdhttp.StatusOK, fields.apply(toProjectDetailResponse(p, inc)))
+}
+
+func (h *ProjectHandler) ListMembers(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+	fields, err := parseFields(r, dto.ProjectUserResponse{})
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
 	}
 
-	writeJSON(w, stdhttp.StatusOK, dto.ProjectResponse{
+	res, err := h.uc.ListMembers(r.Context(), id, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writePage(w, r, res, fields.apply(toProjectUsers(res.Items)))
+}
+
+// writeProjectConflict отвечает 412 с текущим состоянием проекта,
+// чтобы клиент мог слить изменения и повторить запрос.
+func (h *ProjectHandler) writeProjectConflict(w stdhttp.ResponseWriter, r *stdhttp.Request, id uuid.UUID) {
+	inc := project.Include{Members: true, Tasks: true}
+	p, err := h.uc.GetByID(r.Context(), id, inc)
+	if err != nil {
+		writeError(w, project.ErrVersionConflict)
+		return
+	}
+	setETag(w, p.Version)
+	writeJSON(w, stdhttp.StatusPreconditionFailed, toProjectDetailResponse(p, inc))
+}
+
+func toProjectDetailResponse(p project.ProjectDTO, inc project.Include) dto.ProjectResponse {
+	out := dto.ProjectResponse{
 		ID:          p.ID,
 		Name:        p.Name,
 		Description: p.Description,
 		CreatedAt:   p.CreatedAt,
-		Users:       users,
-		Tasks:       tasks,
-	})
+		UpdatedAt:   &p.UpdatedAt,
+		Version:     p.Version,
+		ArchivedAt:  p.ArchivedAt,
+	}
+	setProjectIncludes(&out, p, inc)
+	return out
+}
+
+// setProjectIncludes заполняет запрошенные коллекции; не запрошенные
+// остаются null.
+func setProjectIncludes(out *dto.ProjectResponse, p project.ProjectDTO, inc project.Include) {
+	if inc.Members {
+		out.Users = toProjectUsers(p.Members)
+		out.UsersNext = p.MembersNext
+	}
+	if inc.Tasks || inc.TaskAssignees {
+		out.Tasks = make([]dto.TaskResponse, 0, len(p.Tasks))
+		for _, t := range p.Tasks {
+			item := dto.TaskResponse{
+				ID:          t.ID,
+				Title:       t.Title,
+				Description: t.Description,
+				Status:      t.Status,
+				CreatedAt:   t.CreatedAt,
+				Position:    t.Position,
+			}
+			if inc.TaskAssignees {
+				item.Assignees = make([]dto.TaskAssigneeResponse, 0, len(t.Assignees))
+				for _, a := range t.Assignees {
+					item.Assignees = append(item.Assignees, dto.TaskAssigneeResponse{
+						ID:    a.UserID,
+						Name:  a.Name,
+						Email: a.Email,
+						Role:  a.Role,
+					})
+				}
+			}
+			out.Tasks = append(out.Tasks, item)
+		}
+		out.TasksNext = p.TasksNext
+	}
+}
+
+func toProjectUsers(members []project.ProjectMemberDTO) []dto.ProjectUserResponse {
+	out := make([]dto.ProjectUserResponse, 0, len(members))
+	for _, m := range members {
+		out = append(out, dto.ProjectUserResponse{
+			ID:    m.UserID,
+			Name:  m.Name,
+			Email: m.Email,
+			Role:  m.Role,
+		})
+	}
+	return out
 }
 
 func (h *ProjectHandler) Invite(w stdhttp.ResponseWriter, r *stdhttp.Request) {
@@ -181,42 +304,32 @@ func (h *ProjectHandler) Invite(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 
 	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid project id"})
+		writeBadRequest(w, "invalid project id")
 		return
 	}
 
 	var req dto.InviteUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid json"})
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
 	inviterID, err := uuid.Parse(req.InviterID)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid inviterId"})
+		writeBadRequest(w, "invalid inviterId")
 		return
 	}
+	ctx = audit.WithActor(ctx, inviterID)
 
 	userID, err := uuid.Parse(req.UserID)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid userId"})
+		writeBadRequest(w, "invalid userId")
 		return
 	}
 
 	err = h.uc.Invite(ctx, projectID, inviterID, userID)
 	if err != nil {
-		switch {
-		case errors.Is(err, project.ErrNotFound):
-			writeJSON(w, stdhttp.StatusNotFound, map[string]string{"error": "project not found"})
-		case errors.Is(err, project.ErrUserNotFound):
-			writeJSON(w, stdhttp.StatusNotFound, map[string]string{"error": "user not found"})
-		case errors.Is(err, project.ErrForbidden):
-			writeJSON(w, stdhttp.StatusForbidden, map[string]string{"error": "forbidden"})
-		case errors.Is(err, project.ErrAlreadyMember):
-			writeJSON(w, stdhttp.StatusConflict, map[string]string{"error": "already member"})
-		default:
-			writeJSON(w, stdhttp.StatusInternalServerError, map[string]string{"error": err.Error()})
-		}
+		writeError(w, err)
 		return
 	}
 
@@ -228,32 +341,36 @@ func (h *ProjectHandler) DeleteProject(w stdhttp.ResponseWriter, r *stdhttp.Requ
 
 	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid project id"})
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		writeBadRequest(w, err.Error())
 		return
 	}
 
 	var req dto.DeleteProjectRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid json"})
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
 	actorID, err := uuid.Parse(req.ActorID)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid actorId"})
+		writeBadRequest(w, "invalid actorId")
 		return
 	}
+	ctx = audit.WithActor(ctx, actorID)
 
-	err = h.uc.Delete(ctx, projectID, actorID)
+	err = h.uc.Delete(ctx, projectID, actorID, expectedVersion)
 	if err != nil {
-		switch {
-		case errors.Is(err, project.ErrNotFound):
-			writeJSON(w, stdhttp.StatusNotFound, map[string]string{"error": "project not found"})
-		case errors.Is(err, project.ErrForbidden):
-			writeJSON(w, stdhttp.StatusForbidden, map[string]string{"error": "forbidden"})
-		default:
-			writeJSON(w, stdhttp.StatusInternalServerError, map[string]string{"error": err.Error()})
+		if errors.Is(err, project.ErrVersionConflict) {
+			h.writeProjectConflict(w, r, projectID)
+			return
 		}
+		writeError(w, err)
 		return
 	}
 
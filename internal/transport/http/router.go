@@ -4,28 +4,140 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"net/http"
+
+	"project-manager-dashboard-go/internal/transport/http/openapi"
 )
 
-func NewRouter(userH *UserHandler, projectH *ProjectHandler, taskH *TaskHandler) http.Handler {
+// NewRouter монтирует API под /v1. Старые пути без версии остаются
+// псевдонимами /v1 и отвечают с заголовками Deprecation и Sunset.
+func NewRouter(
+	userH *UserHandler,
+	projectH *ProjectHandler,
+	taskH *TaskHandler,
+	milestoneH *MilestoneHandler,
+	auditH *AuditHandler,
+	notificationH *NotificationHandler,
+	webhookH *WebhookHandler,
+	boardH *BoardHandler,
+	graphQL http.Handler,
+	idem *Idempotency,
+	legacy Deprecation,
+) (http.Handler, error) {
+	v1, err := newV1Router(userH, projectH, taskH, milestoneH, auditH, notificationH, webhookH, boardH, idem)
+	if err != nil {
+		return nil, err
+	}
+
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID, middleware.RealIP, middleware.Logger, middleware.Recoverer)
+	r.Use(auditContext)
+
+	// GraphQL не версионируется путём: схема развивается добавлением полей.
+	r.Handle("/graphql", graphQL)
+	r.Mount(v1Prefix, v1)
+	r.With(deprecated(legacy, v1Prefix)).Mount("/", v1)
+
+	return r, nil
+}
+
+func newV1Router(
+	userH *UserHandler,
+	projectH *ProjectHandler,
+	taskH *TaskHandler,
+	milestoneH *MilestoneHandler,
+	auditH *AuditHandler,
+	notificationH *NotificationHandler,
+	webhookH *WebhookHandler,
+	boardH *BoardHandler,
+	idem *Idempotency,
+) (chi.Router, error) {
+	spec := NewV1Spec()
+	validator, err := openapi.NewValidator(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	r := chi.NewRouter()
+	r.Use(validator.Middleware)
+	r.NotFound(notFound)
+	r.MethodNotAllowed(methodNotAllowed)
+
+	r.Get(specPath, spec.Handler())
+	r.Get(docsPath, openapi.DocsHandler(spec.Info.Title, v1Prefix+specPath))
 
 	r.Get("/users", userH.ListUsers)
 	r.Post("/users", userH.CreateUser)
 	r.Get("/users/{id}", userH.GetUser)
+	r.Get("/users/{id}/watched-tasks", taskH.ListWatched)
+	r.Get("/users/{id}/trashed-projects", projectH.ListTrashed)
+	r.Get("/users/{id}/overdue", taskH.ListOverdueByUser)
+	r.Get("/users/{id}/notifications", notificationH.List)
+	r.Post("/users/{id}/notifications/read-all", notificationH.MarkAllRead)
+	r.Get("/users/{id}/notification-preferences", notificationH.GetPreferences)
+	r.Patch("/users/{id}/notification-preferences", notificationH.UpdatePreferences)
 
 	r.Get("/projects", projectH.ListProjects)
-	r.Post("/projects", projectH.CreateProject)
+	r.With(idem.Handler).Post("/projects", projectH.CreateProject)
 	r.Get("/projects/{id}", projectH.GetProject)
+	r.Get("/projects/{id}/members", projectH.ListMembers)
 	r.Patch("/projects/{id}", projectH.UpdateProject)
 	r.Post("/projects/{id}/invite", projectH.Invite)
 	r.Get("/projects/{id}/tasks", taskH.ListByProject)
-	r.Post("/projects/{id}/tasks", taskH.CreateInProject)
+	r.With(idem.Handler).Post("/projects/{id}/tasks", taskH.CreateInProject)
 	r.Delete("/projects/{id}", projectH.DeleteProject)
+	r.Post("/projects/{id}/restore", projectH.RestoreProject)
+	r.Post("/projects/{id}/archive", projectH.ArchiveProject)
+	r.Post("/projects/{id}/unarchive", projectH.UnarchiveProject)
+	r.Get("/projects/{id}/trash", taskH.ListTrash)
+	r.Get("/projects/{id}/overdue", taskH.ListOverdueByProject)
+	r.Post("/projects/{id}/templates", projectH.SaveAsTemplate)
+	r.Post("/projects/{id}/clone", projectH.CloneProject)
+	r.Get("/projects/{id}/milestones", milestoneH.ListByProject)
+	r.Post("/projects/{id}/milestones", milestoneH.CreateInProject)
+	r.Get("/projects/{id}/audit", auditH.ListByProject)
+	r.Get("/projects/{id}/events", boardH.Stream)
+	r.Get("/projects/{id}/webhooks", webhookH.List)
+	r.Post("/projects/{id}/webhooks", webhookH.Create)
+
+	r.Get("/audit/{entityType}/{entityId}", auditH.ListByEntity)
+
+	r.Post("/notifications/{id}/read", notificationH.MarkRead)
+	r.Post("/notifications/{id}/unread", notificationH.MarkUnread)
+
+	r.Get("/webhooks/{id}", webhookH.Get)
+	r.Patch("/webhooks/{id}", webhookH.Update)
+	r.Delete("/webhooks/{id}", webhookH.Delete)
+	r.Get("/webhooks/{id}/deliveries", webhookH.Deliveries)
+	r.Post("/webhooks/{id}/deliveries/{deliveryId}/redeliver", webhookH.Redeliver)
+
+	r.Get("/templates", projectH.ListTemplates)
+	r.Get("/templates/{id}", projectH.GetTemplate)
+	r.Delete("/templates/{id}", projectH.DeleteTemplate)
+	r.Post("/templates/{id}/projects", projectH.CreateFromTemplate)
+
+	r.Get("/milestones/{id}", milestoneH.GetMilestone)
+	r.Patch("/milestones/{id}", milestoneH.UpdateMilestone)
+	r.Delete("/milestones/{id}", milestoneH.DeleteMilestone)
+	r.Get("/milestones/{id}/summary", milestoneH.Summary)
+	r.Post("/milestones/{id}/tasks", milestoneH.AssignTask)
+	r.Delete("/milestones/{id}/tasks/{taskId}", milestoneH.UnassignTask)
 
+	r.Get("/tasks/{id}", taskH.GetTask)
 	r.Patch("/tasks/{id}", taskH.UpdateTask)
 	r.Post("/tasks/{id}/assign", taskH.Assign)
+	r.Post("/tasks/{id}/assignees", taskH.Assign)
+	r.Post("/tasks/{id}/unassign", taskH.Unassign)
+	r.Delete("/tasks/{id}/assignees", taskH.UnassignAll)
+	r.Delete("/tasks/{id}/assignees/{userId}", taskH.Unassign)
+	r.Get("/tasks/{id}/assignment-history", taskH.AssignmentHistory)
+	r.Get("/tasks/{id}/activity", taskH.Activity)
+	r.Post("/tasks/{id}/watch", taskH.Watch)
+	r.Delete("/tasks/{id}/watch", taskH.Unwatch)
 	r.Delete("/tasks/{id}", taskH.DeleteTask)
+	r.Post("/tasks/{id}/restore", taskH.RestoreTask)
 
-	return r
+	if err := openapi.Check(r, spec); err != nil {
+		return nil, err
+	}
+	return r, nil
 }
@@ -0,0 +1,135 @@
+package http
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"project-manager-dashboard-go/internal/transport/http/openapi"
+)
+
+// graphQLStub отвечает 418, чтобы тест видел, что запрос дошёл до него.
+var graphQLStub = stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, _ *stdhttp.Request) {
+	w.WriteHeader(stdhttp.StatusTeapot)
+})
+
+// newTestRouter собирает роутер без зависимостей: запросы, которые
+// отклоняет валидатор, до обработчиков не доходят.
+func newTestRouter(t *testing.T) stdhttp.Handler {
+	t.Helper()
+	h, err := NewRouter(
+		&UserHandler{}, &ProjectHandler{}, &TaskHandler{}, &MilestoneHandler{},
+		&AuditHandler{}, &NotificationHandler{}, &WebhookHandler{}, &BoardHandler{},
+		graphQLStub, &Idempotency{}, Deprecation{},
+	)
+	if err != nil {
+		t.Fatalf("NewRouter: %v", err)
+	}
+	return h
+}
+
+func TestRoutesMatchSpec(t *testing.T) {
+	v1, err := newV1Router(
+		&UserHandler{}, &ProjectHandler{}, &TaskHandler{}, &MilestoneHandler{},
+		&AuditHandler{}, &NotificationHandler{}, &WebhookHandler{}, &BoardHandler{},
+		&Idempotency{},
+	)
+	if err != nil {
+		t.Fatalf("newV1Router: %v", err)
+	}
+	if err := openapi.Check(v1, NewV1Spec()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRouterValidatesRequests(t *testing.T) {
+	h := newTestRouter(t)
+
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		wantCode string
+	}{
+		{"bad path param", "GET", "/v1/projects/42", "", "invalid_parameters"},
+		{"bad query param", "GET", "/v1/users?limit=many", "", "invalid_parameters"},
+		{"bad body", "POST", "/v1/users", `{"email":1,"name":"John"}`, "invalid_body"},
+		{"legacy path", "GET", "/projects/42", "", "invalid_parameters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != stdhttp.StatusBadRequest {
+				t.Fatalf("status = %d, want 400; body %s", rec.Code, rec.Body)
+			}
+			var p openapi.Problem
+			if err := json.NewDecoder(rec.Body).Decode(&p); err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+			if p.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", p.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRouterUnmatched(t *testing.T) {
+	h := newTestRouter(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantStatus int
+		wantCode   string
+		wantAllow  string
+	}{
+		{"unknown path", "GET", "/v1/nope", stdhttp.StatusNotFound, codeNotFound, ""},
+		{"unknown legacy path", "GET", "/nope", stdhttp.StatusNotFound, codeNotFound, ""},
+		{"wrong method", "PUT", "/v1/users", stdhttp.StatusMethodNotAllowed, codeMethodNotAllowed, "GET, POST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != openapi.ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", ct, openapi.ProblemContentType)
+			}
+			if allow := rec.Header().Get("Allow"); allow != tt.wantAllow {
+				t.Errorf("Allow = %q, want %q", allow, tt.wantAllow)
+			}
+			var p openapi.Problem
+			if err := json.NewDecoder(rec.Body).Decode(&p); err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+			if p.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", p.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRouterMountsGraphQL(t *testing.T) {
+	h := newTestRouter(t)
+
+	for _, method := range []string{"GET", "POST"} {
+		req := httptest.NewRequest(method, "/graphql", nil)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		if rec.Code != stdhttp.StatusTeapot {
+			t.Errorf("%s /graphql: status = %d, want it served by the GraphQL handler", method, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,447 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+	"project-manager-dashboard-go/internal/transport/http/openapi"
+)
+
+// Пути документации API.
+const (
+	specPath = "/openapi.json"
+	docsPath = "/docs"
+)
+
+// statusResponse — короткий ответ {"status": "..."} у действий без сущности.
+type statusResponse struct {
+	Status string `json:"status"`
+}
+
+var (
+	pageParams = []*openapi.Parameter{
+		openapi.QueryParam("limit", openapi.Int(), "1..100, по умолчанию 50"),
+		openapi.QueryParam("cursor", openapi.String(), "курсор из заголовка Link (rel=\"next\" или rel=\"prev\") предыдущего ответа"),
+		openapi.QueryParam("total", openapi.Bool(), "вернуть общее число элементов в X-Total-Count"),
+		openapi.QueryParam("offset", openapi.Int(), "устарел, используйте cursor; с cursor не учитывается"),
+	}
+	fieldsParam       = openapi.QueryParam("fields", openapi.String(), "только перечисленные поля ответа через запятую, вложенные — через точку: id,name,tasks.title")
+	includeLimitParam = openapi.QueryParam("includeLimit", openapi.Int(), "1..100, по умолчанию 50: сколько элементов включённых коллекций отдавать у проекта")
+	actorHeader       = openapi.HeaderParam("X-Actor-ID", openapi.UUID(), "автор запроса")
+	ifMatchHeader     = openapi.HeaderParam("If-Match", openapi.String(), "ETag версии; при несовпадении — 412")
+	idempotencyHeader = openapi.HeaderParam(idempotencyKeyHeader, openapi.String(), "повтор с тем же ключом вернёт сохранённый ответ")
+)
+
+// pageHeaders — заголовки ответов постраничных списков.
+var pageHeaders = map[string]*openapi.Header{
+	"Link": {
+		Description: "ссылки на соседние страницы: rel=\"next\" и rel=\"prev\"",
+		Schema:      openapi.String(),
+	},
+	totalCountHeader: {
+		Description: "общее число элементов, если запрошено ?total=true",
+		Schema:      openapi.Int(),
+	},
+}
+
+func withPage(params ...*openapi.Parameter) []*openapi.Parameter {
+	return append(append([]*openapi.Parameter{}, pageParams...), params...)
+}
+
+// NewV1Spec описывает все маршруты /v1. NewRouter сверяет их с
+// документом и не запускается, если они разошлись.
+func NewV1Spec() *openapi.Document {
+	d := openapi.New(openapi.Info{
+		Title:   "Project Manager Dashboard API",
+		Version: "1.0.0",
+	}, v1Prefix)
+	ok := stdhttp.StatusOK
+	created := stdhttp.StatusCreated
+	noContent := stdhttp.StatusNoContent
+
+	// Users
+	d.Add("GET", "/users", openapi.Op{
+		ID: "listUsers", Summary: "Список пользователей", Tag: "users",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.UserDTO{}},
+	})
+	d.Add("POST", "/users", openapi.Op{
+		ID: "createUser", Summary: "Создать пользователя", Tag: "users",
+		Body:      dto.CreateUserRequest{},
+		Responses: map[int]any{created: dto.UserDTO{}},
+	})
+	d.Add("GET", "/users/{id}", openapi.Op{
+		ID: "getUser", Summary: "Пользователь", Tag: "users",
+		Responses: map[int]any{ok: dto.UserDTO{}},
+	})
+	d.Add("GET", "/users/{id}/watched-tasks", openapi.Op{
+		ID: "listWatchedTasks", Summary: "Задачи, за которыми следит пользователь", Tag: "tasks",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.TaskResponse{}},
+	})
+	d.Add("GET", "/users/{id}/trashed-projects", openapi.Op{
+		ID: "listTrashedProjects", Summary: "Удалённые проекты владельца", Tag: "trash",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.ProjectResponse{}},
+	})
+	d.Add("GET", "/users/{id}/overdue", openapi.Op{
+		ID: "listOverdueByUser", Summary: "Просроченные задачи исполнителя", Tag: "tasks",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.TaskResponse{}},
+	})
+	d.Add("GET", "/users/{id}/notifications", openapi.Op{
+		ID: "listNotifications", Summary: "Уведомления пользователя", Tag: "notifications",
+		Params:    withPage(openapi.QueryParam("unread", openapi.Bool(), "только непрочитанные")),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.NotificationResponse{}},
+	})
+	d.Add("POST", "/users/{id}/notifications/read-all", openapi.Op{
+		ID: "markAllNotificationsRead", Summary: "Прочитать все уведомления", Tag: "notifications",
+		Responses: map[int]any{ok: dto.MarkAllReadResponse{}},
+	})
+	d.Add("GET", "/users/{id}/notification-preferences", openapi.Op{
+		ID: "getNotificationPreferences", Summary: "Настройки уведомлений", Tag: "notifications",
+		Responses: map[int]any{ok: dto.NotificationSettingsResponse{}},
+	})
+	d.Add("PATCH", "/users/{id}/notification-preferences", openapi.Op{
+		ID: "updateNotificationPreferences", Summary: "Изменить настройки уведомлений", Tag: "notifications",
+		Body:      dto.UpdateNotificationPreferencesRequest{},
+		Responses: map[int]any{ok: dto.NotificationSettingsResponse{}},
+	})
+
+	// Projects
+	d.Add("GET", "/projects", openapi.Op{
+		ID: "listProjects", Summary: "Список проектов", Tag: "projects",
+		Params: withPage(openapi.QueryParam("archived", openapi.Enum("false", "true", "all"),
+			"по умолчанию архивные скрыты"),
+			openapi.QueryParam("include", openapi.String(), "связи через запятую: members, tasks, tasks.assignee; по умолчанию без связей"),
+			includeLimitParam, fieldsParam),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.ProjectResponse{}},
+	})
+	d.Add("POST", "/projects", openapi.Op{
+		ID: "createProject", Summary: "Создать проект", Tag: "projects",
+		Params:    []*openapi.Parameter{idempotencyHeader},
+		Body:      dto.CreateProjectRequest{},
+		Responses: map[int]any{created: dto.ProjectResponse{}},
+	})
+	d.Add("GET", "/projects/{id}", openapi.Op{
+		ID: "getProject", Summary: "Проект с участниками и задачами", Tag: "projects",
+		Params: []*openapi.Parameter{
+			openapi.QueryParam("include", openapi.String(), "связи через запятую: members, tasks, tasks.assignee; по умолчанию members,tasks"),
+			includeLimitParam, fieldsParam,
+		},
+		Responses: map[int]any{ok: dto.ProjectResponse{}},
+	})
+	d.Add("GET", "/projects/{id}/members", openapi.Op{
+		ID: "listProjectMembers", Summary: "Участники проекта", Tag: "projects",
+		Params:    withPage(fieldsParam),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.ProjectUserResponse{}},
+	})
+	d.Add("PATCH", "/projects/{id}", openapi.Op{
+		ID: "updateProject", Summary: "Изменить проект", Tag: "projects",
+		Params:    []*openapi.Parameter{ifMatchHeader},
+		Body:      dto.UpdateProjectRequest{},
+		Responses: map[int]any{ok: dto.ProjectResponse{}},
+	})
+	d.Add("DELETE", "/projects/{id}", openapi.Op{
+		ID: "deleteProject", Summary: "Удалить проект в корзину", Tag: "projects",
+		Params:    []*openapi.Parameter{ifMatchHeader},
+		Body:      dto.DeleteProjectRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+	d.Add("POST", "/projects/{id}/invite", openapi.Op{
+		ID: "inviteToProject", Summary: "Пригласить участника", Tag: "projects",
+		Body:      dto.InviteUserRequest{},
+		Responses: map[int]any{created: statusResponse{}},
+	})
+	d.Add("POST", "/projects/{id}/restore", openapi.Op{
+		ID: "restoreProject", Summary: "Восстановить проект из корзины", Tag: "trash",
+		Body:      dto.RestoreProjectRequest{},
+		Responses: map[int]any{ok: dto.ProjectResponse{}},
+	})
+	d.Add("POST", "/projects/{id}/archive", openapi.Op{
+		ID: "archiveProject", Summary: "Архивировать проект", Tag: "projects",
+		Body:      dto.ArchiveProjectRequest{},
+		Responses: map[int]any{ok: dto.ProjectResponse{}},
+	})
+	d.Add("POST", "/projects/{id}/unarchive", openapi.Op{
+		ID: "unarchiveProject", Summary: "Вернуть проект из архива", Tag: "projects",
+		Body:      dto.ArchiveProjectRequest{},
+		Responses: map[int]any{ok: dto.ProjectResponse{}},
+	})
+	d.Add("POST", "/projects/{id}/templates", openapi.Op{
+		ID: "saveProjectAsTemplate", Summary: "Сохранить проект как шаблон", Tag: "templates",
+		Body:      dto.SaveTemplateRequest{},
+		Responses: map[int]any{created: dto.TemplateResponse{}},
+	})
+	d.Add("POST", "/projects/{id}/clone", openapi.Op{
+		ID: "cloneProject", Summary: "Клонировать проект", Tag: "projects",
+		Body:      dto.CloneProjectRequest{},
+		Responses: map[int]any{created: dto.ProjectResponse{}},
+	})
+	d.Add("GET", "/projects/{id}/tasks", openapi.Op{
+		ID: "listProjectTasks", Summary: "Задачи проекта", Tag: "tasks",
+		Params: withPage(openapi.QueryParam("assignee", openapi.UUID(), "только задачи этого исполнителя"),
+			openapi.QueryParam("include", openapi.String(), "связи через запятую: assignee, watchers; по умолчанию assignee"),
+			fieldsParam),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.TaskResponse{}},
+	})
+	d.Add("POST", "/projects/{id}/tasks", openapi.Op{
+		ID: "createTask", Summary: "Создать задачу", Tag: "tasks",
+		Params:    []*openapi.Parameter{idempotencyHeader},
+		Body:      dto.CreateTaskRequest{},
+		Responses: map[int]any{created: dto.TaskResponse{}},
+	})
+	d.Add("GET", "/projects/{id}/trash", openapi.Op{
+		ID: "listTrashedTasks", Summary: "Удалённые задачи проекта", Tag: "trash",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.TaskResponse{}},
+	})
+	d.Add("GET", "/projects/{id}/overdue", openapi.Op{
+		ID: "listOverdueByProject", Summary: "Просроченные задачи проекта", Tag: "tasks",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.TaskResponse{}},
+	})
+	d.Add("GET", "/projects/{id}/milestones", openapi.Op{
+		ID: "listMilestones", Summary: "Milestones проекта", Tag: "milestones",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.MilestoneResponse{}},
+	})
+	d.Add("POST", "/projects/{id}/milestones", openapi.Op{
+		ID: "createMilestone", Summary: "Создать milestone", Tag: "milestones",
+		Body:      dto.CreateMilestoneRequest{},
+		Responses: map[int]any{created: dto.MilestoneResponse{}},
+	})
+	d.Add("GET", "/projects/{id}/audit", openapi.Op{
+		ID: "listProjectAudit", Summary: "Журнал изменений проекта", Tag: "audit",
+		Params:    auditParams(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.AuditEventResponse{}},
+	})
+	d.Add("GET", "/projects/{id}/events", openapi.Op{
+		ID: "streamProjectEvents", Summary: "События доски (Server-Sent Events)", Tag: "board",
+		Params: []*openapi.Parameter{
+			actorHeader,
+			openapi.QueryParam("actorId", openapi.UUID(), "автор, если нельзя передать заголовок"),
+			openapi.HeaderParam("Last-Event-ID", openapi.Int(), "продолжить после события с этим id (номер публикации)"),
+			openapi.QueryParam("lastEventId", openapi.Int(), "то же, что Last-Event-ID"),
+		},
+		Responses: map[int]any{ok: openapi.Content{Type: "text/event-stream"}},
+	})
+	d.Add("GET", "/projects/{id}/webhooks", openapi.Op{
+		ID: "listWebhooks", Summary: "Вебхуки проекта", Tag: "webhooks",
+		Params:    withPage(actorHeader),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.WebhookResponse{}},
+	})
+	d.Add("POST", "/projects/{id}/webhooks", openapi.Op{
+		ID: "createWebhook", Summary: "Создать вебхук", Tag: "webhooks",
+		Body:      dto.CreateWebhookRequest{},
+		Responses: map[int]any{created: dto.WebhookResponse{}},
+	})
+
+	// Audit
+	d.Add("GET", "/audit/{entityType}/{entityId}", openapi.Op{
+		ID: "listEntityAudit", Summary: "Журнал изменений сущности", Tag: "audit",
+		Params: append(auditParams(),
+			openapi.PathParam("entityType", openapi.String())),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.AuditEventResponse{}},
+	})
+
+	// Notifications
+	d.Add("POST", "/notifications/{id}/read", openapi.Op{
+		ID: "markNotificationRead", Summary: "Отметить прочитанным", Tag: "notifications",
+		Body:      dto.MarkNotificationRequest{},
+		Responses: map[int]any{ok: dto.NotificationResponse{}},
+	})
+	d.Add("POST", "/notifications/{id}/unread", openapi.Op{
+		ID: "markNotificationUnread", Summary: "Отметить непрочитанным", Tag: "notifications",
+		Body:      dto.MarkNotificationRequest{},
+		Responses: map[int]any{ok: dto.NotificationResponse{}},
+	})
+
+	// Webhooks
+	d.Add("GET", "/webhooks/{id}", openapi.Op{
+		ID: "getWebhook", Summary: "Вебхук", Tag: "webhooks",
+		Params:    []*openapi.Parameter{actorHeader},
+		Responses: map[int]any{ok: dto.WebhookResponse{}},
+	})
+	d.Add("PATCH", "/webhooks/{id}", openapi.Op{
+		ID: "updateWebhook", Summary: "Изменить вебхук", Tag: "webhooks",
+		Body:      dto.UpdateWebhookRequest{},
+		Responses: map[int]any{ok: dto.WebhookResponse{}},
+	})
+	d.Add("DELETE", "/webhooks/{id}", openapi.Op{
+		ID: "deleteWebhook", Summary: "Удалить вебхук", Tag: "webhooks",
+		Body:      dto.WebhookActorRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+	d.Add("GET", "/webhooks/{id}/deliveries", openapi.Op{
+		ID: "listWebhookDeliveries", Summary: "Журнал доставок", Tag: "webhooks",
+		Params:    withPage(actorHeader),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.WebhookDeliveryResponse{}},
+	})
+	d.Add("POST", "/webhooks/{id}/deliveries/{deliveryId}/redeliver", openapi.Op{
+		ID: "redeliverWebhook", Summary: "Повторить доставку", Tag: "webhooks",
+		Body:      dto.WebhookActorRequest{},
+		Responses: map[int]any{stdhttp.StatusAccepted: dto.WebhookDeliveryResponse{}},
+	})
+
+	// Templates
+	d.Add("GET", "/templates", openapi.Op{
+		ID: "listTemplates", Summary: "Шаблоны проектов", Tag: "templates",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.TemplateResponse{}},
+	})
+	d.Add("GET", "/templates/{id}", openapi.Op{
+		ID: "getTemplate", Summary: "Шаблон", Tag: "templates",
+		Responses: map[int]any{ok: dto.TemplateResponse{}},
+	})
+	d.Add("DELETE", "/templates/{id}", openapi.Op{
+		ID: "deleteTemplate", Summary: "Удалить шаблон", Tag: "templates",
+		Body:      dto.DeleteTemplateRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+	d.Add("POST", "/templates/{id}/projects", openapi.Op{
+		ID: "createProjectFromTemplate", Summary: "Создать проект из шаблона", Tag: "templates",
+		Body:      dto.CreateFromTemplateRequest{},
+		Responses: map[int]any{created: dto.ProjectResponse{}},
+	})
+
+	// Milestones
+	d.Add("GET", "/milestones/{id}", openapi.Op{
+		ID: "getMilestone", Summary: "Milestone", Tag: "milestones",
+		Responses: map[int]any{ok: dto.MilestoneResponse{}},
+	})
+	d.Add("PATCH", "/milestones/{id}", openapi.Op{
+		ID: "updateMilestone", Summary: "Изменить milestone", Tag: "milestones",
+		Body:      dto.UpdateMilestoneRequest{},
+		Responses: map[int]any{ok: dto.MilestoneResponse{}},
+	})
+	d.Add("DELETE", "/milestones/{id}", openapi.Op{
+		ID: "deleteMilestone", Summary: "Удалить milestone", Tag: "milestones",
+		Body:      dto.DeleteMilestoneRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+	d.Add("GET", "/milestones/{id}/summary", openapi.Op{
+		ID: "getMilestoneSummary", Summary: "Прогресс milestone", Tag: "milestones",
+		Responses: map[int]any{ok: dto.MilestoneSummaryResponse{}},
+	})
+	d.Add("POST", "/milestones/{id}/tasks", openapi.Op{
+		ID: "addMilestoneTask", Summary: "Добавить задачу в milestone", Tag: "milestones",
+		Body:      dto.MilestoneTaskRequest{},
+		Responses: map[int]any{created: statusResponse{}},
+	})
+	d.Add("DELETE", "/milestones/{id}/tasks/{taskId}", openapi.Op{
+		ID: "removeMilestoneTask", Summary: "Убрать задачу из milestone", Tag: "milestones",
+		Body:      dto.DeleteMilestoneRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+
+	// Tasks
+	d.Add("GET", "/tasks/{id}", openapi.Op{
+		ID: "getTask", Summary: "Задача", Tag: "tasks",
+		Params: []*openapi.Parameter{
+			openapi.QueryParam("include", openapi.String(), "связи через запятую: assignee, watchers; по умолчанию обе"),
+			fieldsParam,
+		},
+		Responses: map[int]any{ok: dto.TaskResponse{}},
+	})
+	d.Add("PATCH", "/tasks/{id}", openapi.Op{
+		ID: "updateTask", Summary: "Изменить задачу", Tag: "tasks",
+		Params:    []*openapi.Parameter{ifMatchHeader},
+		Body:      dto.UpdateTaskRequest{},
+		Responses: map[int]any{ok: dto.TaskResponse{}},
+	})
+	d.Add("DELETE", "/tasks/{id}", openapi.Op{
+		ID: "deleteTask", Summary: "Удалить задачу в корзину", Tag: "tasks",
+		Params:    []*openapi.Parameter{ifMatchHeader},
+		Body:      dto.DeleteTaskRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+	d.Add("POST", "/tasks/{id}/restore", openapi.Op{
+		ID: "restoreTask", Summary: "Восстановить задачу из корзины", Tag: "trash",
+		Body:      dto.RestoreTaskRequest{},
+		Responses: map[int]any{ok: dto.TaskResponse{}},
+	})
+	d.Add("POST", "/tasks/{id}/assign", openapi.Op{
+		ID: "assignTaskLegacy", Summary: "Назначить исполнителя (устаревший путь)", Tag: "tasks",
+		Body:      dto.AssignTaskRequest{},
+		Responses: map[int]any{created: statusResponse{}},
+	})
+	d.Add("POST", "/tasks/{id}/assignees", openapi.Op{
+		ID: "assignTask", Summary: "Назначить исполнителя", Tag: "tasks",
+		Body:      dto.AssignTaskRequest{},
+		Responses: map[int]any{created: statusResponse{}},
+	})
+	d.Add("POST", "/tasks/{id}/unassign", openapi.Op{
+		ID: "unassignTaskLegacy", Summary: "Снять исполнителя (устаревший путь)", Tag: "tasks",
+		Body:      dto.UnassignTaskRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+	d.Add("DELETE", "/tasks/{id}/assignees", openapi.Op{
+		ID: "unassignAll", Summary: "Снять всех исполнителей", Tag: "tasks",
+		Body:      dto.UnassignTaskRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+	d.Add("DELETE", "/tasks/{id}/assignees/{userId}", openapi.Op{
+		ID: "unassignTask", Summary: "Снять исполнителя", Tag: "tasks",
+		Body:      dto.UnassignTaskRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+	d.Add("GET", "/tasks/{id}/assignment-history", openapi.Op{
+		ID: "getAssignmentHistory", Summary: "История назначений", Tag: "tasks",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.AssignmentEventResponse{}},
+	})
+	d.Add("GET", "/tasks/{id}/activity", openapi.Op{
+		ID: "getTaskActivity", Summary: "Лента активности задачи", Tag: "tasks",
+		Params:    withPage(),
+		Headers:   pageHeaders,
+		Responses: map[int]any{ok: []dto.TaskActivityResponse{}},
+	})
+	d.Add("POST", "/tasks/{id}/watch", openapi.Op{
+		ID: "watchTask", Summary: "Следить за задачей", Tag: "tasks",
+		Body:      dto.WatchTaskRequest{},
+		Responses: map[int]any{created: statusResponse{}},
+	})
+	d.Add("DELETE", "/tasks/{id}/watch", openapi.Op{
+		ID: "unwatchTask", Summary: "Перестать следить", Tag: "tasks",
+		Body:      dto.WatchTaskRequest{},
+		Responses: map[int]any{noContent: nil},
+	})
+
+	// Документация
+	d.Add("GET", specPath, openapi.Op{
+		ID: "getOpenAPI", Summary: "Этот документ", Tag: "docs",
+		Responses: map[int]any{ok: openapi.Content{Type: "application/json"}},
+	})
+	d.Add("GET", docsPath, openapi.Op{
+		ID: "getDocs", Summary: "Страница документации", Tag: "docs",
+		Responses: map[int]any{ok: openapi.Content{Type: "text/html"}},
+	})
+
+	return d
+}
+
+func auditParams() []*openapi.Parameter {
+	return withPage(
+		openapi.QueryParam("actor", openapi.UUID(), "автор изменений"),
+		openapi.QueryParam("from", openapi.DateTime(), ""),
+		openapi.QueryParam("to", openapi.DateTime(), ""),
+	)
+}
@@ -4,12 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	stdhttp "net/http"
-	"project-manager-dashboard-go/internal/transport/http/dto"
-	"strconv"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
+	"project-manager-dashboard-go/internal/app/usecase/audit"
 	"project-manager-dashboard-go/internal/app/usecase/task"
 )
 
@@ -26,50 +27,61 @@ func (h *TaskHandler) ListByProject(w stdhttp.ResponseWriter, r *stdhttp.Request
 
 	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid project id"})
+		writeBadRequest(w, "invalid project id")
 		return
 	}
 
-	limit, offset := 50, 0
-	if s := r.URL.Query().Get("limit"); s != "" {
-		if v, err := strconv.Atoi(s); err == nil {
-			limit = v
-		}
+	p := parsePage(r)
+	inc, err := parseTaskInclude(r, "assignee")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	fields, err := parseFields(r, dto.TaskResponse{})
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
 	}
-	if s := r.URL.Query().Get("offset"); s != "" {
-		if v, err := strconv.Atoi(s); err == nil {
-			offset = v
+
+	var filter task.ListFilter
+	if s := r.URL.Query().Get("assignee"); s != "" {
+		assigneeID, err := uuid.Parse(s)
+		if err != nil {
+			writeBadRequest(w, "invalid assignee")
+			return
 		}
+		filter.AssigneeID = &assigneeID
 	}
 
-	items, err := h.uc.ListByProject(ctx, projectID, limit, offset)
+	res, err := h.uc.ListByProject(ctx, projectID, filter, inc, p)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusInternalServerError, map[string]string{"error": err.Error()})
+		writeError(w, err)
 		return
 	}
 
-	out := make([]dto.TaskResponse, 0, len(items))
-	for _, t := range items {
-		var assignee *dto.TaskAssigneeResponse
-		if t.Assignee != nil {
-			assignee = &dto.TaskAssigneeResponse{
-				ID:    t.Assignee.UserID,
-				Name:  t.Assignee.Name,
-				Email: t.Assignee.Email,
-			}
-		}
-
-		out = append(out, dto.TaskResponse{
+	out := make([]dto.TaskResponse, 0, len(res.Items))
+	for _, t := range res.Items {
+		item := dto.TaskResponse{
 			ID:          t.ID,
 			Title:       t.Title,
 			Description: t.Description,
 			Status:      t.Status,
 			CreatedAt:   t.CreatedAt,
-			Assignee:    assignee,
-		})
+			Position:    t.Position,
+			MilestoneID: t.MilestoneID,
+			DueDate:     t.DueDate,
+			Version:     t.Version,
+		}
+		if inc.Assignees {
+			item.Assignees = toAssigneeResponses(t.Assignees)
+		}
+		if inc.Watchers {
+			item.Watchers = toWatcherResponses(t.Watchers)
+		}
+		out = append(out, item)
 	}
 
-	writeJSON(w, stdhttp.StatusOK, out)
+	writePage(w, r, res, fields.apply(out))
 }
 
 func (h *TaskHandler) CreateInProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
@@ -77,23 +89,42 @@ func (h *TaskHandler) CreateInProject(w stdhttp.ResponseWriter, r *stdhttp.Reque
 
 	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid project id"})
+		writeBadRequest(w, "invalid project id")
 		return
 	}
 
 	var req dto.CreateTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid json"})
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
-	created, err := h.uc.CreateInProject(ctx, projectID, task.CreateInput{
+	in := task.CreateInput{
 		Title:       req.Title,
 		Description: req.Description,
 		Status:      req.Status,
-	})
+	}
+	if req.DueDate != nil {
+		due, err := time.Parse(time.RFC3339, *req.DueDate)
+		if err != nil {
+			writeBadRequest(w, "invalid dueDate")
+			return
+		}
+		in.DueDate = &due
+	}
+	if req.ActorID != "" {
+		creatorID, err := uuid.Parse(req.ActorID)
+		if err != nil {
+			writeBadRequest(w, "invalid actorId")
+			return
+		}
+		ctx = audit.WithActor(ctx, creatorID)
+		in.CreatorID = &creatorID
+	}
+
+	created, err := h.uc.CreateInProject(ctx, projectID, in)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": err.Error()})
+		writeError(w, err)
 		return
 	}
 
@@ -103,6 +134,10 @@ func (h *TaskHandler) CreateInProject(w stdhttp.ResponseWriter, r *stdhttp.Reque
 		Description: created.Description,
 		Status:      created.Status,
 		CreatedAt:   created.CreatedAt,
+		Position:    created.Position,
+		ProjectID:   &projectID,
+		DueDate:     created.DueDate,
+		Version:     created.Version,
 	})
 }
 
@@ -111,32 +146,65 @@ func (h *TaskHandler) UpdateTask(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid id"})
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		writeBadRequest(w, err.Error())
 		return
 	}
 
 	var req dto.UpdateTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid json"})
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
-	updated, err := h.uc.Update(ctx, id, task.UpdateInput{
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      req.Status,
-		Position:    req.Position,
-	})
+	in := task.UpdateInput{
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          req.Status,
+		Position:        req.Position,
+		ExpectedVersion: expectedVersion,
+	}
+	if req.DueDate != nil {
+		if *req.DueDate == "" {
+			in.ClearDueDate = true
+		} else {
+			due, err := time.Parse(time.RFC3339, *req.DueDate)
+			if err != nil {
+				writeBadRequest(w, "invalid dueDate")
+				return
+			}
+			in.DueDate = &due
+		}
+	}
+
+	updated, err := h.uc.Update(ctx, id, in)
 	if err != nil {
-		if errors.Is(err, errors.New("task not found")) {
-			writeJSON(w, stdhttp.StatusNotFound, map[string]string{"error": "not found"})
+		if errors.Is(err, task.ErrVersionConflict) {
+			h.writeTaskConflict(w, r, id)
 			return
 		}
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": err.Error()})
+		writeError(w, err)
 		return
 	}
 
-	writeJSON(w, stdhttp.StatusOK, updated)
+	setETag(w, updated.Version)
+	writeJSON(w, stdhttp.StatusOK, toTaskDetailResponse(updated))
+}
+
+// writeTaskConflict отвечает 412 с текущим состоянием задачи.
+func (h *TaskHandler) writeTaskConflict(w stdhttp.ResponseWriter, r *stdhttp.Request, id uuid.UUID) {
+	t, err := h.uc.GetByID(r.Context(), id, task.Include{Assignees: true, Watchers: true})
+	if err != nil {
+		writeError(w, task.ErrVersionConflict)
+		return
+	}
+	setETag(w, t.Version)
+	writeJSON(w, stdhttp.StatusPreconditionFailed, toTaskDetailResponse(t))
 }
 
 func (h *TaskHandler) Assign(w stdhttp.ResponseWriter, r *stdhttp.Request) {
@@ -144,21 +212,22 @@ func (h *TaskHandler) Assign(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 
 	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid task id"})
+		writeBadRequest(w, "invalid task id")
 		return
 	}
 
 	var req dto.AssignTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid json"})
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
 	actorID, err := uuid.Parse(req.ActorID)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid actorId"})
+		writeBadRequest(w, "invalid actorId")
 		return
 	}
+	ctx = audit.WithActor(ctx, actorID)
 
 	target := req.UserID
 	if target == "" {
@@ -167,62 +236,175 @@ func (h *TaskHandler) Assign(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 
 	userID, err := uuid.Parse(target)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid userId"})
+		writeBadRequest(w, "invalid userId")
 		return
 	}
 
-	err = h.uc.Assign(ctx, taskID, actorID, userID)
+	err = h.uc.Assign(ctx, taskID, actorID, userID, req.Role)
 	if err != nil {
-		switch {
-		case errors.Is(err, errors.New("task not found")):
-			writeJSON(w, stdhttp.StatusNotFound, map[string]string{"error": "task not found"})
-		case errors.Is(err, errors.New("user not found")):
-			writeJSON(w, stdhttp.StatusNotFound, map[string]string{"error": "user not found"})
-		case errors.Is(err, errors.New("user not in project")):
-			writeJSON(w, stdhttp.StatusForbidden, map[string]string{"error": "user not in project"})
-		case errors.Is(err, errors.New("forbidden")):
-			writeJSON(w, stdhttp.StatusForbidden, map[string]string{"error": "forbidden"})
-		case errors.Is(err, errors.New("already assigned")):
-			writeJSON(w, stdhttp.StatusConflict, map[string]string{"error": "already assigned"})
-		default:
-			writeJSON(w, stdhttp.StatusInternalServerError, map[string]string{"error": err.Error()})
-		}
+		writeError(w, err)
 		return
 	}
 
 	writeJSON(w, stdhttp.StatusCreated, map[string]string{"status": "assigned"})
 }
 
+func (h *TaskHandler) Unassign(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid task id")
+		return
+	}
+
+	var req dto.UnassignTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	target := chi.URLParam(r, "userId")
+	if target == "" {
+		target = req.UserID
+	}
+	if target == "" {
+		target = req.ActorID
+	}
+
+	userID, err := uuid.Parse(target)
+	if err != nil {
+		writeBadRequest(w, "invalid userId")
+		return
+	}
+
+	if err := h.uc.Unassign(ctx, taskID, actorID, userID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (h *TaskHandler) UnassignAll(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid task id")
+		return
+	}
+
+	var req dto.UnassignTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	if err := h.uc.UnassignAll(ctx, taskID, actorID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (h *TaskHandler) AssignmentHistory(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid task id")
+		return
+	}
+
+	p := parsePage(r)
+
+	res, err := h.uc.AssignmentHistory(ctx, taskID, p)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.AssignmentEventResponse, 0, len(res.Items))
+	for _, e := range res.Items {
+		out = append(out, dto.AssignmentEventResponse{
+			ID:         e.ID,
+			Action:     e.Action,
+			ActorID:    e.ActorID,
+			FromUserID: e.FromUserID,
+			ToUserID:   e.ToUserID,
+			Role:       e.Role,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+	writePage(w, r, res, out)
+}
+
 func (h *TaskHandler) DeleteTask(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 	ctx := r.Context()
 
 	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid task id"})
+		writeBadRequest(w, "invalid task id")
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		writeBadRequest(w, err.Error())
 		return
 	}
 
 	var req dto.DeleteTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid json"})
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
 	actorID, err := uuid.Parse(req.ActorID)
 	if err != nil {
-		writeJSON(w, stdhttp.StatusBadRequest, map[string]string{"error": "invalid actorId"})
+		writeBadRequest(w, "invalid actorId")
 		return
 	}
+	ctx = audit.WithActor(ctx, actorID)
 
-	err = h.uc.Delete(ctx, taskID, actorID)
+	err = h.uc.Delete(ctx, taskID, actorID, expectedVersion)
 	if err != nil {
-		switch {
-
-		default:
-			writeJSON(w, stdhttp.StatusInternalServerError, map[string]string{"error": err.Error()})
+		if errors.Is(err, task.ErrVersionConflict) {
+			h.writeTaskConflict(w, r, taskID)
+			return
 		}
+		writeError(w, err)
 		return
 	}
 
 	w.WriteHeader(stdhttp.StatusNoContent)
 }
+
+func toAssigneeResponses(items []task.TaskAssigneeDTO) []dto.TaskAssigneeResponse {
+	out := make([]dto.TaskAssigneeResponse, 0, len(items))
+	for _, a := range items {
+		out = append(out, dto.TaskAssigneeResponse{
+			ID:    a.UserID,
+			Name:  a.Name,
+			Email: a.Email,
+			Role:  a.Role,
+		})
+	}
+	return out
+}
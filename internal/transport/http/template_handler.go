@@ -0,0 +1,228 @@
+package http
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/project"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+func (h *ProjectHandler) SaveAsTemplate(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	var req dto.SaveTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	t, err := h.uc.SaveAsTemplate(ctx, projectID, project.SaveTemplateInput{
+		Name:           req.Name,
+		Description:    req.Description,
+		ActorID:        actorID,
+		IncludeMembers: req.IncludeMembers,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, toTemplateResponse(t))
+}
+
+func (h *ProjectHandler) ListTemplates(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	res, err := h.uc.ListTemplates(r.Context(), parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.TemplateResponse, 0, len(res.Items))
+	for _, t := range res.Items {
+		out = append(out, toTemplateResponse(t))
+	}
+	writePage(w, r, res, out)
+}
+
+func (h *ProjectHandler) GetTemplate(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	t, err := h.uc.GetTemplate(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, toTemplateResponse(t))
+}
+
+func (h *ProjectHandler) DeleteTemplate(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	var req dto.DeleteTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx := audit.WithActor(r.Context(), actorID)
+
+	if err := h.uc.DeleteTemplate(ctx, id, actorID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (h *ProjectHandler) CreateFromTemplate(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	templateID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid template id")
+		return
+	}
+
+	var req dto.CreateFromTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	ownerID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		writeBadRequest(w, "invalid userId")
+		return
+	}
+	ctx = audit.WithActor(ctx, ownerID)
+
+	created, err := h.uc.CreateFromTemplate(ctx, templateID, project.FromTemplateInput{
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerID:     ownerID,
+		StartDate:   req.StartDate,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, toProjectWithTasksResponse(created))
+}
+
+func (h *ProjectHandler) CloneProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	ctx := r.Context()
+
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	var req dto.CloneProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx = audit.WithActor(ctx, actorID)
+
+	created, err := h.uc.Clone(ctx, projectID, project.CloneInput{
+		Name:           req.Name,
+		Description:    req.Description,
+		ActorID:        actorID,
+		StartDate:      req.StartDate,
+		IncludeMembers: req.IncludeMembers,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, toProjectWithTasksResponse(created))
+}
+
+func toTemplateResponse(t project.TemplateDTO) dto.TemplateResponse {
+	out := dto.TemplateResponse{
+		ID:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedBy:   t.CreatedBy,
+		CreatedAt:   t.CreatedAt,
+	}
+	for _, tt := range t.Tasks {
+		out.Tasks = append(out.Tasks, dto.TemplateTaskResponse{
+			Title:         tt.Title,
+			Description:   tt.Description,
+			Priority:      tt.Priority,
+			Position:      tt.Position,
+			DueOffsetDays: tt.DueOffsetDays,
+		})
+	}
+	for _, m := range t.Members {
+		out.Members = append(out.Members, dto.TemplateMemberResponse{
+			UserID: m.UserID,
+			Role:   m.Role,
+		})
+	}
+	return out
+}
+
+func toProjectWithTasksResponse(p project.ProjectDTO) dto.ProjectResponse {
+	tasks := make([]dto.TaskResponse, 0, len(p.Tasks))
+	for _, t := range p.Tasks {
+		tasks = append(tasks, dto.TaskResponse{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      t.Status,
+			CreatedAt:   t.CreatedAt,
+			Position:    t.Position,
+		})
+	}
+
+	return dto.ProjectResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		Tasks:       tasks,
+	}
+}
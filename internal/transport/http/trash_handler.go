@@ -0,0 +1,141 @@
+package http
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+func (h *TaskHandler) ListTrash(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	res, err := h.uc.ListTrash(r.Context(), projectID, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.TaskResponse, 0, len(res.Items))
+	for _, t := range res.Items {
+		out = append(out, dto.TaskResponse{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      t.Status,
+			CreatedAt:   t.CreatedAt,
+			Assignees:   []dto.TaskAssigneeResponse{},
+			Position:    t.Position,
+			MilestoneID: t.MilestoneID,
+			DeletedAt:   t.DeletedAt,
+		})
+	}
+	writePage(w, r, res, out)
+}
+
+func (h *TaskHandler) RestoreTask(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid task id")
+		return
+	}
+
+	var req dto.RestoreTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx := audit.WithActor(r.Context(), actorID)
+
+	t, err := h.uc.Restore(ctx, taskID, actorID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, dto.TaskResponse{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      t.Status,
+		CreatedAt:   t.CreatedAt,
+		Assignees:   []dto.TaskAssigneeResponse{},
+		Position:    t.Position,
+		MilestoneID: t.MilestoneID,
+		ProjectID:   &t.ProjectID,
+	})
+}
+
+func (h *ProjectHandler) ListTrashed(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid user id")
+		return
+	}
+
+	res, err := h.uc.ListTrashed(r.Context(), userID, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.ProjectResponse, 0, len(res.Items))
+	for _, p := range res.Items {
+		out = append(out, dto.ProjectResponse{
+			ID:          p.ID,
+			Name:        p.Name,
+			Description: p.Description,
+			CreatedAt:   p.CreatedAt,
+			DeletedAt:   p.DeletedAt,
+		})
+	}
+	writePage(w, r, res, out)
+}
+
+func (h *ProjectHandler) RestoreProject(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	var req dto.RestoreProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+	ctx := audit.WithActor(r.Context(), actorID)
+
+	p, err := h.uc.Restore(ctx, projectID, actorID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, dto.ProjectResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+	})
+}
@@ -4,7 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"project-manager-dashboard-go/internal/app/usecase/user"
-	"project-manager-dashboard-go/internal/transport/http/dto"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
 	"project-manager-dashboard-go/internal/transport/http/mapper"
 
 	"github.com/go-chi/chi/v5"
@@ -25,29 +25,23 @@ func (h *UserHandler) Health(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
-	users, err := h.uc.List(r.Context(), 50)
+	res, err := h.uc.List(r.Context(), parsePage(r))
 	if err != nil {
-		http.Error(w, "internal error", http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
-	out := make([]dto.UserDTO, 0, len(users))
-	for _, u := range users {
+	out := make([]dto.UserDTO, 0, len(res.Items))
+	for _, u := range res.Items {
 		out = append(out, mapper.ToUserDTO(u))
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(out)
+	writePage(w, r, res, out)
 }
 
 func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	var req dto.CreateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "bad json", http.StatusBadRequest)
-		return
-	}
-	if req.Email == "" || req.Name == "" {
-		http.Error(w, "email and name required", http.StatusBadRequest)
+		writeBadRequest(w, "invalid json")
 		return
 	}
 
@@ -57,29 +51,26 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		Country: req.Country,
 	})
 	if err != nil {
-		http.Error(w, "bad request", http.StatusBadRequest)
+		writeError(w, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
-	_ = json.NewEncoder(w).Encode(mapper.ToUserDTO(u))
+	writeJSON(w, http.StatusCreated, mapper.ToUserDTO(u))
 }
 
 func (h *UserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		http.Error(w, "invalid id", http.StatusNotFound)
+		writeBadRequest(w, "invalid id")
 		return
 	}
 
 	u, err := h.uc.GetByID(r.Context(), id)
 	if err != nil {
-		http.Error(w, "not found", http.StatusNotFound)
+		writeError(w, err)
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(mapper.ToUserDTO(u))
+	writeJSON(w, http.StatusOK, mapper.ToUserDTO(u))
 }
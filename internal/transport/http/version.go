@@ -0,0 +1,41 @@
+package http
+
+import (
+	"fmt"
+	stdhttp "net/http"
+	"time"
+)
+
+const v1Prefix = "/v1"
+
+// Deprecation — сроки для путей без версии: с Since они объявлены
+// устаревшими, после Sunset могут быть удалены. Нулевые значения не
+// выводятся в заголовки.
+type Deprecation struct {
+	Since  time.Time
+	Sunset time.Time
+}
+
+// deprecated помечает ответы устаревших путей заголовками Deprecation
+// (RFC 9745), Sunset (RFC 8594) и ссылкой на тот же ресурс в successor.
+func deprecated(d Deprecation, successor string) func(stdhttp.Handler) stdhttp.Handler {
+	return func(next stdhttp.Handler) stdhttp.Handler {
+		return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+			h := w.Header()
+			if d.Since.IsZero() {
+				h.Set("Deprecation", "true")
+			} else {
+				h.Set("Deprecation", fmt.Sprintf("@%d", d.Since.Unix()))
+			}
+			if !d.Sunset.IsZero() {
+				h.Set("Sunset", d.Sunset.UTC().Format(stdhttp.TimeFormat))
+			}
+			link := successor + r.URL.Path
+			if r.URL.RawQuery != "" {
+				link += "?" + r.URL.RawQuery
+			}
+			h.Add("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", link))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
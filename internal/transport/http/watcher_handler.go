@@ -0,0 +1,166 @@
+package http
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/task"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+func (h *TaskHandler) GetTask(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid id")
+		return
+	}
+
+	inc, err := parseTaskInclude(r, "assignee,watchers")
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+	fields, err := parseFields(r, dto.TaskResponse{})
+	if err != nil {
+		writeBadRequest(w, err.Error())
+		return
+	}
+
+	t, err := h.uc.GetByID(r.Context(), id, inc)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := toTaskDetailResponse(t)
+	if !inc.Assignees {
+		out.Assignees = nil
+	}
+	setETag(w, t.Version)
+	writeJSON(w, stdhttp.StatusOK, fields.apply(out))
+}
+
+// parseTaskInclude: assignee — исполнители, watchers — наблюдатели.
+func parseTaskInclude(r *stdhttp.Request, def string) (task.Include, error) {
+	set, err := parseInclude(r, def, "assignee", "watchers")
+	if err != nil {
+		return task.Include{}, err
+	}
+	return task.Include{Assignees: set["assignee"], Watchers: set["watchers"]}, nil
+}
+
+func toTaskDetailResponse(t task.TaskDTO) dto.TaskResponse {
+	return dto.TaskResponse{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      t.Status,
+		CreatedAt:   t.CreatedAt,
+		Assignees:   toAssigneeResponses(t.Assignees),
+		Position:    t.Position,
+		MilestoneID: t.MilestoneID,
+		ProjectID:   &t.ProjectID,
+		Watchers:    toWatcherResponses(t.Watchers),
+		DueDate:     t.DueDate,
+		Version:     t.Version,
+	}
+}
+
+func toWatcherResponses(rows []task.TaskWatcherDTO) []dto.TaskWatcherResponse {
+	out := make([]dto.TaskWatcherResponse, 0, len(rows))
+	for _, wt := range rows {
+		out = append(out, dto.TaskWatcherResponse{
+			ID:        wt.UserID,
+			Name:      wt.Name,
+			Email:     wt.Email,
+			WatchedAt: wt.WatchedAt,
+		})
+	}
+	return out
+}
+
+func (h *TaskHandler) Watch(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	taskID, actorID, ok := parseWatchRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.Watch(r.Context(), taskID, actorID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, map[string]string{"status": "watching"})
+}
+
+func (h *TaskHandler) Unwatch(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	taskID, actorID, ok := parseWatchRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.uc.Unwatch(r.Context(), taskID, actorID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (h *TaskHandler) ListWatched(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid user id")
+		return
+	}
+
+	p := parsePage(r)
+
+	res, err := h.uc.ListWatched(r.Context(), userID, p)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.TaskResponse, 0, len(res.Items))
+	for _, t := range res.Items {
+		projectID := t.ProjectID
+		out = append(out, dto.TaskResponse{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      t.Status,
+			CreatedAt:   t.CreatedAt,
+			Position:    t.Position,
+			MilestoneID: t.MilestoneID,
+			ProjectID:   &projectID,
+			DueDate:     t.DueDate,
+		})
+	}
+	writePage(w, r, res, out)
+}
+
+func parseWatchRequest(w stdhttp.ResponseWriter, r *stdhttp.Request) (uuid.UUID, uuid.UUID, bool) {
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid task id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	var req dto.WatchTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return taskID, actorID, true
+}
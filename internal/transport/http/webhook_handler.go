@@ -0,0 +1,253 @@
+package http
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"project-manager-dashboard-go/internal/app/usecase/audit"
+	"project-manager-dashboard-go/internal/app/usecase/webhook"
+	dto "project-manager-dashboard-go/internal/transport/http/dto/v1"
+)
+
+type WebhookHandler struct {
+	uc webhook.WebhookService
+}
+
+func NewWebhookHandler(uc webhook.WebhookService) *WebhookHandler {
+	return &WebhookHandler{uc: uc}
+}
+
+func (h *WebhookHandler) Create(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+
+	var req dto.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+
+	wh, err := h.uc.Create(r.Context(), projectID, webhook.CreateInput{
+		ActorID: actorID,
+		URL:     req.URL,
+		Secret:  req.Secret,
+		Events:  req.Events,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusCreated, toWebhookResponse(wh))
+}
+
+// List: GET /projects/{id}/webhooks, автор — из заголовка X-Actor-ID.
+func (h *WebhookHandler) List(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	projectID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid project id")
+		return
+	}
+	actorID, ok := audit.ActorFrom(r.Context())
+	if !ok {
+		writeBadRequest(w, "X-Actor-ID header is required")
+		return
+	}
+
+	res, err := h.uc.List(r.Context(), projectID, actorID, parsePage(r))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.WebhookResponse, 0, len(res.Items))
+	for _, wh := range res.Items {
+		out = append(out, toWebhookResponse(wh))
+	}
+	writePage(w, r, res, out)
+}
+
+func (h *WebhookHandler) Get(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid webhook id")
+		return
+	}
+	actorID, ok := audit.ActorFrom(r.Context())
+	if !ok {
+		writeBadRequest(w, "X-Actor-ID header is required")
+		return
+	}
+
+	wh, err := h.uc.Get(r.Context(), id, actorID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, toWebhookResponse(wh))
+}
+
+func (h *WebhookHandler) Update(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid webhook id")
+		return
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+
+	wh, err := h.uc.Update(r.Context(), id, actorID, webhook.UpdateInput{
+		URL:    req.URL,
+		Secret: req.Secret,
+		Events: req.Events,
+		Active: req.Active,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusOK, toWebhookResponse(wh))
+}
+
+func (h *WebhookHandler) Delete(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid webhook id")
+		return
+	}
+
+	var req dto.WebhookActorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+
+	if err := h.uc.Delete(r.Context(), id, actorID); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+// Deliveries: GET /webhooks/{id}/deliveries?limit=&offset=
+func (h *WebhookHandler) Deliveries(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid webhook id")
+		return
+	}
+	actorID, ok := audit.ActorFrom(r.Context())
+	if !ok {
+		writeBadRequest(w, "X-Actor-ID header is required")
+		return
+	}
+
+	p := parsePage(r)
+
+	res, err := h.uc.Deliveries(r.Context(), id, actorID, p)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out := make([]dto.WebhookDeliveryResponse, 0, len(res.Items))
+	for _, d := range res.Items {
+		out = append(out, toWebhookDeliveryResponse(d))
+	}
+	writePage(w, r, res, out)
+}
+
+func (h *WebhookHandler) Redeliver(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeBadRequest(w, "invalid webhook id")
+		return
+	}
+	deliveryID, err := uuid.Parse(chi.URLParam(r, "deliveryId"))
+	if err != nil {
+		writeBadRequest(w, "invalid delivery id")
+		return
+	}
+
+	var req dto.WebhookActorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid json")
+		return
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		writeBadRequest(w, "invalid actorId")
+		return
+	}
+
+	d, err := h.uc.Redeliver(r.Context(), id, deliveryID, actorID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, stdhttp.StatusAccepted, toWebhookDeliveryResponse(d))
+}
+
+func toWebhookResponse(wh webhook.WebhookDTO) dto.WebhookResponse {
+	return dto.WebhookResponse{
+		ID:                  wh.ID,
+		ProjectID:           wh.ProjectID,
+		URL:                 wh.URL,
+		Events:              wh.Events,
+		Active:              wh.Active,
+		ConsecutiveFailures: wh.ConsecutiveFailures,
+		DisabledAt:          wh.DisabledAt,
+		CreatedBy:           wh.CreatedBy,
+		CreatedAt:           wh.CreatedAt,
+		UpdatedAt:           wh.UpdatedAt,
+		Secret:              wh.Secret,
+	}
+}
+
+func toWebhookDeliveryResponse(d webhook.DeliveryDTO) dto.WebhookDeliveryResponse {
+	resp := dto.WebhookDeliveryResponse{
+		ID:            d.ID,
+		WebhookID:     d.WebhookID,
+		EventID:       d.EventID,
+		Event:         d.Event,
+		Payload:       json.RawMessage(d.Payload),
+		RedeliveryOf:  d.RedeliveryOf,
+		Status:        d.Status,
+		Attempts:      d.Attempts,
+		ResponseCode:  d.ResponseCode,
+		ResponseBody:  d.ResponseBody,
+		Error:         d.LastError,
+		DurationMS:    d.DurationMS,
+		LastAttemptAt: d.LastAttemptAt,
+		CreatedAt:     d.CreatedAt,
+	}
+	if d.Status == webhook.StatusPending {
+		next := d.NextAttemptAt
+		resp.NextAttemptAt = &next
+	}
+	return resp
+}